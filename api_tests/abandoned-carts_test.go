@@ -0,0 +1,241 @@
+package apitests_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AbandonedCartsSuite struct {
+	suite.Suite
+	customerDAO                 daos.CustomerDAO
+	addressDAO                  daos.AddressDAO
+	productDAO                  daos.ProductDAO
+	inventoryDAO                daos.InventoryDAO
+	cartDAO                     daos.CartDAO
+	cartItemDAO                 daos.CartItemDAO
+	orderDAO                    daos.OrderDAO
+	notifyAbandonedCartsUsecase usecases.NotifyAbandonedCartsUsecase
+	accessToken                 string
+	testEnvironment             *testhelpers.TestEnvironment
+}
+
+func (a *AbandonedCartsSuite) SetupSuite() {
+	a.testEnvironment = testhelpers.NewTestEnvironment()
+	a.testEnvironment.Start()
+
+	a.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	a.customerDAO = daos.NewCustomerDAO(a.testEnvironment.PgxPool())
+	a.addressDAO = daos.NewAddressDAO(a.testEnvironment.PgxPool())
+	a.productDAO = daos.NewProductDAO(a.testEnvironment.PgxPool())
+	a.inventoryDAO = daos.NewInventoryDAO(a.testEnvironment.PgxPool())
+	a.cartDAO = daos.NewCartDAO(a.testEnvironment.PgxPool())
+	a.cartItemDAO = daos.NewCartItemDAO(a.testEnvironment.PgxPool())
+	a.orderDAO = daos.NewOrderDAO(a.testEnvironment.PgxPool())
+	a.notifyAbandonedCartsUsecase = usecases.NewNotifyAbandonedCartsUsecase(a.testEnvironment.PgxPool(),
+		gateways.NewRabbitmqCartReminderNotifier(a.testEnvironment.RabbitmqConn()), []time.Duration{time.Hour, 24 * time.Hour})
+}
+
+func (a *AbandonedCartsSuite) SetupTest() {
+	a.orderDAO.DeletAll()
+	a.cartItemDAO.DeletAll()
+	a.cartDAO.DeletAll()
+	a.addressDAO.DeletAll()
+	a.customerDAO.DeletAll()
+	a.inventoryDAO.DeletAll()
+	a.productDAO.DeletAll()
+
+	channel := utils.GetOrThrow(a.testEnvironment.RabbitmqConn().Channel())
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	_ = utils.GetOrThrow(channel.QueueDeclare(gateways.CartRemindersQueue, true, false, false, false, nil))
+	_ = utils.GetOrThrow(channel.QueuePurge(gateways.CartRemindersQueue, false))
+
+	a.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	a.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	a.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	a.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC().Add(-2 * time.Hour),
+	})
+	a.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (a *AbandonedCartsSuite) addStock(stockQuantity int32) {
+	a.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: stockQuantity,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (a *AbandonedCartsSuite) notify() int64 {
+	output, err := a.notifyAbandonedCartsUsecase.Execute()
+	a.Require().NoError(err)
+
+	return output.NotifiedCount
+}
+
+func (a *AbandonedCartsSuite) Test1() {
+	a.Run("given an idle cart, when reminding, then sends one reminder per threshold", func() {
+		a.addStock(10)
+
+		a.Equal(int64(1), a.notify())
+		a.Equal(int64(0), a.notify())
+
+		channel := utils.GetOrThrow(a.testEnvironment.RabbitmqConn().Channel())
+		defer func() {
+			_ = channel.Close()
+		}()
+
+		message, ok, err := channel.Get(gateways.CartRemindersQueue, true)
+		a.Require().NoError(err)
+		a.Require().True(ok)
+
+		var reminder gateways.CartReminder
+
+		a.Require().NoError(json.Unmarshal(message.Body, &reminder))
+		a.Equal(reminder.ReminderId.String(), message.MessageId)
+		a.Equal("bb8357b2-b978-4675-9521-ef2da0bd1747", reminder.CartId.String())
+		a.Equal("john.doe@gmail.com", reminder.CustomerEmail)
+		a.Equal(int32(1), reminder.Sequence)
+		a.Require().Len(reminder.Items, 1)
+		a.Equal(int32(2), reminder.Items[0].Quantity)
+
+		_ = utils.GetOrThrow(a.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE carts SET last_activity_at = last_activity_at - INTERVAL '1 day'"))
+		_ = utils.GetOrThrow(a.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE cart_reminders SET sent_at = sent_at - INTERVAL '1 day'"))
+
+		a.Equal(int64(1), a.notify())
+		a.Equal(int64(0), a.notify())
+	})
+}
+
+func (a *AbandonedCartsSuite) Test2() {
+	a.Run("given an idle cart of a customer who opted out, when reminding, then sends nothing", func() {
+		a.addStock(10)
+
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/set-cart-reminder-preference", `{"optedOut": true}`)
+		a.Require().Equal(204, response.StatusCode)
+		a.True(a.customerDAO.FindOneById(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")).CartRemindersOptedOut)
+
+		a.Equal(int64(0), a.notify())
+	})
+}
+
+func (a *AbandonedCartsSuite) Test3() {
+	a.Run("given an idle cart whose items are out of stock, when reminding, then sends nothing", func() {
+		a.addStock(0)
+
+		a.Equal(int64(0), a.notify())
+	})
+}
+
+func (a *AbandonedCartsSuite) Test4() {
+	a.Run("given a reminded cart that was checked out, when getting the metrics, then counts it as recovered", func() {
+		a.addStock(10)
+		a.Equal(int64(1), a.notify())
+
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747"+
+			"&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+		a.Require().Equal(200, response.StatusCode)
+
+		response = a.testEnvironment.Request(a.accessToken, "GET", "/v1/admin/abandoned-cart-metrics", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(200, response.StatusCode)
+		a.JSONEq(`
+			{
+				"data": {
+					"remindersSent": 1,
+					"remindedCarts": 1,
+					"recoveredCarts": 1,
+					"recoveryRate": 1,
+					"recoveredOrders": 1,
+					"recoveredRevenue": [
+						{
+							"currency": "USD",
+							"amount": 5998
+						}
+					]
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (a *AbandonedCartsSuite) Test5() {
+	a.Run("given a cart that got every reminder, when it is touched and left idle again, then sends no more reminders", func() {
+		a.addStock(10)
+
+		a.Equal(int64(1), a.notify())
+
+		_ = utils.GetOrThrow(a.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE carts SET last_activity_at = last_activity_at - INTERVAL '1 day'"))
+
+		a.Equal(int64(1), a.notify())
+
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/increase-product-quantity-in-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+		a.Require().Equal(204, response.StatusCode)
+
+		_ = utils.GetOrThrow(a.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE carts SET last_activity_at = last_activity_at - INTERVAL '3 days'"))
+
+		a.Equal(int64(0), a.notify())
+	})
+}
+
+func TestAbandonedCarts(t *testing.T) {
+	suite.Run(t, new(AbandonedCartsSuite))
+}
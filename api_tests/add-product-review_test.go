@@ -0,0 +1,279 @@
+package apitests_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddProductReviewSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	productDAO       daos.ProductDAO
+	orderDAO         daos.OrderDAO
+	orderItemDAO     daos.OrderItemDAO
+	productReviewDAO daos.ProductReviewDAO
+	accessToken      string
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (a *AddProductReviewSuite) SetupSuite() {
+	a.testEnvironment = testhelpers.NewTestEnvironment()
+	a.testEnvironment.Start()
+
+	a.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	a.customerDAO = daos.NewCustomerDAO(a.testEnvironment.PgxPool())
+	a.productDAO = daos.NewProductDAO(a.testEnvironment.PgxPool())
+	a.orderDAO = daos.NewOrderDAO(a.testEnvironment.PgxPool())
+	a.orderItemDAO = daos.NewOrderItemDAO(a.testEnvironment.PgxPool())
+	a.productReviewDAO = daos.NewProductReviewDAO(a.testEnvironment.PgxPool())
+}
+
+func (a *AddProductReviewSuite) SetupTest() {
+	a.customerDAO.DeletAll()
+	a.productDAO.DeletAll()
+	a.orderDAO.DeletAll()
+	a.orderItemDAO.DeletAll()
+	a.productReviewDAO.DeletAll()
+
+	a.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	a.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+}
+
+func (a *AddProductReviewSuite) createPurchase() {
+	a.orderDAO.Create(daos.OrderSchema{
+		Id:            uuid.MustParse("5b8a8f4c-1f0e-4b7b-9a55-0e1c5a3c2d11"),
+		CustomerId:    uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		TotalPrice:    2999,
+		TotalQuantity: 1,
+		CreatedAt:     time.Now().UTC(),
+	})
+	a.orderItemDAO.Create(daos.OrderItemSchema{
+		Id:        uuid.New(),
+		OrderId:   uuid.MustParse("5b8a8f4c-1f0e-4b7b-9a55-0e1c5a3c2d11"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  1,
+		Price:     2999,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (a *AddProductReviewSuite) addProductReview(body string) *http.Response {
+	return a.testEnvironment.Request(a.accessToken, "POST", "/v1/add-product-review", body)
+}
+
+func (a *AddProductReviewSuite) Test1() {
+	a.Run("given that the customer purchased the product, when adding a review, then returns 201 and creates a pending review", func() {
+		a.createPurchase()
+
+		response := a.addProductReview(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"rating": 5,
+				"title": "Best mouse I ever had",
+				"body": "Comfortable and the battery lasts for weeks."
+			}
+		`)
+
+		a.Equal(201, response.StatusCode)
+		body := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		productReviewId := body["data"]["productReviewId"].(string)
+		a.True(utils.IsValidUUID(productReviewId))
+		a.Equal("pending", body["data"]["status"])
+
+		productReviewSchema := a.productReviewDAO.FindOneById(uuid.MustParse(productReviewId))
+		a.Require().NotNil(productReviewSchema)
+		a.Require().Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", productReviewSchema.ProductId.String())
+		a.Require().Equal("f59207c8-e837-4159-b67d-78c716510747", productReviewSchema.CustomerId.String())
+		a.Require().Equal(int16(5), productReviewSchema.Rating)
+		a.Require().Equal("Best mouse I ever had", productReviewSchema.Title)
+		a.Require().Equal("Comfortable and the battery lasts for weeks.", productReviewSchema.Body)
+		a.Require().Equal("pending", productReviewSchema.Status)
+		a.Require().Equal(int32(0), productReviewSchema.HelpfulCount)
+		a.Require().WithinDuration(time.Now(), productReviewSchema.CreatedAt, 5*time.Second)
+	})
+}
+
+func (a *AddProductReviewSuite) Test2() {
+	a.Run("given that the customer did not purchase the product, when adding a review, then returns 409", func() {
+		response := a.addProductReview(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"rating": 5,
+				"title": "Best mouse I ever had",
+				"body": "Comfortable and the battery lasts for weeks."
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "only customers who purchased this product can review it"
+			}
+		`, string(body))
+	})
+}
+
+func (a *AddProductReviewSuite) Test3() {
+	a.Run("given that the customer already reviewed the product, when adding a review, then returns 409", func() {
+		a.createPurchase()
+		a.productReviewDAO.Create(daos.ProductReviewSchema{
+			Id:         uuid.New(),
+			ProductId:  uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Rating:     4,
+			Title:      "Good mouse",
+			Body:       "Does the job.",
+			Status:     "approved",
+			CreatedAt:  time.Now().UTC(),
+		})
+
+		response := a.addProductReview(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"rating": 5,
+				"title": "Best mouse I ever had",
+				"body": "Comfortable and the battery lasts for weeks."
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "you have already reviewed this product"
+			}
+		`, string(body))
+	})
+}
+
+func (a *AddProductReviewSuite) Test4() {
+	a.Run("when adding a review and rating is out of range, then returns 409", func() {
+		a.createPurchase()
+
+		for _, rating := range []string{"0", "6"} {
+			response := a.addProductReview(fmt.Sprintf(`
+				{
+					"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"rating": %s,
+					"title": "Best mouse I ever had",
+					"body": "Comfortable and the battery lasts for weeks."
+				}
+			`, rating))
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			a.Equal(409, response.StatusCode)
+			a.JSONEq(`
+				{
+					"message": "rating must be between 1 and 5"
+				}
+			`, string(body))
+		}
+	})
+}
+
+func (a *AddProductReviewSuite) Test5() {
+	a.Run("when adding a review and body is invalid, then returns 400", func() {
+		templates := []map[string]string{
+			{
+				"body": `{}`,
+				"error": `[
+					"productId is required",
+					"rating is required",
+					"title is required",
+					"body is required"
+				]`,
+			},
+			{
+				"body": `{
+					"productId": "",
+					"rating": 1.5,
+					"title": " ",
+					"body": 1
+				}`,
+				"error": `[
+					"productId must be uuidv4",
+					"rating must be integer",
+					"title must not be empty",
+					"body must be string"
+				]`,
+			},
+		}
+
+		for _, template := range templates {
+			response := a.addProductReview(template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+
+			a.Equal(400, response.StatusCode)
+			a.JSONEq(fmt.Sprintf(`
+				{
+					"message": %s
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func (a *AddProductReviewSuite) Test6() {
+	a.Run("given two reviews of the same product posted at once, when adding them, then creates one and returns 409 for the other", func() {
+		a.createPurchase()
+
+		statusCodes := make([]int, 2)
+
+		var waitGroup sync.WaitGroup
+		for i := range statusCodes {
+			waitGroup.Add(1)
+
+			go func() {
+				defer waitGroup.Done()
+
+				statusCodes[i] = a.addProductReview(`
+					{
+						"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+						"rating": 5,
+						"title": "Best mouse I ever had",
+						"body": "Comfortable and the battery lasts for weeks."
+					}
+				`).StatusCode
+			}()
+		}
+		waitGroup.Wait()
+
+		a.ElementsMatch([]int{201, 409}, statusCodes)
+
+		var reviewsCount int
+		utils.ThrowOnError(a.testEnvironment.PgxPool().QueryRow(context.Background(),
+			"SELECT COUNT(*) FROM product_reviews WHERE customer_id = $1", uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")).Scan(&reviewsCount))
+		a.Equal(1, reviewsCount)
+	})
+}
+
+func TestAddProductReview(t *testing.T) {
+	suite.Run(t, new(AddProductReviewSuite))
+}
@@ -22,6 +22,7 @@ type AddProductToCartSuite struct {
 	cartItemDAO     daos.CartItemDAO
 	customerDAO     daos.CustomerDAO
 	inventoryDAO    daos.InventoryDAO
+	warehouseDAO    daos.WarehouseDAO
 	testEnvironment *testhelpers.TestEnvironment
 }
 
@@ -34,6 +35,7 @@ func (a *AddProductToCartSuite) SetupSuite() {
 	a.cartItemDAO = daos.NewCartItemDAO(a.testEnvironment.PgxPool())
 	a.customerDAO = daos.NewCustomerDAO(a.testEnvironment.PgxPool())
 	a.inventoryDAO = daos.NewInventoryDAO(a.testEnvironment.PgxPool())
+	a.warehouseDAO = daos.NewWarehouseDAO(a.testEnvironment.PgxPool())
 }
 
 func (a *AddProductToCartSuite) SetupTest() {
@@ -42,6 +44,7 @@ func (a *AddProductToCartSuite) SetupTest() {
 	a.cartDAO.DeletAll()
 	a.cartItemDAO.DeletAll()
 	a.inventoryDAO.DeletAll()
+	a.warehouseDAO.DeletAll()
 }
 
 func (a *AddProductToCartSuite) Test1() {
@@ -128,7 +131,7 @@ func (a *AddProductToCartSuite) Test2() {
 		a.inventoryDAO.Create(daos.InventorySchema{
 			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
 			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
-			StockQuantity: 10,
+			StockQuantity: 20,
 			CreatedAt:     time.Now().UTC(),
 		})
 		a.inventoryDAO.Create(daos.InventorySchema{
@@ -429,6 +432,69 @@ func (a *AddProductToCartSuite) Test6() {
 	})
 }
 
+func (a *AddProductToCartSuite) Test7() {
+	a.Run("given that the product stock is spread across warehouses, when adding more than any single warehouse holds, then returns 204", func() {
+		a.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Name:      "John Doe",
+			Email:     "john.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		a.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+		a.cartDAO.Create(daos.CartSchema{
+			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			CreatedAt:  time.Now().UTC(),
+		})
+		a.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Dallas warehouse",
+			ZipCode:   utils.NewPointer("75201"),
+			CreatedAt: time.Now().UTC(),
+		})
+		a.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			StockQuantity: 5,
+			CreatedAt:     time.Now().UTC(),
+		})
+		a.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			StockQuantity: 5,
+			CreatedAt:     time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/add-product-to-cart", strings.NewReader(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 8
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(204, response.StatusCode)
+		a.Equal("", string(body))
+
+		cartItemSchema := a.cartItemDAO.FindAllByCartId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"))
+		a.Require().Equal(1, len(cartItemSchema))
+		a.Require().Equal(int32(8), cartItemSchema[0].Quantity)
+	})
+}
+
 func TestAddProductToCart(t *testing.T) {
 	suite.Run(t, new(AddProductToCartSuite))
 }
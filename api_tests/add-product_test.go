@@ -35,7 +35,7 @@ func (a *AddProductSuite) SetupTest() {
 }
 
 func (a *AddProductSuite) Test1() {
-	a.Run(`when adding product, then returns 204 
+	a.Run(`when adding product, then returns 201 with the product and inventory ids
 	and a new product and inventory are created and inventory has stock equals zero and product has status 'unpublished'`, func() {
 		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-product", strings.NewReader(`
 			{
@@ -51,16 +51,17 @@ func (a *AddProductSuite) Test1() {
 		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
 
 		body := utils.GetOrThrow(io.ReadAll(response.Body))
-		a.Equal(204, response.StatusCode)
-		a.Equal("", string(body))
+		a.Equal(201, response.StatusCode)
 
 		productSchema := a.productDAO.FindOneByName("ErgoClick Pro Wireless Mouse")
 		a.Require().NotNil(productSchema)
 		a.Require().True(utils.IsValidUUID(productSchema.Id.String()))
 		a.Require().Equal("unpublished", productSchema.Status)
 		a.Require().Equal("ErgoClick Pro Wireless Mouse", productSchema.Name)
+		a.Require().Equal("ergoclick-pro-wireless-mouse", *productSchema.Slug)
 		a.Require().Equal("Ergonomically designed wireless optical mouse ...", *productSchema.Description)
 		a.Require().Equal(int64(2999), productSchema.Price)
+		a.Require().Equal("USD", productSchema.Currency)
 		a.Require().WithinDuration(time.Now(), productSchema.CreatedAt, 5*time.Second)
 
 		inventorySchema := a.inventoryDAO.FindOneByProductId(productSchema.Id)
@@ -69,6 +70,15 @@ func (a *AddProductSuite) Test1() {
 		a.Require().Equal(productSchema.Id, inventorySchema.ProductId)
 		a.Require().Equal(int32(0), inventorySchema.StockQuantity)
 		a.Require().WithinDuration(time.Now(), inventorySchema.CreatedAt, 5*time.Second)
+
+		a.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"productId": "%s",
+					"inventoryId": "%s"
+				}
+			}
+		`, productSchema.Id, inventorySchema.Id), string(body))
 	})
 }
 
@@ -236,6 +246,27 @@ func (a *AddProductSuite) Test3() {
 	})
 }
 
+func (a *AddProductSuite) Test4() {
+	a.Run("when adding product and the name has 50 non-ascii characters, then returns 201", func() {
+		name := strings.Repeat("é", 50)
+
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-product", strings.NewReader(fmt.Sprintf(`
+			{
+				"name": "%s",
+				"price": 2999
+			}
+		`, name))))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		a.Equal(201, response.StatusCode)
+		a.NotNil(a.productDAO.FindOneByName(name))
+	})
+}
+
 func TestAddProduct(t *testing.T) {
 	suite.Run(t, new(AddProductSuite))
 }
@@ -17,9 +17,11 @@ import (
 
 type AddStockSuite struct {
 	suite.Suite
-	productDAO      daos.ProductDAO
-	inventoryDAO    daos.InventoryDAO
-	testEnvironment *testhelpers.TestEnvironment
+	productDAO           daos.ProductDAO
+	inventoryDAO         daos.InventoryDAO
+	inventoryMovementDAO daos.InventoryMovementDAO
+	warehouseDAO         daos.WarehouseDAO
+	testEnvironment      *testhelpers.TestEnvironment
 }
 
 func (a *AddStockSuite) SetupSuite() {
@@ -28,10 +30,13 @@ func (a *AddStockSuite) SetupSuite() {
 
 	a.productDAO = daos.NewProductDAO(a.testEnvironment.PgxPool())
 	a.inventoryDAO = daos.NewInventoryDAO(a.testEnvironment.PgxPool())
+	a.inventoryMovementDAO = daos.NewInventoryMovementDAO(a.testEnvironment.PgxPool())
+	a.warehouseDAO = daos.NewWarehouseDAO(a.testEnvironment.PgxPool())
 }
 
 func (a *AddStockSuite) SetupTest() {
 	a.productDAO.DeletAll()
+	a.warehouseDAO.DeletAll()
 }
 
 func (a *AddStockSuite) Test1() {
@@ -56,7 +61,7 @@ func (a *AddStockSuite) Test1() {
 				"stock": 8
 			}
 		`)))
-		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
 		request.Header.Add("Content-Type", "application/json")
 		request.Header.Add("Authorization", "Bearer "+accessToken)
 
@@ -72,6 +77,15 @@ func (a *AddStockSuite) Test1() {
 		a.Require().Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", inventorySchema.ProductId.String())
 		a.Require().Equal(int32(12), inventorySchema.StockQuantity)
 		a.Require().WithinDuration(time.Now(), inventorySchema.CreatedAt, 5*time.Second)
+
+		inventoryMovementsSchema := a.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Len(inventoryMovementsSchema, 1)
+		a.Require().Equal("cf23ee55-88c0-4898-ada4-15645c75645d", inventoryMovementsSchema[0].InventoryId.String())
+		a.Require().Equal("restock", inventoryMovementsSchema[0].Reason)
+		a.Require().Equal(int32(8), inventoryMovementsSchema[0].Quantity)
+		a.Require().Equal(int32(12), inventoryMovementsSchema[0].Balance)
+		a.Require().Equal("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90", inventoryMovementsSchema[0].ActorId.String())
+		a.Require().Nil(inventoryMovementsSchema[0].ReferenceId)
 	})
 }
 
@@ -244,6 +258,121 @@ func (a *AddStockSuite) Test4() {
 	})
 }
 
+func (a *AddStockSuite) Test5() {
+	a.Run("given that the product has no stock in the warehouse, when adding stock to the warehouse, then it returns 204 and creates the inventory", func() {
+		a.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+		a.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			StockQuantity: 4,
+			CreatedAt:     time.Now().UTC(),
+		})
+		a.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Dallas warehouse",
+			ZipCode:   utils.NewPointer("75201"),
+			CreatedAt: time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-stock", strings.NewReader(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+				"stock": 8
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(204, response.StatusCode)
+		a.Equal("", string(body))
+
+		inventorySchema := a.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		a.Require().NotNil(inventorySchema)
+		a.Require().Equal(int32(8), inventorySchema.StockQuantity)
+
+		defaultInventorySchema := a.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().NotNil(defaultInventorySchema)
+		a.Require().Equal(int32(4), defaultInventorySchema.StockQuantity)
+
+		a.Require().Equal(int32(12), a.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		inventoryMovementsSchema := a.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Len(inventoryMovementsSchema, 1)
+		a.Require().Equal(inventorySchema.Id, inventoryMovementsSchema[0].InventoryId)
+		a.Require().Equal("restock", inventoryMovementsSchema[0].Reason)
+		a.Require().Equal(int32(8), inventoryMovementsSchema[0].Balance)
+	})
+}
+
+func (a *AddStockSuite) Test6() {
+	a.Run("given that the warehouse does not exists, when adding stock to the warehouse, then it returns 409", func() {
+		a.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-stock", strings.NewReader(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+				"stock": 8
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "warehouse not found"
+			}
+		`, string(body))
+	})
+}
+
+func (a *AddStockSuite) Test7() {
+	a.Run("when adding stock to a warehouse without the product, then returns 400", func() {
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-stock", strings.NewReader(`
+			{
+				"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+				"stock": 8
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(400, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": ["productId or sku is required"]
+			}
+		`, string(body))
+	})
+}
+
 func TestAddStock(t *testing.T) {
 	suite.Run(t, new(AddStockSuite))
 }
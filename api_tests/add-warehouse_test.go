@@ -0,0 +1,151 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddWarehouseSuite struct {
+	suite.Suite
+	warehouseDAO    daos.WarehouseDAO
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (a *AddWarehouseSuite) SetupSuite() {
+	a.testEnvironment = testhelpers.NewTestEnvironment()
+	a.testEnvironment.Start()
+
+	a.warehouseDAO = daos.NewWarehouseDAO(a.testEnvironment.PgxPool())
+}
+
+func (a *AddWarehouseSuite) SetupTest() {
+	a.warehouseDAO.DeletAll()
+}
+
+func (a *AddWarehouseSuite) Test1() {
+	a.Run("when adding a warehouse, then it returns 201 and creates the warehouse", func() {
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-warehouse", strings.NewReader(`
+			{
+				"name": "Dallas warehouse",
+				"zipCode": "75201"
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(201, response.StatusCode)
+
+		warehouseSchema := a.warehouseDAO.FindOneByName("Dallas warehouse")
+		a.Require().NotNil(warehouseSchema)
+		a.Require().Equal("75201", *warehouseSchema.ZipCode)
+		a.Require().False(warehouseSchema.IsDefault)
+		a.Require().WithinDuration(time.Now(), warehouseSchema.CreatedAt, 5*time.Second)
+
+		a.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"warehouseId": "%s"
+				}
+			}
+		`, warehouseSchema.Id), string(body))
+	})
+}
+
+func (a *AddWarehouseSuite) Test2() {
+	a.Run("given that a warehouse with the same name exists, when adding a warehouse, then it returns 409", func() {
+		a.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Dallas warehouse",
+			ZipCode:   utils.NewPointer("75201"),
+			CreatedAt: time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-warehouse", strings.NewReader(`
+			{
+				"name": "Dallas warehouse"
+			}
+		`)))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "warehouse name already exists"
+			}
+		`, string(body))
+	})
+}
+
+func (a *AddWarehouseSuite) Test3() {
+	a.Run("when adding a warehouse and body is invalid, then returns 400", func() {
+		templates := []map[string]string{
+			{
+				"body": `{}`,
+				"error": `[
+					"name is required"
+				]`,
+			},
+			{
+				"body": `{
+					"name": " ",
+					"zipCode": " "
+				}`,
+				"error": `[
+					"name must not be empty",
+					"zipCode must not be empty"
+				]`,
+			},
+			{
+				"body": `{
+					"name": 1,
+					"zipCode": 1
+				}`,
+				"error": `[
+					"name must be string",
+					"zipCode must be string"
+				]`,
+			},
+		}
+
+		for _, template := range templates {
+			request := utils.GetOrThrow(http.NewRequest("POST", a.testEnvironment.BaseUrl()+"/v1/admin/add-warehouse", strings.NewReader(template["body"])))
+			accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add("Authorization", "Bearer "+accessToken)
+
+			response := utils.GetOrThrow(a.testEnvironment.Client().Do(request))
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+
+			a.Equal(400, response.StatusCode)
+			a.JSONEq(fmt.Sprintf(`
+				{
+					"message": %s
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func TestAddWarehouse(t *testing.T) {
+	suite.Run(t, new(AddWarehouseSuite))
+}
@@ -0,0 +1,214 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdjustStockSuite struct {
+	suite.Suite
+	productDAO           daos.ProductDAO
+	inventoryDAO         daos.InventoryDAO
+	inventoryMovementDAO daos.InventoryMovementDAO
+	accessToken          string
+	testEnvironment      *testhelpers.TestEnvironment
+}
+
+func (a *AdjustStockSuite) SetupSuite() {
+	a.testEnvironment = testhelpers.NewTestEnvironment()
+	a.testEnvironment.Start()
+
+	a.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+
+	a.productDAO = daos.NewProductDAO(a.testEnvironment.PgxPool())
+	a.inventoryDAO = daos.NewInventoryDAO(a.testEnvironment.PgxPool())
+	a.inventoryMovementDAO = daos.NewInventoryMovementDAO(a.testEnvironment.PgxPool())
+}
+
+func (a *AdjustStockSuite) SetupTest() {
+	a.productDAO.DeletAll()
+	a.inventoryDAO.DeletAll()
+	a.inventoryMovementDAO.DeletAll()
+
+	a.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	a.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (a *AdjustStockSuite) adjustStock(body string) *http.Response {
+	return a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/adjust-stock", body)
+}
+
+func (a *AdjustStockSuite) Test1() {
+	a.Run("when writing off damaged stock, then it returns 204, decreases stock and logs the movement with its note", func() {
+		response := a.adjustStock(`
+			{
+				"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+				"quantity": -3,
+				"reason": "damage",
+				"note": "Crushed boxes on pallet 4"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(204, response.StatusCode)
+		a.Equal("", string(body))
+
+		inventorySchema := a.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Equal(int32(7), inventorySchema.StockQuantity)
+
+		inventoryMovementsSchema := a.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Len(inventoryMovementsSchema, 1)
+		a.Require().Equal("damage", inventoryMovementsSchema[0].Reason)
+		a.Require().Equal(int32(-3), inventoryMovementsSchema[0].Quantity)
+		a.Require().Equal(int32(7), inventoryMovementsSchema[0].Balance)
+		a.Require().Equal("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90", inventoryMovementsSchema[0].ActorId.String())
+		a.Require().Equal("Crushed boxes on pallet 4", *inventoryMovementsSchema[0].Note)
+	})
+}
+
+func (a *AdjustStockSuite) Test2() {
+	a.Run("when adjusting stock upwards, then it returns 204 and increases stock", func() {
+		response := a.adjustStock(`
+			{
+				"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+				"quantity": 2,
+				"reason": "adjustment",
+				"note": "Found two units misplaced in returns"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(204, response.StatusCode)
+		a.Equal("", string(body))
+
+		inventorySchema := a.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Equal(int32(12), inventorySchema.StockQuantity)
+	})
+}
+
+func (a *AdjustStockSuite) Test3() {
+	a.Run("when the adjustment would take stock below zero, then it returns 409 and leaves stock untouched", func() {
+		response := a.adjustStock(`
+			{
+				"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+				"quantity": -11,
+				"reason": "adjustment",
+				"note": "Shrinkage"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "stock cannot be negative"
+			}
+		`, string(body))
+
+		inventorySchema := a.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Equal(int32(10), inventorySchema.StockQuantity)
+
+		inventoryMovementsSchema := a.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Empty(inventoryMovementsSchema)
+	})
+}
+
+func (a *AdjustStockSuite) Test4() {
+	a.Run("when the adjustment breaks a business rule, then it returns 409", func() {
+		templates := []map[string]string{
+			{
+				"body":  `{"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d", "quantity": 0, "reason": "adjustment", "note": "Nothing"}`,
+				"error": "adjustment quantity cannot be zero",
+			},
+			{
+				"body":  `{"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d", "quantity": -1, "reason": "theft", "note": "Missing"}`,
+				"error": "reason must be adjustment or damage",
+			},
+			{
+				"body":  `{"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d", "quantity": 1, "reason": "damage", "note": "Broken"}`,
+				"error": "damage can only decrease stock",
+			},
+			{
+				"body":  `{"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f", "quantity": -1, "reason": "damage", "note": "Broken"}`,
+				"error": "inventory not found",
+			},
+		}
+
+		for _, template := range templates {
+			response := a.adjustStock(template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			a.Equal(409, response.StatusCode)
+			a.JSONEq(fmt.Sprintf(`
+				{
+					"message": "%s"
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func (a *AdjustStockSuite) Test5() {
+	a.Run("when adjusting stock and body is invalid, then returns 400", func() {
+		templates := []map[string]string{
+			{
+				"body": `{}`,
+				"error": `[
+					"inventoryId is required",
+					"quantity is required",
+					"reason is required",
+					"note is required"
+				]`,
+			},
+			{
+				"body": `{
+					"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+					"quantity": 1.5,
+					"reason": " ",
+					"note": " "
+				}`,
+				"error": `[
+					"quantity must be integer",
+					"reason must not be empty",
+					"note must not be empty"
+				]`,
+			},
+		}
+
+		for _, template := range templates {
+			response := a.adjustStock(template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			a.Equal(400, response.StatusCode)
+			a.JSONEq(fmt.Sprintf(`
+				{
+					"message": %s
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func TestAdjustStock(t *testing.T) {
+	suite.Run(t, new(AdjustStockSuite))
+}
@@ -0,0 +1,268 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminProductsSuite struct {
+	suite.Suite
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	warehouseDAO    daos.WarehouseDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (a *AdminProductsSuite) SetupSuite() {
+	a.testEnvironment = testhelpers.NewTestEnvironment()
+	a.testEnvironment.Start()
+
+	a.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	a.productDAO = daos.NewProductDAO(a.testEnvironment.PgxPool())
+	a.inventoryDAO = daos.NewInventoryDAO(a.testEnvironment.PgxPool())
+	a.warehouseDAO = daos.NewWarehouseDAO(a.testEnvironment.PgxPool())
+}
+
+func (a *AdminProductsSuite) SetupTest() {
+	a.productDAO.DeletAll()
+	a.inventoryDAO.DeletAll()
+	a.warehouseDAO.DeletAll()
+
+	a.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Sku:         utils.NewPointer("ERGO-MOUSE-01"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	a.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+	a.warehouseDAO.Create(daos.WarehouseSchema{
+		Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+		Name:      "Austin warehouse",
+		ZipCode:   utils.NewPointer("78701"),
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (a *AdminProductsSuite) Test1() {
+	a.Run("when adding stock by sku and by product id, then it returns 204 and the admin product lists both inventories", func() {
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/add-stock", `
+			{
+				"sku": "ERGO-MOUSE-01",
+				"stock": 5
+			}
+		`)
+		a.Equal(204, response.StatusCode)
+
+		response = a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/add-stock", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+				"stock": 3
+			}
+		`)
+		a.Equal(204, response.StatusCode)
+
+		austinInventorySchema := a.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		a.Require().NotNil(austinInventorySchema)
+
+		productSchema := a.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		response = a.testEnvironment.Request(a.accessToken, "GET", "/v1/admin/products/c0981e5b-9cb7-4623-9713-55db0317dc1a", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(200, response.StatusCode)
+		a.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"sku": "ERGO-MOUSE-01",
+					"slug": null,
+					"status": "published",
+					"name": "ErgoClick Pro Wireless Mouse",
+					"price": 2999,
+					"currency": "USD",
+					"createdAt": "%s",
+					"inventories": [
+						{
+							"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+							"warehouseId": "7c1d1a8e-2f4b-4e6a-9d3c-5b8e0f2a4c6d",
+							"warehouseName": "Main warehouse",
+							"stockQuantity": 15,
+							"reorderPoint": 0
+						},
+						{
+							"inventoryId": "%s",
+							"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+							"warehouseName": "Austin warehouse",
+							"stockQuantity": 3,
+							"reorderPoint": 0
+						}
+					]
+				}
+			}
+		`, productSchema.CreatedAt.Format(time.RFC3339Nano), austinInventorySchema.Id), string(body))
+	})
+}
+
+func (a *AdminProductsSuite) Test2() {
+	a.Run("when adjusting stock by product id and setting the reorder point by sku, then it updates the default warehouse inventory", func() {
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/adjust-stock", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": -4,
+				"reason": "damage",
+				"note": "Water damage"
+			}
+		`)
+		a.Equal(204, response.StatusCode)
+
+		response = a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/set-reorder-point", `
+			{
+				"sku": "ERGO-MOUSE-01",
+				"reorderPoint": 2
+			}
+		`)
+		a.Equal(204, response.StatusCode)
+
+		inventorySchema := a.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		a.Require().Equal(int32(6), inventorySchema.StockQuantity)
+		a.Require().Equal(int32(2), inventorySchema.ReorderPoint)
+	})
+}
+
+func (a *AdminProductsSuite) Test3() {
+	a.Run("when listing admin products, then it returns every product with its inventories", func() {
+		a.productDAO.Create(daos.ProductSchema{
+			Id:        uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+			Status:    "unpublished",
+			Name:      "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+			Price:     99286,
+			CreatedAt: time.Now().UTC().Add(-time.Minute),
+		})
+
+		mouseSchema := a.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		keyboardSchema := a.productDAO.FindOneById(uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"))
+
+		response := a.testEnvironment.Request(a.accessToken, "GET", "/v1/admin/products?pageSize=10", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(200, response.StatusCode)
+		a.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"items": [
+						{
+							"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+							"sku": "ERGO-MOUSE-01",
+							"slug": null,
+							"status": "published",
+							"name": "ErgoClick Pro Wireless Mouse",
+							"price": 2999,
+							"currency": "USD",
+							"createdAt": "%s",
+							"inventories": [
+								{
+									"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+									"warehouseId": "7c1d1a8e-2f4b-4e6a-9d3c-5b8e0f2a4c6d",
+									"warehouseName": "Main warehouse",
+									"stockQuantity": 10,
+									"reorderPoint": 0
+								}
+							]
+						},
+						{
+							"id": "7ab00199-6f9c-4af7-ad54-a02503226282",
+							"sku": null,
+							"slug": null,
+							"status": "unpublished",
+							"name": "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+							"price": 99286,
+							"currency": "USD",
+							"createdAt": "%s",
+							"inventories": []
+						}
+					],
+					"page": 1,
+					"pageSize": 10,
+					"totalItems": 2
+				}
+			}
+		`, mouseSchema.CreatedAt.Format(time.RFC3339Nano), keyboardSchema.CreatedAt.Format(time.RFC3339Nano)), string(body))
+	})
+}
+
+func (a *AdminProductsSuite) Test4() {
+	a.Run("when the inventory is addressed ambiguously, then it returns 400", func() {
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/add-stock", `
+			{
+				"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+				"sku": "ERGO-MOUSE-01",
+				"stock": 5
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(400, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": ["only one of inventoryId, productId or sku can be given"]
+			}
+		`, string(body))
+	})
+}
+
+func (a *AdminProductsSuite) Test5() {
+	a.Run("given that no product has the sku, when adding stock by sku, then it returns 409", func() {
+		response := a.testEnvironment.Request(a.accessToken, "POST", "/v1/admin/add-stock", `
+			{
+				"sku": "UNKNOWN-SKU",
+				"stock": 5
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "product not found"
+			}
+		`, string(body))
+	})
+}
+
+func (a *AdminProductsSuite) Test6() {
+	a.Run("given that the product does not exist, when getting the admin product, then it returns 409", func() {
+		response := a.testEnvironment.Request(a.accessToken, "GET", "/v1/admin/products/7ab00199-6f9c-4af7-ad54-a02503226282", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		a.Equal(409, response.StatusCode)
+		a.JSONEq(`
+			{
+				"message": "product not found"
+			}
+		`, string(body))
+	})
+}
+
+func TestAdminProducts(t *testing.T) {
+	suite.Run(t, new(AdminProductsSuite))
+}
@@ -0,0 +1,300 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type BackordersSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	addressDAO      daos.AddressDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	orderDAO        daos.OrderDAO
+	orderItemDAO    daos.OrderItemDAO
+	paymentDAO      daos.PaymentDAO
+	allocationDAO   daos.OrderItemAllocationDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (b *BackordersSuite) SetupSuite() {
+	b.testEnvironment = testhelpers.NewTestEnvironment()
+	b.testEnvironment.Start()
+
+	b.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	b.customerDAO = daos.NewCustomerDAO(b.testEnvironment.PgxPool())
+	b.addressDAO = daos.NewAddressDAO(b.testEnvironment.PgxPool())
+	b.productDAO = daos.NewProductDAO(b.testEnvironment.PgxPool())
+	b.inventoryDAO = daos.NewInventoryDAO(b.testEnvironment.PgxPool())
+	b.cartDAO = daos.NewCartDAO(b.testEnvironment.PgxPool())
+	b.cartItemDAO = daos.NewCartItemDAO(b.testEnvironment.PgxPool())
+	b.orderDAO = daos.NewOrderDAO(b.testEnvironment.PgxPool())
+	b.orderItemDAO = daos.NewOrderItemDAO(b.testEnvironment.PgxPool())
+	b.paymentDAO = daos.NewPaymentDAO(b.testEnvironment.PgxPool())
+	b.allocationDAO = daos.NewOrderItemAllocationDAO(b.testEnvironment.PgxPool())
+}
+
+func (b *BackordersSuite) SetupTest() {
+	b.customerDAO.DeletAll()
+	b.addressDAO.DeletAll()
+	b.productDAO.DeletAll()
+	b.inventoryDAO.DeletAll()
+	b.cartDAO.DeletAll()
+	b.cartItemDAO.DeletAll()
+	b.orderDAO.DeletAll()
+	b.orderItemDAO.DeletAll()
+	b.paymentDAO.DeletAll()
+
+	b.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	b.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	b.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	b.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 2,
+		CreatedAt:     time.Now().UTC(),
+	})
+	b.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (b *BackordersSuite) allowBackorders(backorderLimit int32) {
+	response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/set-product-backorder-policy", fmt.Sprintf(`
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"allowsBackorder": true,
+			"allowsPreorder": false,
+			"backorderLimit": %d,
+			"expectedShipDate": "2026-12-01"
+		}
+	`, backorderLimit))
+	b.Require().Equal(204, response.StatusCode)
+}
+
+func (b *BackordersSuite) addProductToCart(quantity int32) *http.Response {
+	return b.testEnvironment.Request(b.accessToken, "POST", "/v1/add-product-to-cart", fmt.Sprintf(`
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"quantity": %d
+		}
+	`, quantity))
+}
+
+func (b *BackordersSuite) checkout() *http.Response {
+	return b.testEnvironment.Request(b.accessToken, "POST",
+		"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+}
+
+func (b *BackordersSuite) addStock(stock int32) {
+	response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/add-stock", fmt.Sprintf(`
+		{
+			"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+			"stock": %d
+		}
+	`, stock))
+	b.Require().Equal(204, response.StatusCode)
+}
+
+func (b *BackordersSuite) Test1() {
+	b.Run("when setting the backorder policy, then it returns 204 and updates the product", func() {
+		b.allowBackorders(5)
+
+		productSchema := b.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		b.Require().True(productSchema.AllowsBackorder)
+		b.Require().False(productSchema.AllowsPreorder)
+		b.Require().Equal(int32(5), productSchema.BackorderLimit)
+		b.Require().Equal("2026-12-01", productSchema.ExpectedShipDate.Format(time.DateOnly))
+	})
+}
+
+func (b *BackordersSuite) Test2() {
+	b.Run("given that pre-orders are enabled without an expected ship date, when setting the backorder policy, then it returns 409", func() {
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/set-product-backorder-policy", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"allowsBackorder": false,
+				"allowsPreorder": true,
+				"backorderLimit": 10
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "pre-orders require an expected ship date"
+			}
+		`, string(body))
+	})
+}
+
+func (b *BackordersSuite) Test3() {
+	b.Run("when the body is invalid, then it returns 400", func() {
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/set-product-backorder-policy", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"allowsBackorder": "yes",
+				"backorderLimit": -1,
+				"expectedShipDate": "12/01/2026"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(400, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": [
+					"allowsBackorder must be boolean",
+					"allowsPreorder is required",
+					"backorderLimit must be positive",
+					"expectedShipDate must follow format yyyy-mm-dd"
+				]
+			}
+		`, string(body))
+	})
+}
+
+func (b *BackordersSuite) Test4() {
+	b.Run("given that the product allows backorders, when adding more than the stock to the cart, then it accepts up to the backorder limit", func() {
+		response := b.addProductToCart(3)
+		b.Equal(409, response.StatusCode)
+
+		b.allowBackorders(5)
+
+		response = b.addProductToCart(8)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+
+		response = b.addProductToCart(7)
+		b.Equal(204, response.StatusCode)
+	})
+}
+
+func (b *BackordersSuite) Test5() {
+	b.Run("given a backordered order item, when stock is added, then it is allocated to the backorder first", func() {
+		b.allowBackorders(5)
+
+		response := b.addProductToCart(6)
+		b.Require().Equal(204, response.StatusCode)
+
+		response = b.checkout()
+		b.Require().Equal(200, response.StatusCode)
+
+		orderSchema := b.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		orderItemsSchema := b.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		b.Require().Len(orderItemsSchema, 1)
+		b.Require().Equal(int32(6), orderItemsSchema[0].Quantity)
+		b.Require().Equal(int32(4), orderItemsSchema[0].BackorderedQuantity)
+		b.Require().Equal("2026-12-01", orderItemsSchema[0].ExpectedShipDate.Format(time.DateOnly))
+		b.Require().Equal(int32(0), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+		b.Require().Equal(int32(4), b.orderItemDAO.SumBackorderedQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		b.addStock(3)
+
+		orderItemsSchema = b.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		b.Require().Equal(int32(1), orderItemsSchema[0].BackorderedQuantity)
+		b.Require().Equal(int32(0), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		b.addStock(5)
+
+		orderItemsSchema = b.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		b.Require().Equal(int32(0), orderItemsSchema[0].BackorderedQuantity)
+		b.Require().Equal(int32(4), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		allocationsSchema := b.allocationDAO.FindAllByOrderItemId(orderItemsSchema[0].Id)
+		allocatedQuantity := int32(0)
+		for _, allocationSchema := range allocationsSchema {
+			allocatedQuantity += allocationSchema.Quantity
+		}
+		b.Require().Len(allocationsSchema, 3)
+		b.Require().Equal(int32(6), allocatedQuantity)
+	})
+}
+
+func (b *BackordersSuite) Test6() {
+	b.Run("given that the backorder limit is used up, when checking out, then it returns 409 and creates no order", func() {
+		b.allowBackorders(5)
+
+		response := b.addProductToCart(7)
+		b.Require().Equal(204, response.StatusCode)
+
+		b.orderDAO.Create(daos.OrderSchema{
+			Id:            uuid.MustParse("2f0c3e6a-8b1d-4c5e-9a7f-6d4b2e8c1a93"),
+			CustomerId:    uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			TotalPrice:    8995,
+			TotalQuantity: 3,
+			CreatedAt:     time.Now().UTC(),
+		})
+		b.orderItemDAO.Create(daos.OrderItemSchema{
+			Id:                  uuid.MustParse("6c8e1a3f-5b7d-4e9a-8c2f-1d3b5a7e9c04"),
+			OrderId:             uuid.MustParse("2f0c3e6a-8b1d-4c5e-9a7f-6d4b2e8c1a93"),
+			ProductId:           uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:            3,
+			Price:               2999,
+			CreatedAt:           time.Now().UTC(),
+			BackorderedQuantity: 3,
+		})
+
+		response = b.checkout()
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+
+		b.Require().Equal(int32(2), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+	})
+}
+
+func TestBackorders(t *testing.T) {
+	suite.Run(t, new(BackordersSuite))
+}
@@ -0,0 +1,325 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type BundlesSuite struct {
+	suite.Suite
+	customerDAO        daos.CustomerDAO
+	addressDAO         daos.AddressDAO
+	productDAO         daos.ProductDAO
+	inventoryDAO       daos.InventoryDAO
+	bundleComponentDAO daos.BundleComponentDAO
+	cartDAO            daos.CartDAO
+	cartItemDAO        daos.CartItemDAO
+	orderDAO           daos.OrderDAO
+	orderItemDAO       daos.OrderItemDAO
+	paymentDAO         daos.PaymentDAO
+	allocationDAO      daos.OrderItemAllocationDAO
+	accessToken        string
+	testEnvironment    *testhelpers.TestEnvironment
+}
+
+func (b *BundlesSuite) SetupSuite() {
+	b.testEnvironment = testhelpers.NewTestEnvironment()
+	b.testEnvironment.Start()
+
+	b.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	b.customerDAO = daos.NewCustomerDAO(b.testEnvironment.PgxPool())
+	b.addressDAO = daos.NewAddressDAO(b.testEnvironment.PgxPool())
+	b.productDAO = daos.NewProductDAO(b.testEnvironment.PgxPool())
+	b.inventoryDAO = daos.NewInventoryDAO(b.testEnvironment.PgxPool())
+	b.bundleComponentDAO = daos.NewBundleComponentDAO(b.testEnvironment.PgxPool())
+	b.cartDAO = daos.NewCartDAO(b.testEnvironment.PgxPool())
+	b.cartItemDAO = daos.NewCartItemDAO(b.testEnvironment.PgxPool())
+	b.orderDAO = daos.NewOrderDAO(b.testEnvironment.PgxPool())
+	b.orderItemDAO = daos.NewOrderItemDAO(b.testEnvironment.PgxPool())
+	b.paymentDAO = daos.NewPaymentDAO(b.testEnvironment.PgxPool())
+	b.allocationDAO = daos.NewOrderItemAllocationDAO(b.testEnvironment.PgxPool())
+}
+
+func (b *BundlesSuite) SetupTest() {
+	b.bundleComponentDAO.DeletAll()
+	b.customerDAO.DeletAll()
+	b.addressDAO.DeletAll()
+	b.productDAO.DeletAll()
+	b.inventoryDAO.DeletAll()
+	b.cartDAO.DeletAll()
+	b.cartItemDAO.DeletAll()
+	b.orderDAO.DeletAll()
+	b.orderItemDAO.DeletAll()
+	b.paymentDAO.DeletAll()
+
+	b.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	b.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	b.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	b.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Name:        "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+		Description: utils.NewPointer("A split-design wireless ergonomic keyboard ..."),
+		Price:       99286,
+		CreatedAt:   time.Now().UTC(),
+	})
+	b.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+	b.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+		ProductId:     uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		StockQuantity: 3,
+		CreatedAt:     time.Now().UTC(),
+	})
+	b.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (b *BundlesSuite) createBundle() {
+	b.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f"),
+		Status:    "published",
+		Name:      "Ergonomic Desk Kit",
+		Price:     104999,
+		IsBundle:  true,
+		CreatedAt: time.Now().UTC(),
+	})
+	b.bundleComponentDAO.Create(daos.BundleComponentSchema{
+		Id:                 uuid.New(),
+		BundleProductId:    uuid.MustParse("4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f"),
+		ComponentProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:           2,
+		CreatedAt:          time.Now().UTC(),
+	})
+	b.bundleComponentDAO.Create(daos.BundleComponentSchema{
+		Id:                 uuid.New(),
+		BundleProductId:    uuid.MustParse("4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f"),
+		ComponentProductId: uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Quantity:           1,
+		CreatedAt:          time.Now().UTC(),
+	})
+}
+
+func (b *BundlesSuite) addBundleToCart(quantity int32) *http.Response {
+	return b.testEnvironment.Request(b.accessToken, "POST", "/v1/add-product-to-cart", fmt.Sprintf(`
+		{
+			"productId": "4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f",
+			"quantity": %d
+		}
+	`, quantity))
+}
+
+func (b *BundlesSuite) Test1() {
+	b.Run("when adding a bundle, then it returns 201 and creates an unpublished bundle without inventory", func() {
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/add-bundle", `
+			{
+				"name": "Ergonomic Desk Kit",
+				"price": 104999,
+				"components": [
+					{ "productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a", "quantity": 2 },
+					{ "productId": "7ab00199-6f9c-4af7-ad54-a02503226282", "quantity": 1 }
+				]
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(201, response.StatusCode)
+
+		productSchema := b.productDAO.FindOneByName("Ergonomic Desk Kit")
+		b.Require().NotNil(productSchema)
+		b.Require().True(productSchema.IsBundle)
+		b.Require().Equal("unpublished", productSchema.Status)
+		b.Require().Equal(int64(104999), productSchema.Price)
+		b.Require().Nil(b.inventoryDAO.FindOneByProductId(productSchema.Id))
+
+		b.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"productId": "%s"
+				}
+			}
+		`, productSchema.Id), string(body))
+
+		bundleComponentsSchema := b.bundleComponentDAO.FindAllByBundleProductId(productSchema.Id)
+		b.Require().Len(bundleComponentsSchema, 2)
+		b.Require().Equal(int32(3), b.inventoryDAO.SumStockQuantityByProductId(productSchema.Id))
+	})
+}
+
+func (b *BundlesSuite) Test2() {
+	b.Run("when adding a bundle and the components are invalid, then it returns 400", func() {
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/add-bundle", `
+			{
+				"name": "Ergonomic Desk Kit",
+				"price": 104999,
+				"components": [
+					{ "productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a" },
+					"7ab00199-6f9c-4af7-ad54-a02503226282"
+				]
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(400, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": [
+					"components[0].quantity is required",
+					"components[1] must be object"
+				]
+			}
+		`, string(body))
+	})
+}
+
+func (b *BundlesSuite) Test3() {
+	b.Run("given that a component is a bundle, when adding a bundle, then it returns 409", func() {
+		b.createBundle()
+
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/add-bundle", `
+			{
+				"name": "Ergonomic Desk Kit Deluxe",
+				"price": 154999,
+				"components": [
+					{ "productId": "4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f", "quantity": 1 }
+				]
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "bundle components cannot be bundles"
+			}
+		`, string(body))
+	})
+}
+
+func (b *BundlesSuite) Test4() {
+	b.Run("when adding a bundle to the cart, then its availability is limited by the scarcest component", func() {
+		b.createBundle()
+
+		response := b.addBundleToCart(4)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+
+		response = b.addBundleToCart(3)
+		b.Equal(204, response.StatusCode)
+	})
+}
+
+func (b *BundlesSuite) Test5() {
+	b.Run("when checking out a bundle, then it records the bundle and its components and takes the stock out of the components", func() {
+		b.createBundle()
+
+		response := b.addBundleToCart(2)
+		b.Require().Equal(204, response.StatusCode)
+
+		response = b.testEnvironment.Request(b.accessToken, "POST",
+			"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+		b.Require().Equal(200, response.StatusCode)
+
+		orderSchema := b.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		b.Require().Equal(int64(209998), orderSchema.TotalPrice)
+		b.Require().Equal(int32(2), orderSchema.TotalQuantity)
+
+		orderItemsSchema := b.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		b.Require().Len(orderItemsSchema, 3)
+
+		orderItemsByProductId := map[string]daos.OrderItemSchema{}
+		for _, orderItemSchema := range orderItemsSchema {
+			orderItemsByProductId[orderItemSchema.ProductId.String()] = orderItemSchema
+		}
+
+		bundleOrderItemSchema := orderItemsByProductId["4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f"]
+		b.Require().Equal(int32(2), bundleOrderItemSchema.Quantity)
+		b.Require().Equal(int64(104999), bundleOrderItemSchema.Price)
+		b.Require().Nil(bundleOrderItemSchema.ParentOrderItemId)
+		b.Require().Empty(b.allocationDAO.FindAllByOrderItemId(bundleOrderItemSchema.Id))
+
+		mouseOrderItemSchema := orderItemsByProductId["c0981e5b-9cb7-4623-9713-55db0317dc1a"]
+		b.Require().Equal(int32(4), mouseOrderItemSchema.Quantity)
+		b.Require().Equal(int64(0), mouseOrderItemSchema.Price)
+		b.Require().Equal(bundleOrderItemSchema.Id, *mouseOrderItemSchema.ParentOrderItemId)
+		b.Require().Len(b.allocationDAO.FindAllByOrderItemId(mouseOrderItemSchema.Id), 1)
+
+		keyboardOrderItemSchema := orderItemsByProductId["7ab00199-6f9c-4af7-ad54-a02503226282"]
+		b.Require().Equal(int32(2), keyboardOrderItemSchema.Quantity)
+		b.Require().Equal(bundleOrderItemSchema.Id, *keyboardOrderItemSchema.ParentOrderItemId)
+
+		b.Require().Equal(int32(6), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+		b.Require().Equal(int32(1), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282")))
+		b.Require().Equal(int32(1), b.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f")))
+	})
+}
+
+func (b *BundlesSuite) Test6() {
+	b.Run("when adding stock to a bundle, then it returns 409", func() {
+		b.createBundle()
+
+		response := b.testEnvironment.Request(b.accessToken, "POST", "/v1/admin/add-stock", `
+			{
+				"productId": "4d2e8f1a-6b3c-4a7d-9e5f-0c1b2a3d4e5f",
+				"stock": 5
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		b.Equal(409, response.StatusCode)
+		b.JSONEq(`
+			{
+				"message": "bundles have no stock of their own"
+			}
+		`, string(body))
+	})
+}
+
+func TestBundles(t *testing.T) {
+	suite.Run(t, new(BundlesSuite))
+}
@@ -0,0 +1,175 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartWarningsSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (c *CartWarningsSuite) SetupSuite() {
+	c.testEnvironment = testhelpers.NewTestEnvironment()
+	c.testEnvironment.Start()
+
+	c.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	c.customerDAO = daos.NewCustomerDAO(c.testEnvironment.PgxPool())
+	c.productDAO = daos.NewProductDAO(c.testEnvironment.PgxPool())
+	c.inventoryDAO = daos.NewInventoryDAO(c.testEnvironment.PgxPool())
+	c.cartDAO = daos.NewCartDAO(c.testEnvironment.PgxPool())
+	c.cartItemDAO = daos.NewCartItemDAO(c.testEnvironment.PgxPool())
+}
+
+func (c *CartWarningsSuite) SetupTest() {
+	c.cartItemDAO.DeletAll()
+	c.cartDAO.DeletAll()
+	c.customerDAO.DeletAll()
+	c.inventoryDAO.DeletAll()
+	c.productDAO.DeletAll()
+
+	c.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	c.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (c *CartWarningsSuite) addCartLine(status string, stockQuantity int32, quantity int32, priceAtAdd int64) uuid.UUID {
+	productId := uuid.New()
+
+	c.productDAO.Create(daos.ProductSchema{
+		Id:          productId,
+		Status:      status,
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	c.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     productId,
+		StockQuantity: stockQuantity,
+		CreatedAt:     time.Now().UTC(),
+	})
+	c.cartItemDAO.Create(daos.CartItemSchema{
+		Id:         uuid.New(),
+		CartId:     uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId:  productId,
+		Quantity:   quantity,
+		PriceAtAdd: &priceAtAdd,
+		CreatedAt:  time.Now().UTC(),
+	})
+
+	return productId
+}
+
+func (c *CartWarningsSuite) warnings() []any {
+	response := c.testEnvironment.Request(c.accessToken, "GET", "/v1/cart", "")
+	c.Require().Equal(200, response.StatusCode)
+
+	return utils.ParseJSONBody[map[string]map[string]any](response.Body)["data"]["warnings"].([]any)
+}
+
+func (c *CartWarningsSuite) Test1() {
+	c.Run("given that a price changed since it was added, when checking out, then returns 409 until the change is acknowledged", func() {
+		productId := c.addCartLine("published", 10, 2, 2499)
+
+		c.Equal([]any{
+			map[string]any{
+				"productId":  productId.String(),
+				"type":       "price_changed",
+				"quantity":   float64(2),
+				"priceAtAdd": float64(2499),
+				"price":      float64(2999),
+			},
+		}, c.warnings())
+
+		response := c.testEnvironment.Request(c.accessToken, "POST", "/v1/checkout-prepayment", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		c.Equal(409, response.StatusCode)
+		c.JSONEq(`
+			{
+				"message": "cart has changes that must be acknowledged"
+			}
+		`, string(body))
+
+		response = c.testEnvironment.Request(c.accessToken, "POST", "/v1/acknowledge-cart-changes", "")
+		c.Require().Equal(204, response.StatusCode)
+		c.Empty(c.warnings())
+
+		cartItemSchema := c.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"), productId)
+		c.Require().NotNil(cartItemSchema)
+		c.Equal(int64(2999), *cartItemSchema.PriceAtAdd)
+	})
+}
+
+func (c *CartWarningsSuite) Test2() {
+	c.Run("given that the stock dropped below the cart quantity, when acknowledging, then lowers the quantity to the stock available", func() {
+		productId := c.addCartLine("published", 1, 3, 2999)
+
+		c.Equal([]any{
+			map[string]any{
+				"productId":         productId.String(),
+				"type":              "insufficient_stock",
+				"quantity":          float64(3),
+				"availableQuantity": float64(1),
+			},
+		}, c.warnings())
+
+		response := c.testEnvironment.Request(c.accessToken, "POST", "/v1/acknowledge-cart-changes", "")
+		c.Require().Equal(204, response.StatusCode)
+		c.Empty(c.warnings())
+
+		cartItemSchema := c.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"), productId)
+		c.Require().NotNil(cartItemSchema)
+		c.Equal(int32(1), cartItemSchema.Quantity)
+	})
+}
+
+func (c *CartWarningsSuite) Test3() {
+	c.Run("given that a product was unpublished, when acknowledging, then removes it from the cart", func() {
+		productId := c.addCartLine("unpublished", 10, 1, 2999)
+
+		c.Equal([]any{
+			map[string]any{
+				"productId": productId.String(),
+				"type":      "unavailable",
+				"quantity":  float64(1),
+			},
+		}, c.warnings())
+
+		response := c.testEnvironment.Request(c.accessToken, "POST", "/v1/acknowledge-cart-changes", "")
+		c.Require().Equal(204, response.StatusCode)
+		c.Empty(c.warnings())
+
+		c.Nil(c.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"), productId))
+	})
+}
+
+func TestCartWarnings(t *testing.T) {
+	suite.Run(t, new(CartWarningsSuite))
+}
@@ -15,16 +15,19 @@ import (
 
 type CheckoutPostpaymentSuite struct {
 	suite.Suite
-	customerDAO     daos.CustomerDAO
-	cartDAO         daos.CartDAO
-	cartItemDAO     daos.CartItemDAO
-	inventoryDAO    daos.InventoryDAO
-	addressDAO      daos.AddressDAO
-	orderDAO        daos.OrderDAO
-	orderItemDAO    daos.OrderItemDAO
-	paymentDAO      daos.PaymentDAO
-	productDAO      daos.ProductDAO
-	testEnvironment *testhelpers.TestEnvironment
+	customerDAO          daos.CustomerDAO
+	cartDAO              daos.CartDAO
+	cartItemDAO          daos.CartItemDAO
+	inventoryDAO         daos.InventoryDAO
+	addressDAO           daos.AddressDAO
+	orderDAO             daos.OrderDAO
+	orderItemDAO         daos.OrderItemDAO
+	paymentDAO           daos.PaymentDAO
+	productDAO           daos.ProductDAO
+	inventoryMovementDAO daos.InventoryMovementDAO
+	warehouseDAO         daos.WarehouseDAO
+	allocationDAO        daos.OrderItemAllocationDAO
+	testEnvironment      *testhelpers.TestEnvironment
 }
 
 func (c *CheckoutPostpaymentSuite) SetupSuite() {
@@ -40,6 +43,9 @@ func (c *CheckoutPostpaymentSuite) SetupSuite() {
 	c.orderItemDAO = daos.NewOrderItemDAO(c.testEnvironment.PgxPool())
 	c.paymentDAO = daos.NewPaymentDAO(c.testEnvironment.PgxPool())
 	c.productDAO = daos.NewProductDAO(c.testEnvironment.PgxPool())
+	c.inventoryMovementDAO = daos.NewInventoryMovementDAO(c.testEnvironment.PgxPool())
+	c.warehouseDAO = daos.NewWarehouseDAO(c.testEnvironment.PgxPool())
+	c.allocationDAO = daos.NewOrderItemAllocationDAO(c.testEnvironment.PgxPool())
 }
 
 func (c *CheckoutPostpaymentSuite) SetupTest() {
@@ -52,6 +58,7 @@ func (c *CheckoutPostpaymentSuite) SetupTest() {
 	c.orderDAO.DeletAll()
 	c.orderItemDAO.DeletAll()
 	c.paymentDAO.DeletAll()
+	c.warehouseDAO.DeletAll()
 }
 
 func (c *CheckoutPostpaymentSuite) Test1() {
@@ -162,11 +169,310 @@ func (c *CheckoutPostpaymentSuite) Test1() {
 		c.Require().Equal("mercado_pago", paymentSchema.PaymentGatewayName)
 		c.Require().WithinDuration(time.Now(), paymentSchema.CreatedAt, 5*time.Second)
 
+		inventoryMovementsSchema := c.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		c.Require().Len(inventoryMovementsSchema, 1)
+		c.Require().Equal("cf23ee55-88c0-4898-ada4-15645c75645d", inventoryMovementsSchema[0].InventoryId.String())
+		c.Require().Equal("sale", inventoryMovementsSchema[0].Reason)
+		c.Require().Equal(int32(-8), inventoryMovementsSchema[0].Quantity)
+		c.Require().Equal(int32(42), inventoryMovementsSchema[0].Balance)
+		c.Require().Equal("f59207c8-e837-4159-b67d-78c716510747", inventoryMovementsSchema[0].ActorId.String())
+		c.Require().Equal(orderSchema.Id, *inventoryMovementsSchema[0].ReferenceId)
+
 		cartItemSchema := c.cartItemDAO.FindAllByCartId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"))
 		c.Require().Empty(cartItemSchema)
 	})
 }
 
+func (c *CheckoutPostpaymentSuite) Test2() {
+	c.Run("given that several warehouses can fill the order, when checking out, then it ships from the nearest one", func() {
+		c.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Name:      "John Doe",
+			Email:     "john.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		c.addressDAO.Create(daos.AddressSchema{
+			Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+			CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			IsDefault:   true,
+			Street:      "Maple Grove Lane",
+			Number:      "4767",
+			City:        "Austin",
+			State:       "TX",
+			ZipCode:     "78739",
+			AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Austin warehouse",
+			ZipCode:   utils.NewPointer("78701"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			Name:      "Seattle warehouse",
+			ZipCode:   utils.NewPointer("98101"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			StockQuantity: 50,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			StockQuantity: 10,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.cartDAO.Create(daos.CartSchema{
+			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			CreatedAt:  time.Now().UTC(),
+		})
+		c.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.MustParse("b999870f-f969-4d24-8955-499dbf3c689e"),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:  8,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", c.testEnvironment.BaseUrl()+
+			"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", nil))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(c.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		c.Equal(200, response.StatusCode)
+		c.Equal("", string(body))
+
+		orderSchema := c.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		c.Require().NotNil(orderSchema)
+
+		orderItemSchema := c.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		c.Require().Len(orderItemSchema, 1)
+
+		allocationsSchema := c.allocationDAO.FindAllByOrderItemId(orderItemSchema[0].Id)
+		c.Require().Len(allocationsSchema, 1)
+		c.Require().Equal("3fede283-d7f3-4423-bfe1-63163978c03f", allocationsSchema[0].InventoryId.String())
+		c.Require().Equal("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f", allocationsSchema[0].WarehouseId.String())
+		c.Require().Equal(int32(8), allocationsSchema[0].Quantity)
+
+		austinInventorySchema := c.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		c.Require().Equal(int32(2), austinInventorySchema.StockQuantity)
+
+		seattleInventorySchema := c.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"))
+		c.Require().Equal(int32(50), seattleInventorySchema.StockQuantity)
+	})
+}
+
+func (c *CheckoutPostpaymentSuite) Test3() {
+	c.Run("given that no warehouse can fill the order alone, when checking out, then it splits the order item across warehouses", func() {
+		c.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Name:      "John Doe",
+			Email:     "john.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		c.addressDAO.Create(daos.AddressSchema{
+			Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+			CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			IsDefault:   true,
+			Street:      "Maple Grove Lane",
+			Number:      "4767",
+			City:        "Austin",
+			State:       "TX",
+			ZipCode:     "78739",
+			AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Austin warehouse",
+			ZipCode:   utils.NewPointer("78701"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			Name:      "Seattle warehouse",
+			ZipCode:   utils.NewPointer("98101"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			StockQuantity: 5,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			StockQuantity: 3,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.cartDAO.Create(daos.CartSchema{
+			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			CreatedAt:  time.Now().UTC(),
+		})
+		c.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.MustParse("b999870f-f969-4d24-8955-499dbf3c689e"),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:  8,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", c.testEnvironment.BaseUrl()+
+			"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", nil))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(c.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		c.Equal(200, response.StatusCode)
+		c.Equal("", string(body))
+
+		orderSchema := c.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		c.Require().NotNil(orderSchema)
+
+		orderItemSchema := c.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		c.Require().Len(orderItemSchema, 1)
+
+		allocationsSchema := c.allocationDAO.FindAllByOrderItemId(orderItemSchema[0].Id)
+		c.Require().Len(allocationsSchema, 2)
+		c.Require().Equal("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17", allocationsSchema[0].WarehouseId.String())
+		c.Require().Equal(int32(5), allocationsSchema[0].Quantity)
+		c.Require().Equal("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f", allocationsSchema[1].WarehouseId.String())
+		c.Require().Equal(int32(3), allocationsSchema[1].Quantity)
+
+		c.Require().Equal(int32(0), c.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		inventoryMovementsSchema := c.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		c.Require().Len(inventoryMovementsSchema, 2)
+	})
+}
+
+func (c *CheckoutPostpaymentSuite) Test4() {
+	c.Run("given that the warehouses do not hold enough stock, when checking out, then it returns 409 and creates no order", func() {
+		c.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Name:      "John Doe",
+			Email:     "john.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		c.addressDAO.Create(daos.AddressSchema{
+			Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+			CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			IsDefault:   true,
+			Street:      "Maple Grove Lane",
+			Number:      "4767",
+			City:        "Austin",
+			State:       "TX",
+			ZipCode:     "78739",
+			AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Name:        "ErgoClick Pro Wireless Mouse",
+			Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+			Price:       2999,
+			CreatedAt:   time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			Name:      "Austin warehouse",
+			ZipCode:   utils.NewPointer("78701"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.warehouseDAO.Create(daos.WarehouseSchema{
+			Id:        uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			Name:      "Seattle warehouse",
+			ZipCode:   utils.NewPointer("98101"),
+			CreatedAt: time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+			StockQuantity: 4,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+			StockQuantity: 3,
+			CreatedAt:     time.Now().UTC(),
+		})
+		c.cartDAO.Create(daos.CartSchema{
+			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			CreatedAt:  time.Now().UTC(),
+		})
+		c.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.MustParse("b999870f-f969-4d24-8955-499dbf3c689e"),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:  8,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		request := utils.GetOrThrow(http.NewRequest("POST", c.testEnvironment.BaseUrl()+
+			"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", nil))
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+		request.Header.Add("Content-Type", "application/json")
+		request.Header.Add("Authorization", "Bearer "+accessToken)
+
+		response := utils.GetOrThrow(c.testEnvironment.Client().Do(request))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		c.Equal(409, response.StatusCode)
+		c.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+
+		orderSchema := c.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		c.Require().Nil(orderSchema)
+
+		c.Require().Equal(int32(7), c.inventoryDAO.SumStockQuantityByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+	})
+}
+
 func TestCheckoutPostpayment(t *testing.T) {
 	suite.Run(t, new(CheckoutPostpaymentSuite))
 }
@@ -0,0 +1,114 @@
+package apitests_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportProductsSuite struct {
+	suite.Suite
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (e *ExportProductsSuite) SetupSuite() {
+	e.testEnvironment = testhelpers.NewTestEnvironment()
+	e.testEnvironment.Start()
+
+	e.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	e.productDAO = daos.NewProductDAO(e.testEnvironment.PgxPool())
+	e.inventoryDAO = daos.NewInventoryDAO(e.testEnvironment.PgxPool())
+}
+
+func (e *ExportProductsSuite) SetupTest() {
+	e.productDAO.DeletAll()
+
+	e.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Sku:         utils.NewPointer("MOUSE-001"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC().Add(-time.Minute),
+	})
+	e.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Status:    "unpublished",
+		Name:      "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+		Price:     99286,
+		CreatedAt: time.Now().UTC(),
+	})
+	e.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 50,
+		CreatedAt:     time.Now().UTC(),
+	})
+	e.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+		ProductId:     uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		StockQuantity: 4,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (e *ExportProductsSuite) exportProducts(format string) *http.Response {
+	return e.testEnvironment.Request(e.accessToken, "GET", "/v1/admin/export-products?format="+format, "")
+}
+
+func (e *ExportProductsSuite) Test1() {
+	e.Run("when exporting as csv, then returns 200 and the catalog with stock levels", func() {
+		response := e.exportProducts("csv")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		e.Equal(200, response.StatusCode)
+		e.Equal("text/csv", response.Header.Get("Content-Type"))
+		e.Equal("id,sku,status,name,description,price,currency,stock_quantity\n"+
+			"c0981e5b-9cb7-4623-9713-55db0317dc1a,MOUSE-001,published,ErgoClick Pro Wireless Mouse,Ergonomically designed wireless optical mouse ...,2999,USD,50\n"+
+			"7ab00199-6f9c-4af7-ad54-a02503226282,,unpublished,Kinesis Freestyle2 Wireless Ergonomic Keyboard,,99286,USD,4\n", string(body))
+	})
+}
+
+func (e *ExportProductsSuite) Test2() {
+	e.Run("when exporting as ndjson, then returns 200 and one json object per product", func() {
+		response := e.exportProducts("ndjson")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		e.Equal(200, response.StatusCode)
+		e.Equal("application/x-ndjson", response.Header.Get("Content-Type"))
+		e.Equal(`{"id":"c0981e5b-9cb7-4623-9713-55db0317dc1a","sku":"MOUSE-001","status":"published","name":"ErgoClick Pro Wireless Mouse",`+
+			`"description":"Ergonomically designed wireless optical mouse ...","price":2999,"currency":"USD","stockQuantity":50}`+"\n"+
+			`{"id":"7ab00199-6f9c-4af7-ad54-a02503226282","sku":null,"status":"unpublished","name":"Kinesis Freestyle2 Wireless Ergonomic Keyboard",`+
+			`"description":null,"price":99286,"currency":"USD","stockQuantity":4}`+"\n", string(body))
+	})
+}
+
+func (e *ExportProductsSuite) Test3() {
+	e.Run("when exporting with an unsupported format, then returns 400", func() {
+		response := e.exportProducts("xml")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		e.Equal(400, response.StatusCode)
+		e.JSONEq(`
+			{
+				"message": ["format must be csv or ndjson"]
+			}
+		`, string(body))
+	})
+}
+
+func TestExportProducts(t *testing.T) {
+	suite.Run(t, new(ExportProductsSuite))
+}
@@ -16,6 +16,7 @@ import (
 type GetCartSuite struct {
 	suite.Suite
 	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
 	cartDAO         daos.CartDAO
 	cartItemDAO     daos.CartItemDAO
 	customerDAO     daos.CustomerDAO
@@ -27,6 +28,7 @@ func (i *GetCartSuite) SetupSuite() {
 	i.testEnvironment.Start()
 
 	i.productDAO = daos.NewProductDAO(i.testEnvironment.PgxPool())
+	i.inventoryDAO = daos.NewInventoryDAO(i.testEnvironment.PgxPool())
 	i.cartDAO = daos.NewCartDAO(i.testEnvironment.PgxPool())
 	i.cartItemDAO = daos.NewCartItemDAO(i.testEnvironment.PgxPool())
 	i.customerDAO = daos.NewCustomerDAO(i.testEnvironment.PgxPool())
@@ -34,6 +36,7 @@ func (i *GetCartSuite) SetupSuite() {
 
 func (i *GetCartSuite) SetupTest() {
 	i.customerDAO.DeletAll()
+	i.inventoryDAO.DeletAll()
 	i.productDAO.DeletAll()
 	i.cartDAO.DeletAll()
 	i.cartItemDAO.DeletAll()
@@ -67,6 +70,15 @@ func (i *GetCartSuite) Test1() {
 			Description: utils.NewPointer("Lightweight Bluetooth on-ear headphones ..."),
 			Price:       22167,
 		})
+		for _, productId := range []string{"c0981e5b-9cb7-4623-9713-55db0317dc1a", "7ab00199-6f9c-4af7-ad54-a02503226282",
+			"b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2"} {
+			i.inventoryDAO.Create(daos.InventorySchema{
+				Id:            uuid.New(),
+				ProductId:     uuid.MustParse(productId),
+				StockQuantity: 10,
+				CreatedAt:     time.Now().UTC(),
+			})
+		}
 		i.cartDAO.Create(daos.CartSchema{
 			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
 			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
@@ -107,8 +119,19 @@ func (i *GetCartSuite) Test1() {
 			{
 				"data": {
 					"cartId": "bb8357b2-b978-4675-9521-ef2da0bd1747",
+					"version": 0,
+					"currency": "USD",
 					"totalItems": 3,
 					"totalQuantity": 18,
+					"subtotalPrice": 554138,
+					"couponCode": null,
+					"discounts": [],
+					"freeShipping": false,
+					"shippingMethod": null,
+					"shippingPrice": 0,
+					"taxState": null,
+					"taxPrice": 0,
+					"taxes": [],
 					"totalPrice": 554138,
 					"items": [
 						{
@@ -135,7 +158,9 @@ func (i *GetCartSuite) Test1() {
 							"quantity": 6,
 							"price": 22167
 						}
-					]
+					],
+					"warnings": [],
+					"relatedProducts": []
 				}
 			}
 		`, string(body))
@@ -170,10 +195,23 @@ func (i *GetCartSuite) Test2() {
 			{
 				"data": {
 					"cartId": "bb8357b2-b978-4675-9521-ef2da0bd1747",
+					"version": 0,
+					"currency": "USD",
 					"totalItems": 0,
 					"totalQuantity": 0,
+					"subtotalPrice": 0,
+					"couponCode": null,
+					"discounts": [],
+					"freeShipping": false,
+					"shippingMethod": null,
+					"shippingPrice": 0,
+					"taxState": null,
+					"taxPrice": 0,
+					"taxes": [],
 					"totalPrice": 0,
-					"items": []
+					"items": [],
+					"warnings": [],
+					"relatedProducts": []
 				}
 			}
 		`, string(body))
@@ -0,0 +1,163 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetInventoryMovementsSuite struct {
+	suite.Suite
+	productDAO           daos.ProductDAO
+	inventoryDAO         daos.InventoryDAO
+	inventoryMovementDAO daos.InventoryMovementDAO
+	accessToken          string
+	testEnvironment      *testhelpers.TestEnvironment
+}
+
+func (g *GetInventoryMovementsSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.inventoryDAO = daos.NewInventoryDAO(g.testEnvironment.PgxPool())
+	g.inventoryMovementDAO = daos.NewInventoryMovementDAO(g.testEnvironment.PgxPool())
+}
+
+func (g *GetInventoryMovementsSuite) SetupTest() {
+	g.productDAO.DeletAll()
+	g.inventoryDAO.DeletAll()
+	g.inventoryMovementDAO.DeletAll()
+
+	g.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:      "ErgoClick Pro Wireless Mouse",
+		Price:     2999,
+		CreatedAt: time.Now().UTC(),
+	})
+	g.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 7,
+		CreatedAt:     time.Now().UTC(),
+	})
+	g.inventoryMovementDAO.Create(daos.InventoryMovementSchema{
+		Id:          uuid.MustParse("5b1e7c2a-0f3d-4e8a-9c6b-2d4f6a8b0c1e"),
+		InventoryId: uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:   uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Reason:      "restock",
+		Quantity:    10,
+		Balance:     10,
+		ActorId:     utils.NewPointer(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90")),
+		CreatedAt:   time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+	})
+	g.inventoryMovementDAO.Create(daos.InventoryMovementSchema{
+		Id:          uuid.MustParse("9e2c4a6b-8d0f-4b1a-a3c5-e7f9b1d3f5a7"),
+		InventoryId: uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:   uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Reason:      "sale",
+		Quantity:    -3,
+		Balance:     7,
+		ActorId:     utils.NewPointer(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")),
+		ReferenceId: utils.NewPointer(uuid.MustParse("6c8e0a2b-4d6f-4a8c-b0e2-f4a6c8e0b2d4")),
+		CreatedAt:   time.Date(2025, 3, 5, 15, 30, 0, 0, time.UTC),
+	})
+}
+
+func (g *GetInventoryMovementsSuite) getInventoryMovements(query string) *http.Response {
+	return g.testEnvironment.Request(g.accessToken, "GET", "/v1/admin/inventory-movements?"+query, "")
+}
+
+func (g *GetInventoryMovementsSuite) Test1() {
+	g.Run("when getting the movements of a product, then returns 200 and the movements in chronological order", func() {
+		response := g.getInventoryMovements("productId=c0981e5b-9cb7-4623-9713-55db0317dc1a")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"items": [
+						{
+							"id": "5b1e7c2a-0f3d-4e8a-9c6b-2d4f6a8b0c1e",
+							"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+							"reason": "restock",
+							"quantity": 10,
+							"balance": 10,
+							"actorId": "0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90",
+							"referenceId": null,
+							"note": null,
+							"createdAt": "2025-03-01T10:00:00Z"
+						},
+						{
+							"id": "9e2c4a6b-8d0f-4b1a-a3c5-e7f9b1d3f5a7",
+							"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+							"reason": "sale",
+							"quantity": -3,
+							"balance": 7,
+							"actorId": "f59207c8-e837-4159-b67d-78c716510747",
+							"referenceId": "6c8e0a2b-4d6f-4a8c-b0e2-f4a6c8e0b2d4",
+							"note": null,
+							"createdAt": "2025-03-05T15:30:00Z"
+						}
+					],
+					"page": 1,
+					"pageSize": 20,
+					"totalItems": 2
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetInventoryMovementsSuite) Test2() {
+	g.Run("when getting the movements within a date range, then returns only the movements in it", func() {
+		response := g.getInventoryMovements("productId=c0981e5b-9cb7-4623-9713-55db0317dc1a&from=2025-03-02T00:00:00Z&to=2025-03-31T00:00:00Z")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.Contains(string(body), `"totalItems":1`)
+		g.Contains(string(body), `"id":"9e2c4a6b-8d0f-4b1a-a3c5-e7f9b1d3f5a7"`)
+	})
+}
+
+func (g *GetInventoryMovementsSuite) Test3() {
+	g.Run("when query params are invalid, then returns 400", func() {
+		response := g.getInventoryMovements("productId=abc&from=yesterday")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(400, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": [
+					"productId must be uuidv4",
+					"from must follow format yyyy-mm-ddThh:mm:ssZ"
+				]
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetInventoryMovementsSuite) Test4() {
+	g.Run("when changing a recorded movement, then the database rejects it", func() {
+		_, err := g.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE inventory_movements SET quantity = 100")
+		g.ErrorContains(err, "inventory_movements is append-only")
+
+		_, err = g.testEnvironment.PgxPool().Exec(context.Background(), "DELETE FROM inventory_movements")
+		g.ErrorContains(err, "inventory_movements is append-only")
+	})
+}
+
+func TestGetInventoryMovements(t *testing.T) {
+	suite.Run(t, new(GetInventoryMovementsSuite))
+}
@@ -0,0 +1,198 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProductBySlugSuite struct {
+	suite.Suite
+	productDAO                    daos.ProductDAO
+	regenerateProductSlugsUsecase usecases.RegenerateProductSlugsUsecase
+	accessToken                   string
+	testEnvironment               *testhelpers.TestEnvironment
+}
+
+func (g *GetProductBySlugSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.regenerateProductSlugsUsecase = usecases.NewRegenerateProductSlugsUsecase(g.testEnvironment.PgxPool())
+}
+
+func (g *GetProductBySlugSuite) SetupTest() {
+	g.productDAO.DeletAll()
+
+	g.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Slug:        utils.NewPointer("ergoclick-mouse"),
+		Status:      "published",
+		Name:        "ErgoClick Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	g.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Slug:      utils.NewPointer("ergoclick-pro-wireless-mouse"),
+		Status:    "published",
+		Name:      "ErgoClick Pro Wireless Mouse",
+		Price:     4999,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (g *GetProductBySlugSuite) renameProduct(body string) *http.Response {
+	return g.testEnvironment.Request(g.accessToken, "POST", "/v1/admin/rename-product", body)
+}
+
+func (g *GetProductBySlugSuite) getProductBySlug(slug string) *http.Response {
+	client := *g.testEnvironment.Client()
+	client.CheckRedirect = func(request *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return utils.GetOrThrow(client.Get(g.testEnvironment.BaseUrl() + "/v1/products/by-slug/" + slug))
+}
+
+func (g *GetProductBySlugSuite) Test1() {
+	g.Run("when getting a product by its current slug, then returns 200", func() {
+		response := g.getProductBySlug("ergoclick-mouse")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"slug": "ergoclick-mouse",
+					"name": "ErgoClick Mouse",
+					"description": "Ergonomically designed wireless optical mouse ...",
+					"price": 2999,
+					"currency": "USD",
+					"inStock": false,
+					"averageRating": 0,
+					"reviewCount": 0,
+					"prices": [],
+					"relatedProducts": []
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductBySlugSuite) Test2() {
+	g.Run("when renaming a product, then returns 200 with a unique slug and the old slug points to the new one with 301", func() {
+		response := g.renameProduct(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"name": "ErgoClick Pro Wireless Mouse"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"slug": "ergoclick-pro-wireless-mouse-2"
+				}
+			}
+		`, string(body))
+
+		productSchema := g.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		g.Require().NotNil(productSchema)
+		g.Require().Equal("ErgoClick Pro Wireless Mouse", productSchema.Name)
+		g.Require().Equal("ergoclick-pro-wireless-mouse-2", *productSchema.Slug)
+
+		response = g.getProductBySlug("ergoclick-mouse")
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(301, response.StatusCode)
+		g.Equal("/v1/products/by-slug/ergoclick-pro-wireless-mouse-2", response.Header.Get("Location"))
+		g.JSONEq(`
+			{
+				"data": {
+					"slug": "ergoclick-pro-wireless-mouse-2",
+					"location": "/v1/products/by-slug/ergoclick-pro-wireless-mouse-2"
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductBySlugSuite) Test3() {
+	g.Run("given that a product was renamed back, when getting by its restored slug, then returns 200", func() {
+		g.renameProduct(`{"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a", "name": "ErgoClick Mouse V2"}`)
+		g.renameProduct(`{"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a", "name": "ErgoClick Mouse"}`)
+
+		response := g.getProductBySlug("ergoclick-mouse")
+		g.Equal(200, response.StatusCode)
+
+		response = g.getProductBySlug("ergoclick-mouse-v2")
+		g.Equal(301, response.StatusCode)
+		g.Equal("/v1/products/by-slug/ergoclick-mouse", response.Header.Get("Location"))
+	})
+}
+
+func (g *GetProductBySlugSuite) Test4() {
+	g.Run("when getting a product by an unknown slug, then returns 409", func() {
+		response := g.getProductBySlug("unknown-product")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "product not found"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductBySlugSuite) Test5() {
+	g.Run("given a product whose slug is outdated, when regenerating the slugs, then slugifies its name and the old slug points to the new one with 301", func() {
+		g.productDAO.Create(daos.ProductSchema{
+			Id:        uuid.MustParse("3c9d2b1a-7e4f-4a8b-9c6d-5e2f1a0b8c7d"),
+			Slug:      utils.NewPointer("caf-cr-me-br-l-e-3c9d2b1a"),
+			Status:    "published",
+			Name:      "Café Crème Brûlée",
+			Price:     1299,
+			CreatedAt: time.Now().UTC(),
+		})
+		_ = utils.GetOrThrow(g.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE products SET slug_outdated = TRUE WHERE id = $1",
+			uuid.MustParse("3c9d2b1a-7e4f-4a8b-9c6d-5e2f1a0b8c7d")))
+
+		output, err := g.regenerateProductSlugsUsecase.Execute()
+		g.Require().NoError(err)
+		g.Equal(int64(1), output.NotifiedCount)
+
+		productSchema := g.productDAO.FindOneById(uuid.MustParse("3c9d2b1a-7e4f-4a8b-9c6d-5e2f1a0b8c7d"))
+		g.Require().NotNil(productSchema)
+		g.Equal("cafe-creme-brulee", *productSchema.Slug)
+
+		response := g.getProductBySlug("caf-cr-me-br-l-e-3c9d2b1a")
+		g.Equal(301, response.StatusCode)
+		g.Equal("/v1/products/by-slug/cafe-creme-brulee", response.Header.Get("Location"))
+
+		output, err = g.regenerateProductSlugsUsecase.Execute()
+		g.Require().NoError(err)
+		g.Equal(int64(0), output.NotifiedCount)
+	})
+}
+
+func TestGetProductBySlug(t *testing.T) {
+	suite.Run(t, new(GetProductBySlugSuite))
+}
@@ -0,0 +1,207 @@
+package apitests_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProductReviewsSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	productDAO       daos.ProductDAO
+	productReviewDAO daos.ProductReviewDAO
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (g *GetProductReviewsSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.customerDAO = daos.NewCustomerDAO(g.testEnvironment.PgxPool())
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.productReviewDAO = daos.NewProductReviewDAO(g.testEnvironment.PgxPool())
+}
+
+func (g *GetProductReviewsSuite) SetupTest() {
+	g.customerDAO.DeletAll()
+	g.productDAO.DeletAll()
+	g.productReviewDAO.DeletAll()
+
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("2d7f5b9e-3c4a-4b1e-8f6d-9a0b1c2d3e4f"),
+		Name:      "Jane Doe",
+		Email:     "jane.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("7e1c2b3a-4d5e-4f60-8a9b-0c1d2e3f4a5b"),
+		Name:      "Richard Roe",
+		Email:     "richard.roe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:    "published",
+		Name:      "ErgoClick Pro Wireless Mouse",
+		Price:     2999,
+		CreatedAt: time.Now().UTC(),
+	})
+	g.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:           uuid.MustParse("a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"),
+		ProductId:    uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId:   uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Rating:       5,
+		Title:        "Best mouse I ever had",
+		Body:         "Comfortable and the battery lasts for weeks.",
+		Status:       "approved",
+		HelpfulCount: 0,
+		CreatedAt:    time.Date(2025, 10, 2, 12, 0, 0, 0, time.UTC),
+	})
+	g.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:           uuid.MustParse("b2c7b1b5-7b3c-4a6c-9f1f-4a7a6d0e8f32"),
+		ProductId:    uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId:   uuid.MustParse("2d7f5b9e-3c4a-4b1e-8f6d-9a0b1c2d3e4f"),
+		Rating:       2,
+		Title:        "Stopped working",
+		Body:         "The scroll wheel broke after a month.",
+		Status:       "approved",
+		HelpfulCount: 3,
+		CreatedAt:    time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+	})
+	g.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:           uuid.MustParse("c3d8c2c6-8c4d-4b7d-a02a-5b8b7e1f9a43"),
+		ProductId:    uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId:   uuid.MustParse("7e1c2b3a-4d5e-4f60-8a9b-0c1d2e3f4a5b"),
+		Rating:       1,
+		Title:        "Spam",
+		Body:         "Buy followers at ...",
+		Status:       "pending",
+		HelpfulCount: 0,
+		CreatedAt:    time.Date(2025, 10, 3, 12, 0, 0, 0, time.UTC),
+	})
+}
+
+func (g *GetProductReviewsSuite) Test1() {
+	g.Run("when getting reviews sorted by newest, then returns 200 and only approved reviews", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() +
+			"/v1/product-reviews?productId=c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"page": 1,
+					"pageSize": 20,
+					"totalItems": 2,
+					"items": [
+						{
+							"id": "a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21",
+							"customerName": "John Doe",
+							"rating": 5,
+							"title": "Best mouse I ever had",
+							"body": "Comfortable and the battery lasts for weeks.",
+							"helpfulCount": 0,
+							"verifiedPurchase": true,
+							"createdAt": "2025-10-02T12:00:00Z"
+						},
+						{
+							"id": "b2c7b1b5-7b3c-4a6c-9f1f-4a7a6d0e8f32",
+							"customerName": "Jane Doe",
+							"rating": 2,
+							"title": "Stopped working",
+							"body": "The scroll wheel broke after a month.",
+							"helpfulCount": 3,
+							"verifiedPurchase": true,
+							"createdAt": "2025-10-01T12:00:00Z"
+						}
+					]
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductReviewsSuite) Test2() {
+	g.Run("when getting reviews sorted by most helpful and paginated, then returns 200 and the most helpful first", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() +
+			"/v1/product-reviews?productId=c0981e5b-9cb7-4623-9713-55db0317dc1a&sort=most_helpful&page=1&pageSize=1"))
+
+		g.Equal(200, response.StatusCode)
+		body := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		items := body["data"]["items"].([]any)
+		g.Require().Len(items, 1)
+		g.Equal("b2c7b1b5-7b3c-4a6c-9f1f-4a7a6d0e8f32", items[0].(map[string]any)["id"])
+		g.Equal(float64(2), body["data"]["totalItems"])
+	})
+}
+
+func (g *GetProductReviewsSuite) Test3() {
+	g.Run("when marking a review as helpful, then returns 204 and increments its helpful count only once per customer", func() {
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("2d7f5b9e-3c4a-4b1e-8f6d-9a0b1c2d3e4f"))
+		request := func() *http.Response {
+			return g.testEnvironment.Request(accessToken, "POST", "/v1/mark-product-review-helpful", `
+				{
+					"productReviewId": "a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"
+				}
+			`)
+		}
+
+		response := request()
+		g.Equal(204, response.StatusCode)
+
+		response = request()
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "you have already marked this review as helpful"
+			}
+		`, string(body))
+
+		productReviewSchema := g.productReviewDAO.FindOneById(uuid.MustParse("a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"))
+		g.Require().NotNil(productReviewSchema)
+		g.Require().Equal(int32(1), productReviewSchema.HelpfulCount)
+	})
+}
+
+func (g *GetProductReviewsSuite) Test4() {
+	g.Run("when getting reviews and query is invalid, then returns 400", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() +
+			"/v1/product-reviews?productId=1&sort=oldest&page=0&pageSize=101"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(400, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": [
+					"page must be a positive integer",
+					"pageSize must be between 1 and 100",
+					"productId must be uuidv4",
+					"sort must be newest or most_helpful"
+				]
+			}
+		`, string(body))
+	})
+}
+
+func TestGetProductReviews(t *testing.T) {
+	suite.Run(t, new(GetProductReviewsSuite))
+}
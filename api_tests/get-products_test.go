@@ -0,0 +1,194 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProductsSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	productDAO       daos.ProductDAO
+	inventoryDAO     daos.InventoryDAO
+	productReviewDAO daos.ProductReviewDAO
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (g *GetProductsSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.customerDAO = daos.NewCustomerDAO(g.testEnvironment.PgxPool())
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.inventoryDAO = daos.NewInventoryDAO(g.testEnvironment.PgxPool())
+	g.productReviewDAO = daos.NewProductReviewDAO(g.testEnvironment.PgxPool())
+}
+
+func (g *GetProductsSuite) SetupTest() {
+	g.customerDAO.DeletAll()
+	g.productDAO.DeletAll()
+	g.inventoryDAO.DeletAll()
+	g.productReviewDAO.DeletAll()
+
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("2d7f5b9e-3c4a-4b1e-8f6d-9a0b1c2d3e4f"),
+		Name:      "Jane Doe",
+		Email:     "jane.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC().Add(-time.Minute),
+	})
+	g.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Status:    "published",
+		Name:      "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+		Price:     99286,
+		CreatedAt: time.Now().UTC(),
+	})
+	g.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2"),
+		Status:    "unpublished",
+		Name:      "JBL Tune 520BT Wireless Headphones",
+		Price:     22167,
+		CreatedAt: time.Now().UTC(),
+	})
+	g.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 8,
+		CreatedAt:     time.Now().UTC(),
+	})
+	g.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		StockQuantity: 0,
+		CreatedAt:     time.Now().UTC(),
+	})
+	g.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:         uuid.New(),
+		ProductId:  uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Rating:     5,
+		Title:      "Best mouse I ever had",
+		Body:       "Comfortable and the battery lasts for weeks.",
+		Status:     "approved",
+		CreatedAt:  time.Now().UTC(),
+	})
+	g.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:         uuid.New(),
+		ProductId:  uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId: uuid.MustParse("2d7f5b9e-3c4a-4b1e-8f6d-9a0b1c2d3e4f"),
+		Rating:     4,
+		Title:      "Good mouse",
+		Body:       "Does the job.",
+		Status:     "approved",
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (g *GetProductsSuite) Test1() {
+	g.Run("when listing products, then returns 200 and only published products with their rating aggregates", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() + "/v1/products"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"page": 1,
+					"pageSize": 20,
+					"totalItems": 2,
+					"items": [
+						{
+							"id": "7ab00199-6f9c-4af7-ad54-a02503226282",
+							"slug": null,
+							"name": "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+							"description": null,
+							"price": 99286,
+							"currency": "USD",
+							"inStock": false,
+							"averageRating": 0,
+							"reviewCount": 0
+						},
+						{
+							"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+							"slug": null,
+							"name": "ErgoClick Pro Wireless Mouse",
+							"description": "Ergonomically designed wireless optical mouse ...",
+							"price": 2999,
+							"currency": "USD",
+							"inStock": true,
+							"averageRating": 4.5,
+							"reviewCount": 2
+						}
+					]
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductsSuite) Test2() {
+	g.Run("when getting a published product, then returns 200 and its rating aggregates", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() + "/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": {
+					"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"slug": null,
+					"name": "ErgoClick Pro Wireless Mouse",
+					"description": "Ergonomically designed wireless optical mouse ...",
+					"price": 2999,
+					"currency": "USD",
+					"inStock": true,
+					"averageRating": 4.5,
+					"reviewCount": 2,
+					"prices": [],
+					"relatedProducts": []
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (g *GetProductsSuite) Test3() {
+	g.Run("given that the product is unpublished, when getting it, then returns 409", func() {
+		response := utils.GetOrThrow(g.testEnvironment.Client().Get(g.testEnvironment.BaseUrl() + "/v1/products/b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "product not found"
+			}
+		`, string(body))
+	})
+}
+
+func TestGetProducts(t *testing.T) {
+	suite.Run(t, new(GetProductsSuite))
+}
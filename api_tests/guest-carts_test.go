@@ -0,0 +1,286 @@
+package apitests_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GuestCartsSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	productDAO       daos.ProductDAO
+	inventoryDAO     daos.InventoryDAO
+	cartDAO          daos.CartDAO
+	cartItemDAO      daos.CartItemDAO
+	guestCartDAO     daos.GuestCartDAO
+	guestCartItemDAO daos.GuestCartItemDAO
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (g *GuestCartsSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.customerDAO = daos.NewCustomerDAO(g.testEnvironment.PgxPool())
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.inventoryDAO = daos.NewInventoryDAO(g.testEnvironment.PgxPool())
+	g.cartDAO = daos.NewCartDAO(g.testEnvironment.PgxPool())
+	g.cartItemDAO = daos.NewCartItemDAO(g.testEnvironment.PgxPool())
+	g.guestCartDAO = daos.NewGuestCartDAO(g.testEnvironment.PgxPool())
+	g.guestCartItemDAO = daos.NewGuestCartItemDAO(g.testEnvironment.PgxPool())
+}
+
+func (g *GuestCartsSuite) SetupTest() {
+	g.guestCartItemDAO.DeletAll()
+	g.guestCartDAO.DeletAll()
+	g.cartItemDAO.DeletAll()
+	g.cartDAO.DeletAll()
+	g.customerDAO.DeletAll()
+	g.inventoryDAO.DeletAll()
+	g.productDAO.DeletAll()
+
+	g.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	g.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 5,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (g *GuestCartsSuite) createGuestCart() string {
+	response := utils.GetOrThrow(g.testEnvironment.Client().Post(g.testEnvironment.BaseUrl()+"/v1/guest-carts", "application/json", nil))
+	g.Require().Equal(201, response.StatusCode)
+
+	body := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+	return body["data"]["cartToken"].(string)
+}
+
+func (g *GuestCartsSuite) addProduct(cartToken string, quantity int32) *http.Response {
+	return g.testEnvironment.Request("", "POST", "/v1/add-product-to-guest-cart", fmt.Sprintf(`
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"quantity": %d
+		}
+	`, quantity), "X-Cart-Token", cartToken)
+}
+
+func (g *GuestCartsSuite) createCustomerWithCart(quantity int32) {
+	g.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	g.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("3fe0d1b2-6a0e-4c1c-8f1e-4b1d2a9c7e55"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	g.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("3fe0d1b2-6a0e-4c1c-8f1e-4b1d2a9c7e55"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  quantity,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (g *GuestCartsSuite) Test1() {
+	g.Run("given a guest cart, when adding a product and getting the cart, then returns 200 with the product", func() {
+		cartToken := g.createGuestCart()
+
+		response := g.addProduct(cartToken, 2)
+		g.Require().Equal(204, response.StatusCode)
+
+		response = g.addProduct(cartToken, 1)
+		g.Require().Equal(204, response.StatusCode)
+
+		response = g.testEnvironment.Request("", "GET", "/v1/guest-cart", "", "X-Cart-Token", cartToken)
+		g.Require().Equal(200, response.StatusCode)
+
+		body := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		g.Equal("USD", body["data"]["currency"])
+		g.Equal(float64(1), body["data"]["totalItems"])
+		g.Equal(float64(3), body["data"]["totalQuantity"])
+		g.Equal(float64(8997), body["data"]["totalPrice"])
+
+		items := body["data"]["items"].([]any)
+		g.Require().Len(items, 1)
+		g.Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", items[0].(map[string]any)["productId"])
+		g.Equal(float64(3), items[0].(map[string]any)["quantity"])
+	})
+}
+
+func (g *GuestCartsSuite) Test2() {
+	g.Run("given a guest cart, when adding more than the stock available, then returns 409", func() {
+		cartToken := g.createGuestCart()
+
+		response := g.addProduct(cartToken, 4)
+		g.Require().Equal(204, response.StatusCode)
+
+		response = g.addProduct(cartToken, 2)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GuestCartsSuite) Test3() {
+	g.Run("when using an access token as cart token, then returns 401", func() {
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+		response := g.addProduct(accessToken, 1)
+		g.Equal(401, response.StatusCode)
+	})
+}
+
+func (g *GuestCartsSuite) Test4() {
+	g.Run("given a guest cart, when logging in with its token, then sums quantities up to the stock and deletes the guest cart", func() {
+		g.createCustomerWithCart(3)
+		cartToken := g.createGuestCart()
+
+		response := g.addProduct(cartToken, 4)
+		g.Require().Equal(204, response.StatusCode)
+
+		response = utils.GetOrThrow(g.testEnvironment.Client().Post(g.testEnvironment.BaseUrl()+"/v1/login", "application/json",
+			strings.NewReader(fmt.Sprintf(`
+				{
+					"email": "john.doe@gmail.com",
+					"password": "123456",
+					"cartToken": "%s"
+				}
+			`, cartToken))))
+		g.Require().Equal(200, response.StatusCode)
+
+		cartItemSchema := g.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("3fe0d1b2-6a0e-4c1c-8f1e-4b1d2a9c7e55"),
+			uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		g.Require().NotNil(cartItemSchema)
+		g.Equal(int32(5), cartItemSchema.Quantity)
+
+		response = g.testEnvironment.Request("", "GET", "/v1/guest-cart", "", "X-Cart-Token", cartToken)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "cart not found"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GuestCartsSuite) Test5() {
+	g.Run("given a guest cart, when signing up with its token, then moves its items into the new cart", func() {
+		cartToken := g.createGuestCart()
+
+		response := g.addProduct(cartToken, 2)
+		g.Require().Equal(204, response.StatusCode)
+
+		response = utils.GetOrThrow(g.testEnvironment.Client().Post(g.testEnvironment.BaseUrl()+"/v1/sign-up", "application/json",
+			strings.NewReader(fmt.Sprintf(`
+				{
+					"name": "John Doe",
+					"email": "john.doe@gmail.com",
+					"password": "123456",
+					"cartToken": "%s"
+				}
+			`, cartToken))))
+		g.Require().Equal(204, response.StatusCode)
+
+		customerSchema := g.customerDAO.FindOneByEmail("john.doe@gmail.com")
+		g.Require().NotNil(customerSchema)
+		cartSchema := g.cartDAO.FindOneByCustomerId(customerSchema.Id)
+		g.Require().NotNil(cartSchema)
+
+		cartItemSchema := g.cartItemDAO.FindOneByCartIdAndProductId(cartSchema.Id, uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		g.Require().NotNil(cartItemSchema)
+		g.Equal(int32(2), cartItemSchema.Quantity)
+	})
+}
+
+func (g *GuestCartsSuite) Test6() {
+	g.Run("when logging in with a tampered cart token, then returns 409", func() {
+		g.createCustomerWithCart(1)
+
+		response := utils.GetOrThrow(g.testEnvironment.Client().Post(g.testEnvironment.BaseUrl()+"/v1/login", "application/json",
+			strings.NewReader(`
+				{
+					"email": "john.doe@gmail.com",
+					"password": "123456",
+					"cartToken": "not-a-cart-token"
+				}
+			`)))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "cart token is invalid"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GuestCartsSuite) Test7() {
+	g.Run("given a product whose price changed after it was added to a guest cart, when signing up with its token, then keeps the price the guest saw", func() {
+		cartToken := g.createGuestCart()
+
+		response := g.addProduct(cartToken, 2)
+		g.Require().Equal(204, response.StatusCode)
+
+		var priceAtAdd int64
+		utils.ThrowOnError(g.testEnvironment.PgxPool().QueryRow(context.Background(), "SELECT price_at_add FROM guest_cart_items").
+			Scan(&priceAtAdd))
+		g.Equal(int64(2999), priceAtAdd)
+
+		_ = utils.GetOrThrow(g.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE products SET price = $1 WHERE id = $2",
+			3499, uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		response = utils.GetOrThrow(g.testEnvironment.Client().Post(g.testEnvironment.BaseUrl()+"/v1/sign-up", "application/json",
+			strings.NewReader(fmt.Sprintf(`
+				{
+					"name": "John Doe",
+					"email": "john.doe@gmail.com",
+					"password": "123456",
+					"cartToken": "%s"
+				}
+			`, cartToken))))
+		g.Require().Equal(204, response.StatusCode)
+
+		cartSchema := g.cartDAO.FindOneByCustomerId(g.customerDAO.FindOneByEmail("john.doe@gmail.com").Id)
+		g.Require().NotNil(cartSchema)
+
+		cartItemSchema := g.cartItemDAO.FindOneByCartIdAndProductId(cartSchema.Id, uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		g.Require().NotNil(cartItemSchema)
+		g.Require().NotNil(cartItemSchema.PriceAtAdd)
+		g.Equal(int64(2999), *cartItemSchema.PriceAtAdd)
+	})
+}
+
+func TestGuestCarts(t *testing.T) {
+	suite.Run(t, new(GuestCartsSuite))
+}
@@ -0,0 +1,385 @@
+package apitests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GuestCheckoutSuite struct {
+	suite.Suite
+	customerDAO              daos.CustomerDAO
+	productDAO               daos.ProductDAO
+	inventoryDAO             daos.InventoryDAO
+	cartDAO                  daos.CartDAO
+	addressDAO               daos.AddressDAO
+	orderDAO                 daos.OrderDAO
+	guestCartDAO             daos.GuestCartDAO
+	shippingMethodDAO        daos.ShippingMethodDAO
+	notifyGuestOrdersUsecase usecases.NotifyGuestOrdersUsecase
+	testEnvironment          *testhelpers.TestEnvironment
+}
+
+func (g *GuestCheckoutSuite) SetupSuite() {
+	g.testEnvironment = testhelpers.NewTestEnvironment()
+	g.testEnvironment.Start()
+
+	g.customerDAO = daos.NewCustomerDAO(g.testEnvironment.PgxPool())
+	g.productDAO = daos.NewProductDAO(g.testEnvironment.PgxPool())
+	g.inventoryDAO = daos.NewInventoryDAO(g.testEnvironment.PgxPool())
+	g.cartDAO = daos.NewCartDAO(g.testEnvironment.PgxPool())
+	g.addressDAO = daos.NewAddressDAO(g.testEnvironment.PgxPool())
+	g.orderDAO = daos.NewOrderDAO(g.testEnvironment.PgxPool())
+	g.guestCartDAO = daos.NewGuestCartDAO(g.testEnvironment.PgxPool())
+	g.shippingMethodDAO = daos.NewShippingMethodDAO(g.testEnvironment.PgxPool())
+	g.notifyGuestOrdersUsecase = usecases.NewNotifyGuestOrdersUsecase(g.testEnvironment.PgxPool(),
+		gateways.NewRabbitmqOrderLookupNotifier(g.testEnvironment.RabbitmqConn()), g.testEnvironment.AwsSecretsGateway(),
+		"https://shop.example.com")
+}
+
+func (g *GuestCheckoutSuite) SetupTest() {
+	g.guestCartDAO.DeletAll()
+	g.orderDAO.DeletAll()
+	g.addressDAO.DeletAll()
+	g.cartDAO.DeletAll()
+	g.shippingMethodDAO.DeletAll()
+	g.customerDAO.DeletAll()
+	g.inventoryDAO.DeletAll()
+	g.productDAO.DeletAll()
+
+	channel := utils.GetOrThrow(g.testEnvironment.RabbitmqConn().Channel())
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	_ = utils.GetOrThrow(channel.QueueDeclare(gateways.OrderLookupNotificationsQueue, true, false, false, false, nil))
+	_ = utils.GetOrThrow(channel.QueuePurge(gateways.OrderLookupNotificationsQueue, false))
+
+	location := utils.GetOrThrow(json.Marshal(map[string]any{
+		"city":  "Austin",
+		"state": "TX",
+	}))
+	utils.ThrowOnError(g.testEnvironment.RedisClient().Set(context.Background(), "zip_codes:73301", location, 0).Err())
+
+	g.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	g.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 5,
+		CreatedAt:     time.Now().UTC(),
+	})
+	g.shippingMethodDAO.Create(daos.ShippingMethodSchema{
+		Id:        uuid.MustParse("5b1f2c3d-8e7a-4b6c-9d0e-1f2a3b4c5d6e"),
+		Name:      "Standard",
+		Type:      "flat",
+		Rate:      utils.NewPointer(int64(799)),
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (g *GuestCheckoutSuite) createGuestCart(quantity int32) string {
+	response := g.testEnvironment.Request("", "POST", "/v1/guest-carts", "")
+	g.Require().Equal(201, response.StatusCode)
+
+	cartToken := utils.ParseJSONBody[map[string]map[string]any](response.Body)["data"]["cartToken"].(string)
+
+	if quantity > 0 {
+		response = g.testEnvironment.Request("", "POST", "/v1/add-product-to-guest-cart", fmt.Sprintf(`
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": %d
+			}
+		`, quantity), "X-Cart-Token", cartToken)
+		g.Require().Equal(204, response.StatusCode)
+	}
+
+	return cartToken
+}
+
+func (g *GuestCheckoutSuite) checkout(cartToken string, email string) *http.Response {
+	return g.checkoutAs(cartToken, "Jane Doe", email, "")
+}
+
+func (g *GuestCheckoutSuite) checkoutAs(cartToken string, name string, email string, orderLookupToken string) *http.Response {
+	orderLookupTokenField := ""
+	if orderLookupToken != "" {
+		orderLookupTokenField = fmt.Sprintf(`, "orderLookupToken": "%s"`, orderLookupToken)
+	}
+
+	return g.testEnvironment.Request("", "POST", "/v1/guest-checkout", fmt.Sprintf(`
+		{
+			"name": "%s",
+			"email": "%s",
+			"city": "Austin",
+			"state": "TX",
+			"zipCode": "73301",
+			"streetName": "Delivery Road",
+			"streetNumber": "321"%s
+		}
+	`, name, email, orderLookupTokenField), "X-Cart-Token", cartToken)
+}
+
+func (g *GuestCheckoutSuite) pay(cartToken string, customerId string, addressId string) {
+	response := g.testEnvironment.Request("", "POST", "/v1/select-guest-shipping-method", `
+		{
+			"shippingMethodId": "5b1f2c3d-8e7a-4b6c-9d0e-1f2a3b4c5d6e"
+		}
+	`, "X-Cart-Token", cartToken)
+	g.Require().Equal(204, response.StatusCode)
+
+	response = g.testEnvironment.Request("", "POST", "/v1/guest-checkout-prepayment", "", "X-Cart-Token", cartToken)
+	g.Require().Equal(200, response.StatusCode)
+
+	preference := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+	g.Require().NotEmpty(preference["data"]["preferenceId"])
+
+	response = g.testEnvironment.Request("", "POST",
+		fmt.Sprintf("/v1/checkout-postpayment?data_id=123456&customer_id=%s&address_id=%s", customerId, addressId), "")
+	g.Require().Equal(200, response.StatusCode)
+}
+
+func (g *GuestCheckoutSuite) lookupToken() string {
+	output, err := g.notifyGuestOrdersUsecase.Execute()
+	g.Require().NoError(err)
+	g.Require().Equal(int64(1), output.NotifiedCount)
+
+	channel := utils.GetOrThrow(g.testEnvironment.RabbitmqConn().Channel())
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	message, ok, err := channel.Get(gateways.OrderLookupNotificationsQueue, true)
+	g.Require().NoError(err)
+	g.Require().True(ok)
+
+	var notification gateways.OrderLookupNotification
+	utils.ThrowOnError(json.Unmarshal(message.Body, &notification))
+
+	lookupUrl := utils.GetOrThrow(url.Parse(notification.LookupUrl))
+	return lookupUrl.Query().Get("token")
+}
+
+func (g *GuestCheckoutSuite) Test1() {
+	g.Run("given a guest cart, when checking out as a guest and paying, then creates the order and sends a lookup link that converts the guest", func() {
+		cartToken := g.createGuestCart(2)
+
+		response := g.checkout(cartToken, "jane.doe@gmail.com")
+		g.Require().Equal(201, response.StatusCode)
+
+		body := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		customerId := body["data"]["customerId"].(string)
+		addressId := body["data"]["addressId"].(string)
+
+		customerSchema := g.customerDAO.FindOneByEmail("jane.doe@gmail.com")
+		g.Require().NotNil(customerSchema)
+		g.Require().Equal(customerId, customerSchema.Id.String())
+		g.Require().True(customerSchema.IsGuest)
+
+		response = g.testEnvironment.Request("", "GET", "/v1/guest-shipping-rates", "", "X-Cart-Token", cartToken)
+		ratesBody := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Require().Equal(200, response.StatusCode)
+		g.JSONEq(`
+			{
+				"data": [
+					{
+						"shippingMethodId": "5b1f2c3d-8e7a-4b6c-9d0e-1f2a3b4c5d6e",
+						"name": "Standard",
+						"type": "flat",
+						"price": 799,
+						"currency": "USD"
+					}
+				]
+			}
+		`, string(ratesBody))
+
+		g.pay(cartToken, customerId, addressId)
+
+		response = g.testEnvironment.Request("", "GET", "/v1/guest-cart", "", "X-Cart-Token", cartToken)
+		g.Require().Equal(409, response.StatusCode)
+
+		orderSchema := g.orderDAO.FindOneByCustomerId(customerSchema.Id)
+		g.Require().NotNil(orderSchema)
+		g.Require().Equal(int32(2), orderSchema.TotalQuantity)
+		g.Require().Equal(int64(6797), orderSchema.TotalPrice)
+		g.Require().Nil(orderSchema.LookupLinkSentAt)
+
+		output, err := g.notifyGuestOrdersUsecase.Execute()
+		g.Require().NoError(err)
+		g.Require().Equal(int64(1), output.NotifiedCount)
+
+		orderSchema = g.orderDAO.FindOneById(orderSchema.Id)
+		g.Require().NotNil(orderSchema.LookupLinkSentAt)
+
+		channel := utils.GetOrThrow(g.testEnvironment.RabbitmqConn().Channel())
+		defer func() {
+			_ = channel.Close()
+		}()
+
+		message, ok, err := channel.Get(gateways.OrderLookupNotificationsQueue, true)
+		g.Require().NoError(err)
+		g.Require().True(ok)
+
+		var notification gateways.OrderLookupNotification
+		utils.ThrowOnError(json.Unmarshal(message.Body, &notification))
+		g.Require().Equal(orderSchema.Id, notification.OrderId)
+		g.Require().Equal("jane.doe@gmail.com", notification.CustomerEmail)
+
+		lookupUrl := utils.GetOrThrow(url.Parse(notification.LookupUrl))
+		g.Require().Equal("/v1/order-lookup", lookupUrl.Path)
+		lookupToken := lookupUrl.Query().Get("token")
+
+		response = g.testEnvironment.Request("", "GET", "/v1/order-lookup?token="+url.QueryEscape(lookupToken), "")
+		g.Require().Equal(200, response.StatusCode)
+
+		lookup := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		g.Equal(orderSchema.Id.String(), lookup["data"]["id"])
+		g.Equal("jane.doe@gmail.com", lookup["data"]["customerEmail"])
+		g.Equal(float64(6797), lookup["data"]["totalPrice"])
+		g.Len(lookup["data"]["items"], 1)
+
+		response = g.testEnvironment.Request("", "POST", "/v1/convert-guest-customer?token="+url.QueryEscape(lookupToken), `
+			{
+				"name": "Jane Doe",
+				"password": "123456"
+			}
+		`)
+		g.Require().Equal(204, response.StatusCode)
+
+		customerSchema = g.customerDAO.FindOneByEmail("jane.doe@gmail.com")
+		g.Require().False(customerSchema.IsGuest)
+
+		response = g.testEnvironment.Request("", "POST", "/v1/login", `
+			{
+				"email": "jane.doe@gmail.com",
+				"password": "123456"
+			}
+		`)
+		g.Require().Equal(200, response.StatusCode)
+	})
+}
+
+func (g *GuestCheckoutSuite) Test2() {
+	g.Run("given that the email belongs to an account, when checking out as a guest, then returns 409", func() {
+		g.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			Name:      "John Doe",
+			Email:     "john.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		cartToken := g.createGuestCart(1)
+
+		response := g.checkout(cartToken, "john.doe@gmail.com")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "this email address belongs to an account, log in to check out"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GuestCheckoutSuite) Test3() {
+	g.Run("given an empty guest cart, when checking out as a guest, then returns 409", func() {
+		cartToken := g.createGuestCart(0)
+
+		response := g.checkout(cartToken, "jane.doe@gmail.com")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "cart is empty"
+			}
+		`, string(body))
+	})
+}
+
+func (g *GuestCheckoutSuite) Test4() {
+	g.Run("when looking up an order with a token that was not signed for it, then returns 401", func() {
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.New())
+
+		response := g.testEnvironment.Request("", "GET", "/v1/order-lookup?token="+url.QueryEscape(accessToken), "")
+		g.Equal(401, response.StatusCode)
+	})
+}
+
+func (g *GuestCheckoutSuite) Test5() {
+	g.Run("given a returning guest, when checking out with the same email, then it takes their order link and does not rename them", func() {
+		cartToken := g.createGuestCart(1)
+
+		response := g.checkout(cartToken, "jane.doe@gmail.com")
+		g.Require().Equal(201, response.StatusCode)
+		firstCheckout := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+
+		response = g.checkout(cartToken, "jane.doe@gmail.com")
+		g.Require().Equal(201, response.StatusCode)
+		secondCheckout := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		g.Require().Equal(firstCheckout["data"]["customerId"], secondCheckout["data"]["customerId"])
+
+		g.pay(cartToken, secondCheckout["data"]["customerId"].(string), secondCheckout["data"]["addressId"].(string))
+		lookupToken := g.lookupToken()
+
+		cartToken = g.createGuestCart(1)
+
+		response = g.checkoutAs(cartToken, "Mallory", "jane.doe@gmail.com", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "this email address was used to check out before, check out from your order link"
+			}
+		`, string(body))
+
+		response = g.checkoutAs(cartToken, "Mallory", "jane.doe@gmail.com", lookupToken)
+		g.Require().Equal(201, response.StatusCode)
+		thirdCheckout := utils.ParseJSONBody[map[string]map[string]any](response.Body)
+		g.Require().Equal(firstCheckout["data"]["customerId"], thirdCheckout["data"]["customerId"])
+
+		customerSchema := g.customerDAO.FindOneByEmail("jane.doe@gmail.com")
+		g.Require().Equal("Jane Doe", customerSchema.Name)
+	})
+}
+
+func (g *GuestCheckoutSuite) Test6() {
+	g.Run("given a guest cart that was not checked out, when paying for it, then returns 409", func() {
+		cartToken := g.createGuestCart(1)
+
+		response := g.testEnvironment.Request("", "POST", "/v1/guest-checkout-prepayment", "", "X-Cart-Token", cartToken)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		g.Equal(409, response.StatusCode)
+		g.JSONEq(`
+			{
+				"message": "guest checkout is required"
+			}
+		`, string(body))
+	})
+}
+
+func TestGuestCheckout(t *testing.T) {
+	suite.Run(t, new(GuestCheckoutSuite))
+}
@@ -0,0 +1,277 @@
+package apitests_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImportProductsSuite struct {
+	suite.Suite
+	productDAO                   daos.ProductDAO
+	inventoryDAO                 daos.InventoryDAO
+	productImportJobDAO          daos.ProductImportJobDAO
+	processProductImportsUsecase usecases.ProcessProductImportsUsecase
+	accessToken                  string
+	testEnvironment              *testhelpers.TestEnvironment
+}
+
+func (i *ImportProductsSuite) SetupSuite() {
+	i.testEnvironment = testhelpers.NewTestEnvironment()
+	i.testEnvironment.Start()
+
+	i.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	i.productDAO = daos.NewProductDAO(i.testEnvironment.PgxPool())
+	i.inventoryDAO = daos.NewInventoryDAO(i.testEnvironment.PgxPool())
+	i.productImportJobDAO = daos.NewProductImportJobDAO(i.testEnvironment.PgxPool())
+	i.processProductImportsUsecase = usecases.NewProcessProductImportsUsecase(i.testEnvironment.PgxPool())
+}
+
+func (i *ImportProductsSuite) SetupTest() {
+	i.productDAO.DeletAll()
+	i.productImportJobDAO.DeletAll()
+}
+
+func (i *ImportProductsSuite) importProducts(contentType string, content string) *http.Response {
+	return i.testEnvironment.Request(i.accessToken, "POST", "/v1/admin/import-products", content, "Content-Type", contentType)
+}
+
+func (i *ImportProductsSuite) processJob(jobId uuid.UUID) string {
+	i.Equal("queued", i.productImportJobDAO.FindOneById(jobId).Status)
+
+	output, err := i.processProductImportsUsecase.Execute()
+	i.Require().NoError(err)
+	i.Equal(int64(1), output.NotifiedCount)
+
+	productImportJobSchema := i.productImportJobDAO.FindOneById(jobId)
+	i.Require().Equal("completed", productImportJobSchema.Status)
+	i.Nil(productImportJobSchema.Content)
+
+	response := i.testEnvironment.Request(i.accessToken, "GET", "/v1/admin/import-products/"+jobId.String(), "")
+
+	body := utils.GetOrThrow(io.ReadAll(response.Body))
+	i.Equal(200, response.StatusCode)
+
+	return string(body)
+}
+
+func (i *ImportProductsSuite) Test1() {
+	i.Run("when importing a csv, then returns 202 and creates new products and updates existing ones by sku", func() {
+		i.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Sku:         utils.NewPointer("MOUSE-001"),
+			Status:      "published",
+			Name:        "ErgoClick Mouse",
+			Description: utils.NewPointer("Old description"),
+			Price:       1999,
+			CreatedAt:   time.Now().UTC(),
+		})
+
+		response := i.importProducts("text/csv", "sku,name,description,price,currency\n"+
+			"MOUSE-001,ErgoClick Pro Wireless Mouse,Ergonomically designed wireless optical mouse ...,2999,\n"+
+			"KEYBOARD-001,Kinesis Freestyle2 Wireless Ergonomic Keyboard,,99286,brl\n")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		i.Equal(202, response.StatusCode)
+
+		var output struct {
+			Data struct {
+				JobId uuid.UUID `json:"jobId"`
+			} `json:"data"`
+		}
+		utils.ThrowOnError(json.Unmarshal(body, &output))
+
+		jobBody := i.processJob(output.Data.JobId)
+		i.Contains(jobBody, `"totalRows":2`)
+		i.Contains(jobBody, `"processedRows":2`)
+		i.Contains(jobBody, `"failedRows":0`)
+		i.Contains(jobBody, `"errors":[]`)
+
+		mouseSchema := i.productDAO.FindOneBySku("MOUSE-001")
+		i.Require().NotNil(mouseSchema)
+		i.Require().Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", mouseSchema.Id.String())
+		i.Require().Equal("published", mouseSchema.Status)
+		i.Require().Equal("ErgoClick Pro Wireless Mouse", mouseSchema.Name)
+		i.Require().Equal("Ergonomically designed wireless optical mouse ...", *mouseSchema.Description)
+		i.Require().Equal(int64(2999), mouseSchema.Price)
+		i.Require().Equal("USD", mouseSchema.Currency)
+
+		keyboardSchema := i.productDAO.FindOneBySku("KEYBOARD-001")
+		i.Require().NotNil(keyboardSchema)
+		i.Require().Equal("unpublished", keyboardSchema.Status)
+		i.Require().Equal("Kinesis Freestyle2 Wireless Ergonomic Keyboard", keyboardSchema.Name)
+		i.Require().Nil(keyboardSchema.Description)
+		i.Require().Equal(int64(99286), keyboardSchema.Price)
+		i.Require().Equal("BRL", keyboardSchema.Currency)
+
+		inventorySchema := i.inventoryDAO.FindOneByProductId(keyboardSchema.Id)
+		i.Require().NotNil(inventorySchema)
+		i.Require().Equal(int32(0), inventorySchema.StockQuantity)
+	})
+}
+
+func (i *ImportProductsSuite) Test2() {
+	i.Run("when importing ndjson with invalid rows, then returns 202 and reports an error per invalid row", func() {
+		response := i.importProducts("application/x-ndjson", `{"name": "ErgoClick Pro Wireless Mouse", "price": 2999}
+{"name": " ", "price": 2999}
+{"name": "JBL Tune 520BT Wireless Headphones", "price": 0}
+{"name": "Kinesis Freestyle2 Wireless Ergonomic Keyboard", "price": 9.5}
+not a json
+`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		i.Equal(202, response.StatusCode)
+
+		var output struct {
+			Data struct {
+				JobId uuid.UUID `json:"jobId"`
+			} `json:"data"`
+		}
+		utils.ThrowOnError(json.Unmarshal(body, &output))
+
+		jobBody := i.processJob(output.Data.JobId)
+
+		var job struct {
+			Data struct {
+				TotalRows     int32 `json:"totalRows"`
+				ProcessedRows int32 `json:"processedRows"`
+				FailedRows    int32 `json:"failedRows"`
+				Errors        []struct {
+					Row     int32  `json:"row"`
+					Message string `json:"message"`
+				} `json:"errors"`
+			} `json:"data"`
+		}
+		utils.ThrowOnError(json.Unmarshal([]byte(jobBody), &job))
+
+		i.Equal(int32(5), job.Data.TotalRows)
+		i.Equal(int32(5), job.Data.ProcessedRows)
+		i.Equal(int32(4), job.Data.FailedRows)
+		i.Require().Len(job.Data.Errors, 4)
+		i.Equal(int32(2), job.Data.Errors[0].Row)
+		i.Equal("the product name cannot be empty", job.Data.Errors[0].Message)
+		i.Equal(int32(3), job.Data.Errors[1].Row)
+		i.Equal("the product price cannot be zero", job.Data.Errors[1].Message)
+		i.Equal(int32(4), job.Data.Errors[2].Row)
+		i.Equal("the product price must be an integer", job.Data.Errors[2].Message)
+		i.Equal(int32(5), job.Data.Errors[3].Row)
+		i.Equal("row is not a valid json object", job.Data.Errors[3].Message)
+
+		i.NotNil(i.productDAO.FindOneByName("ErgoClick Pro Wireless Mouse"))
+		i.Nil(i.productDAO.FindOneByName("JBL Tune 520BT Wireless Headphones"))
+	})
+}
+
+func (i *ImportProductsSuite) Test3() {
+	i.Run("when importing a csv without the required columns, then returns 409", func() {
+		response := i.importProducts("text/csv", "sku,description\nMOUSE-001,Mouse\n")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		i.Equal(409, response.StatusCode)
+		i.JSONEq(`
+			{
+				"message": "csv header must contain name and price columns"
+			}
+		`, string(body))
+	})
+}
+
+func (i *ImportProductsSuite) Test4() {
+	i.Run("when importing with an unsupported content type, then returns 415", func() {
+		response := i.importProducts("application/json", `{"name": "ErgoClick Pro Wireless Mouse", "price": 2999}`)
+
+		i.Equal(415, response.StatusCode)
+	})
+}
+
+func (i *ImportProductsSuite) Test5() {
+	i.Run("when a row names another product, then reports the row as failed and leaves both products untouched", func() {
+		i.productDAO.Create(daos.ProductSchema{
+			Id:        uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Sku:       utils.NewPointer("MOUSE-001"),
+			Status:    "published",
+			Name:      "ErgoClick Mouse",
+			Price:     1999,
+			CreatedAt: time.Now().UTC(),
+		})
+		i.productDAO.Create(daos.ProductSchema{
+			Id:        uuid.MustParse("5d0b3f8e-2a71-4c9e-8f3d-6b1e7a9c2d40"),
+			Sku:       utils.NewPointer("KEYBOARD-001"),
+			Status:    "published",
+			Name:      "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+			Price:     99286,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		response := i.importProducts("text/csv", "sku,name,price\n"+
+			"MOUSE-002,ErgoClick Mouse,2999\n"+
+			"MOUSE-001,Kinesis Freestyle2 Wireless Ergonomic Keyboard,2999\n")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		i.Equal(202, response.StatusCode)
+
+		var output struct {
+			Data struct {
+				JobId uuid.UUID `json:"jobId"`
+			} `json:"data"`
+		}
+		utils.ThrowOnError(json.Unmarshal(body, &output))
+
+		jobBody := i.processJob(output.Data.JobId)
+		i.Contains(jobBody, `"failedRows":2`)
+		i.Contains(jobBody, `{"row":2,"message":"product name already exists"}`)
+		i.Contains(jobBody, `{"row":3,"message":"product name already exists"}`)
+
+		i.Nil(i.productDAO.FindOneBySku("MOUSE-002"))
+
+		mouseSchema := i.productDAO.FindOneBySku("MOUSE-001")
+		i.Require().NotNil(mouseSchema)
+		i.Equal("ErgoClick Mouse", mouseSchema.Name)
+		i.Equal(int64(1999), mouseSchema.Price)
+
+		keyboardSchema := i.productDAO.FindOneBySku("KEYBOARD-001")
+		i.Require().NotNil(keyboardSchema)
+		i.Equal(int64(99286), keyboardSchema.Price)
+	})
+}
+
+func (i *ImportProductsSuite) Test6() {
+	i.Run("when a queued job cannot be processed, then marks it as failed", func() {
+		i.productImportJobDAO.Create(daos.ProductImportJobSchema{
+			Id:        uuid.MustParse("8e2c4a71-3f5d-4b9e-a6c1-7d0f2b8e5a39"),
+			Status:    "queued",
+			Format:    "csv",
+			Content:   []byte("sku,description\nMOUSE-001,Mouse\n"),
+			TotalRows: 1,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		output, err := i.processProductImportsUsecase.Execute()
+		i.Require().Error(err)
+		i.Equal(int64(0), output.NotifiedCount)
+
+		productImportJobSchema := i.productImportJobDAO.FindOneById(uuid.MustParse("8e2c4a71-3f5d-4b9e-a6c1-7d0f2b8e5a39"))
+		i.Require().NotNil(productImportJobSchema)
+		i.Equal("failed", productImportJobSchema.Status)
+		i.NotNil(productImportJobSchema.FinishedAt)
+		i.Nil(productImportJobSchema.Content)
+
+		output, err = i.processProductImportsUsecase.Execute()
+		i.Require().NoError(err)
+		i.False(output.HasMore)
+	})
+}
+
+func TestImportProducts(t *testing.T) {
+	suite.Run(t, new(ImportProductsSuite))
+}
@@ -75,7 +75,7 @@ func (i *IncreaseProductQuantityInCartSuite) Test1() {
 		i.inventoryDAO.Create(daos.InventorySchema{
 			Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
 			ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
-			StockQuantity: 10,
+			StockQuantity: 20,
 			CreatedAt:     time.Now().UTC(),
 		})
 
@@ -0,0 +1,250 @@
+package apitests_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type LowStockAlertsSuite struct {
+	suite.Suite
+	productDAO                  daos.ProductDAO
+	inventoryDAO                daos.InventoryDAO
+	lowStockAlertDAO            daos.LowStockAlertDAO
+	notifyLowStockAlertsUsecase usecases.NotifyLowStockAlertsUsecase
+	accessToken                 string
+	testEnvironment             *testhelpers.TestEnvironment
+}
+
+func (l *LowStockAlertsSuite) SetupSuite() {
+	l.testEnvironment = testhelpers.NewTestEnvironment()
+	l.testEnvironment.Start()
+
+	l.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+
+	l.productDAO = daos.NewProductDAO(l.testEnvironment.PgxPool())
+	l.inventoryDAO = daos.NewInventoryDAO(l.testEnvironment.PgxPool())
+	l.lowStockAlertDAO = daos.NewLowStockAlertDAO(l.testEnvironment.PgxPool())
+	l.notifyLowStockAlertsUsecase = usecases.NewNotifyLowStockAlertsUsecase(l.testEnvironment.PgxPool(),
+		gateways.NewRabbitmqLowStockNotifier(l.testEnvironment.RabbitmqConn()))
+}
+
+func (l *LowStockAlertsSuite) SetupTest() {
+	l.lowStockAlertDAO.DeletAll()
+	l.productDAO.DeletAll()
+	l.inventoryDAO.DeletAll()
+
+	channel := utils.GetOrThrow(l.testEnvironment.RabbitmqConn().Channel())
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	_ = utils.GetOrThrow(channel.QueueDeclare(gateways.LowStockAlertsQueue, true, false, false, false, nil))
+	_ = utils.GetOrThrow(channel.QueuePurge(gateways.LowStockAlertsQueue, false))
+
+	l.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	l.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		ReorderPoint:  5,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (l *LowStockAlertsSuite) adjustStock(quantity int32) {
+	response := l.testEnvironment.Request(l.accessToken, "POST", "/v1/admin/adjust-stock", fmt.Sprintf(`
+		{
+			"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+			"quantity": %d,
+			"reason": "adjustment",
+			"note": "Shelf audit"
+		}
+	`, quantity))
+	l.Require().Equal(204, response.StatusCode)
+}
+
+func (l *LowStockAlertsSuite) Test1() {
+	l.Run("when stock crosses the reorder point, then it queues a single alert and notifies it through rabbitmq", func() {
+		l.adjustStock(-4)
+		l.Require().Empty(l.lowStockAlertDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		l.adjustStock(-1)
+		l.adjustStock(-2)
+
+		lowStockAlertsSchema := l.lowStockAlertDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		l.Require().Len(lowStockAlertsSchema, 1)
+		l.Require().Equal("cf23ee55-88c0-4898-ada4-15645c75645d", lowStockAlertsSchema[0].InventoryId.String())
+		l.Require().Equal(int32(5), lowStockAlertsSchema[0].StockQuantity)
+		l.Require().Equal(int32(5), lowStockAlertsSchema[0].ReorderPoint)
+		l.Require().Nil(lowStockAlertsSchema[0].NotifiedAt)
+
+		output, err := l.notifyLowStockAlertsUsecase.Execute()
+		l.Require().NoError(err)
+		l.Require().Equal(int64(1), output.NotifiedCount)
+
+		channel := utils.GetOrThrow(l.testEnvironment.RabbitmqConn().Channel())
+		defer func() {
+			_ = channel.Close()
+		}()
+
+		message, ok, err := channel.Get(gateways.LowStockAlertsQueue, true)
+		l.Require().NoError(err)
+		l.Require().True(ok)
+		l.Require().Equal(lowStockAlertsSchema[0].Id.String(), message.MessageId)
+
+		var alert gateways.LowStockAlert
+		utils.ThrowOnError(json.Unmarshal(message.Body, &alert))
+		l.Require().Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", alert.ProductId.String())
+		l.Require().Equal("ErgoClick Pro Wireless Mouse", alert.ProductName)
+		l.Require().Equal(int32(5), alert.StockQuantity)
+		l.Require().Equal(int32(5), alert.ReorderPoint)
+
+		lowStockAlertsSchema = l.lowStockAlertDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		l.Require().NotNil(lowStockAlertsSchema[0].NotifiedAt)
+
+		output, err = l.notifyLowStockAlertsUsecase.Execute()
+		l.Require().NoError(err)
+		l.Require().Equal(int64(0), output.NotifiedCount)
+	})
+}
+
+func (l *LowStockAlertsSuite) Test2() {
+	l.Run("given that the stock was replenished, when it drops below the reorder point again, then it queues a new alert", func() {
+		l.adjustStock(-6)
+		l.adjustStock(6)
+		l.adjustStock(-6)
+
+		lowStockAlertsSchema := l.lowStockAlertDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		l.Require().Len(lowStockAlertsSchema, 2)
+	})
+}
+
+func (l *LowStockAlertsSuite) Test3() {
+	l.Run("when listing low stock items, then it returns the inventories at or below their reorder point", func() {
+		l.productDAO.Create(daos.ProductSchema{
+			Id:          uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+			Name:        "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+			Description: utils.NewPointer("A split-design wireless ergonomic keyboard ..."),
+			Price:       99286,
+			CreatedAt:   time.Now().UTC(),
+		})
+		l.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+			ProductId:     uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+			StockQuantity: 2,
+			CreatedAt:     time.Now().UTC(),
+		})
+
+		response := l.testEnvironment.Request(l.accessToken, "POST", "/v1/admin/set-reorder-point", `
+			{
+				"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f",
+				"reorderPoint": 3
+			}
+		`)
+		l.Equal(204, response.StatusCode)
+
+		inventorySchema := l.inventoryDAO.FindOneByProductId(uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"))
+		l.Require().Equal(int32(3), inventorySchema.ReorderPoint)
+
+		response = l.testEnvironment.Request(l.accessToken, "GET", "/v1/admin/low-stock-items", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		l.Equal(200, response.StatusCode)
+		l.JSONEq(`
+			{
+				"data": {
+					"items": [
+						{
+							"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f",
+							"productId": "7ab00199-6f9c-4af7-ad54-a02503226282",
+							"productName": "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+							"warehouseId": "7c1d1a8e-2f4b-4e6a-9d3c-5b8e0f2a4c6d",
+							"warehouseName": "Main warehouse",
+							"stockQuantity": 2,
+							"reorderPoint": 3
+						}
+					],
+					"page": 1,
+					"pageSize": 20,
+					"totalItems": 1
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (l *LowStockAlertsSuite) Test4() {
+	l.Run("when setting the reorder point of an unknown inventory, then it returns 409", func() {
+		response := l.testEnvironment.Request(l.accessToken, "POST", "/v1/admin/set-reorder-point", `
+			{
+				"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f",
+				"reorderPoint": 3
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		l.Equal(409, response.StatusCode)
+		l.JSONEq(`
+			{
+				"message": "inventory not found"
+			}
+		`, string(body))
+	})
+}
+
+func (l *LowStockAlertsSuite) Test5() {
+	l.Run("when setting the reorder point and body is invalid, then returns 400", func() {
+		templates := []map[string]string{
+			{
+				"body": `{}`,
+				"error": `[
+					"inventoryId is required",
+					"reorderPoint is required"
+				]`,
+			},
+			{
+				"body": `{
+					"inventoryId": "x",
+					"reorderPoint": -1
+				}`,
+				"error": `[
+					"inventoryId must be uuidv4",
+					"reorderPoint must be positive"
+				]`,
+			},
+		}
+
+		for _, template := range templates {
+			response := l.testEnvironment.Request(l.accessToken, "POST", "/v1/admin/set-reorder-point", template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			l.Equal(400, response.StatusCode)
+			l.JSONEq(fmt.Sprintf(`
+				{
+					"message": %s
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func TestLowStockAlerts(t *testing.T) {
+	suite.Run(t, new(LowStockAlertsSuite))
+}
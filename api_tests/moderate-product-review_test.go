@@ -0,0 +1,160 @@
+package apitests_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModerateProductReviewSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	productDAO       daos.ProductDAO
+	productReviewDAO daos.ProductReviewDAO
+	accessToken      string
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (m *ModerateProductReviewSuite) SetupSuite() {
+	m.testEnvironment = testhelpers.NewTestEnvironment()
+	m.testEnvironment.Start()
+
+	m.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	m.customerDAO = daos.NewCustomerDAO(m.testEnvironment.PgxPool())
+	m.productDAO = daos.NewProductDAO(m.testEnvironment.PgxPool())
+	m.productReviewDAO = daos.NewProductReviewDAO(m.testEnvironment.PgxPool())
+}
+
+func (m *ModerateProductReviewSuite) SetupTest() {
+	m.customerDAO.DeletAll()
+	m.productDAO.DeletAll()
+	m.productReviewDAO.DeletAll()
+
+	m.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	m.productDAO.Create(daos.ProductSchema{
+		Id:        uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:    "published",
+		Name:      "ErgoClick Pro Wireless Mouse",
+		Price:     2999,
+		CreatedAt: time.Now().UTC(),
+	})
+	m.productReviewDAO.Create(daos.ProductReviewSchema{
+		Id:         uuid.MustParse("a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"),
+		ProductId:  uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Rating:     5,
+		Title:      "Best mouse I ever had",
+		Body:       "Comfortable and the battery lasts for weeks.",
+		Status:     "pending",
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (m *ModerateProductReviewSuite) moderateProductReview(body string) *http.Response {
+	return m.testEnvironment.Request(m.accessToken, "POST", "/v1/admin/moderate-product-review", body)
+}
+
+func (m *ModerateProductReviewSuite) Test1() {
+	m.Run("given that the review is pending, when approving it, then returns 204 and the review counts towards the product rating", func() {
+		response := m.moderateProductReview(`
+			{
+				"productReviewId": "a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21",
+				"status": "approved"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		m.Equal(204, response.StatusCode)
+		m.Equal("", string(body))
+
+		productReviewSchema := m.productReviewDAO.FindOneById(uuid.MustParse("a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"))
+		m.Require().NotNil(productReviewSchema)
+		m.Require().Equal("approved", productReviewSchema.Status)
+		m.Require().NotNil(productReviewSchema.ModeratedAt)
+
+		productResponse := utils.GetOrThrow(m.testEnvironment.Client().Get(m.testEnvironment.BaseUrl() +
+			"/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		productBody := utils.ParseJSONBody[map[string]map[string]any](productResponse.Body)
+		m.Equal(float64(5), productBody["data"]["averageRating"])
+		m.Equal(float64(1), productBody["data"]["reviewCount"])
+	})
+}
+
+func (m *ModerateProductReviewSuite) Test2() {
+	m.Run("given that the review is pending, when rejecting it, then returns 204 and the review is not counted", func() {
+		response := m.moderateProductReview(`
+			{
+				"productReviewId": "a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21",
+				"status": "rejected"
+			}
+		`)
+
+		m.Equal(204, response.StatusCode)
+
+		productReviewSchema := m.productReviewDAO.FindOneById(uuid.MustParse("a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21"))
+		m.Require().NotNil(productReviewSchema)
+		m.Require().Equal("rejected", productReviewSchema.Status)
+
+		productResponse := utils.GetOrThrow(m.testEnvironment.Client().Get(m.testEnvironment.BaseUrl() +
+			"/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		productBody := utils.ParseJSONBody[map[string]map[string]any](productResponse.Body)
+		m.Equal(float64(0), productBody["data"]["averageRating"])
+		m.Equal(float64(0), productBody["data"]["reviewCount"])
+	})
+}
+
+func (m *ModerateProductReviewSuite) Test3() {
+	m.Run("when moderating with an invalid status, then returns 409", func() {
+		response := m.moderateProductReview(`
+			{
+				"productReviewId": "a1b6a0a4-6a2b-4f5b-8f0e-3f6f5c9d7e21",
+				"status": "deleted"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		m.Equal(409, response.StatusCode)
+		m.JSONEq(`
+			{
+				"message": "status must be approved or rejected"
+			}
+		`, string(body))
+	})
+}
+
+func (m *ModerateProductReviewSuite) Test4() {
+	m.Run("given that the review does not exist, when moderating, then returns 409", func() {
+		response := m.moderateProductReview(`
+			{
+				"productReviewId": "0b6f1a3e-4d5c-4e8f-9a7b-2c1d3e4f5a6b",
+				"status": "approved"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		m.Equal(409, response.StatusCode)
+		m.JSONEq(`
+			{
+				"message": "product review not found"
+			}
+		`, string(body))
+	})
+}
+
+func TestModerateProductReview(t *testing.T) {
+	suite.Run(t, new(ModerateProductReviewSuite))
+}
@@ -0,0 +1,267 @@
+package apitests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrderCancellationsSuite struct {
+	suite.Suite
+	customerDAO               daos.CustomerDAO
+	addressDAO                daos.AddressDAO
+	productDAO                daos.ProductDAO
+	inventoryDAO              daos.InventoryDAO
+	cartDAO                   daos.CartDAO
+	cartItemDAO               daos.CartItemDAO
+	orderDAO                  daos.OrderDAO
+	orderItemDAO              daos.OrderItemDAO
+	paymentDAO                daos.PaymentDAO
+	refundDAO                 daos.RefundDAO
+	sendPendingRefundsUsecase usecases.SendPendingRefundsUsecase
+	accessToken               string
+	adminAccessToken          string
+	testEnvironment           *testhelpers.TestEnvironment
+}
+
+func (o *OrderCancellationsSuite) SetupSuite() {
+	o.testEnvironment = testhelpers.NewTestEnvironment()
+	o.testEnvironment.Start()
+
+	o.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+	o.adminAccessToken = testhelpers.TestGenerateAdminAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+
+	o.customerDAO = daos.NewCustomerDAO(o.testEnvironment.PgxPool())
+	o.addressDAO = daos.NewAddressDAO(o.testEnvironment.PgxPool())
+	o.productDAO = daos.NewProductDAO(o.testEnvironment.PgxPool())
+	o.inventoryDAO = daos.NewInventoryDAO(o.testEnvironment.PgxPool())
+	o.cartDAO = daos.NewCartDAO(o.testEnvironment.PgxPool())
+	o.cartItemDAO = daos.NewCartItemDAO(o.testEnvironment.PgxPool())
+	o.orderDAO = daos.NewOrderDAO(o.testEnvironment.PgxPool())
+	o.orderItemDAO = daos.NewOrderItemDAO(o.testEnvironment.PgxPool())
+	o.paymentDAO = daos.NewPaymentDAO(o.testEnvironment.PgxPool())
+	o.refundDAO = daos.NewRefundDAO(o.testEnvironment.PgxPool())
+	o.sendPendingRefundsUsecase = usecases.NewSendPendingRefundsUsecase(o.testEnvironment.PgxPool(), testhelpers.StubPaymentGateway{})
+}
+
+func (o *OrderCancellationsSuite) SetupTest() {
+	o.customerDAO.DeletAll()
+	o.addressDAO.DeletAll()
+	o.productDAO.DeletAll()
+	o.inventoryDAO.DeletAll()
+	o.cartDAO.DeletAll()
+	o.cartItemDAO.DeletAll()
+	o.orderDAO.DeletAll()
+	o.orderItemDAO.DeletAll()
+	o.paymentDAO.DeletAll()
+	o.refundDAO.DeletAll()
+
+	o.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	o.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	o.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	o.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 5,
+		CreatedAt:     time.Now().UTC(),
+	})
+	o.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+// placeOrder checks out two units of the product and returns the order.
+func (o *OrderCancellationsSuite) placeOrder() *daos.OrderSchema {
+	response := o.testEnvironment.Request(o.accessToken, "POST", "/v1/add-product-to-cart", `
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"quantity": 2
+		}
+	`)
+	o.Require().Equal(204, response.StatusCode)
+
+	response = o.testEnvironment.Request(o.accessToken, "POST",
+		"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+	o.Require().Equal(200, response.StatusCode)
+
+	return o.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+}
+
+func (o *OrderCancellationsSuite) cancelOrder(accessToken string, path string, orderId uuid.UUID) *http.Response {
+	return o.testEnvironment.Request(accessToken, "POST", path, fmt.Sprintf(`{"orderId": "%s"}`, orderId))
+}
+
+func (o *OrderCancellationsSuite) Test1() {
+	o.Run("given a placed order, when the customer cancels it, then returns 200, restores the stock and refunds the payment after commit", func() {
+		orderSchema := o.placeOrder()
+		o.Require().Equal(int32(3), o.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+
+		response := o.cancelOrder(o.accessToken, "/v1/cancel-order", orderSchema.Id)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(200, response.StatusCode)
+		o.JSONEq(fmt.Sprintf(`{"data": {"refundedAmount": %d}}`, orderSchema.TotalPrice), string(body))
+
+		o.Equal(int32(5), o.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+
+		orderSchema = o.orderDAO.FindOneById(orderSchema.Id)
+		o.Equal("cancelled", orderSchema.Status)
+		o.NotNil(orderSchema.CancelledAt)
+		o.Equal(utils.NewPointer(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")), orderSchema.CancelledBy)
+
+		paymentSchema := o.paymentDAO.FindOneByOrderId(orderSchema.Id)
+		o.Equal(orderSchema.TotalPrice, paymentSchema.RefundedAmount)
+
+		refundsSchema := o.refundDAO.FindAllByPaymentId(paymentSchema.Id)
+		o.Require().Len(refundsSchema, 1)
+		o.Equal(orderSchema.TotalPrice, refundsSchema[0].Amount)
+		o.Equal("USD", refundsSchema[0].Currency)
+		o.Equal("cancellation", refundsSchema[0].Reason)
+		o.Equal("pending", refundsSchema[0].Status)
+		o.Nil(refundsSchema[0].PaymentGatewayRefundId)
+
+		output, err := o.sendPendingRefundsUsecase.Execute()
+		o.Require().NoError(err)
+		o.Equal(int64(1), output.NotifiedCount)
+
+		refundsSchema = o.refundDAO.FindAllByPaymentId(paymentSchema.Id)
+		o.Equal("approved", refundsSchema[0].Status)
+		o.True(strings.HasPrefix(*refundsSchema[0].PaymentGatewayRefundId, "stub-"))
+		o.NotNil(refundsSchema[0].SentAt)
+	})
+}
+
+func (o *OrderCancellationsSuite) Test2() {
+	o.Run("given a cancelled order, when cancelling it again, then returns 409 and does not refund twice", func() {
+		orderSchema := o.placeOrder()
+		o.Require().Equal(200, o.cancelOrder(o.accessToken, "/v1/cancel-order", orderSchema.Id).StatusCode)
+
+		response := o.cancelOrder(o.adminAccessToken, "/v1/admin/cancel-order", orderSchema.Id)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(409, response.StatusCode)
+		o.JSONEq(`{"message": "order is already cancelled"}`, string(body))
+
+		o.Equal(int32(5), o.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+		o.Len(o.refundDAO.FindAllByPaymentId(o.paymentDAO.FindOneByOrderId(orderSchema.Id).Id), 1)
+	})
+}
+
+func (o *OrderCancellationsSuite) Test3() {
+	o.Run("given a fulfilled order, when the customer cancels it, then returns 409, and an admin can still cancel it", func() {
+		orderSchema := o.placeOrder()
+
+		response := o.testEnvironment.Request(o.adminAccessToken, "POST", "/v1/admin/fulfil-order", fmt.Sprintf(`{"orderId": "%s"}`, orderSchema.Id))
+		o.Require().Equal(204, response.StatusCode)
+		o.Equal("fulfilled", o.orderDAO.FindOneById(orderSchema.Id).Status)
+
+		response = o.cancelOrder(o.accessToken, "/v1/cancel-order", orderSchema.Id)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(409, response.StatusCode)
+		o.JSONEq(`{"message": "order cannot be cancelled after it is fulfilled"}`, string(body))
+
+		response = o.cancelOrder(o.adminAccessToken, "/v1/admin/cancel-order", orderSchema.Id)
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(200, response.StatusCode)
+		o.JSONEq(fmt.Sprintf(`{"data": {"refundedAmount": %d}}`, orderSchema.TotalPrice), string(body))
+		o.Equal("cancelled", o.orderDAO.FindOneById(orderSchema.Id).Status)
+		o.Equal(int32(5), o.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+	})
+}
+
+func (o *OrderCancellationsSuite) Test4() {
+	o.Run("given a cancelled order, when fulfilling it, then returns 409", func() {
+		orderSchema := o.placeOrder()
+		o.Require().Equal(200, o.cancelOrder(o.accessToken, "/v1/cancel-order", orderSchema.Id).StatusCode)
+
+		response := o.testEnvironment.Request(o.adminAccessToken, "POST", "/v1/admin/fulfil-order", fmt.Sprintf(`{"orderId": "%s"}`, orderSchema.Id))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(409, response.StatusCode)
+		o.JSONEq(`{"message": "order is cancelled"}`, string(body))
+	})
+}
+
+func (o *OrderCancellationsSuite) Test5() {
+	o.Run("given an order of another customer, when the customer cancels it, then returns 409", func() {
+		orderSchema := o.placeOrder()
+
+		accessToken := testhelpers.TestGenerateAccessToken(uuid.MustParse("4d2b7a0e-53a4-4a5b-9f5e-0c1f3a8b6d21"))
+
+		response := o.testEnvironment.Request(accessToken, "POST", "/v1/cancel-order", fmt.Sprintf(`{"orderId": "%s"}`, orderSchema.Id))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(409, response.StatusCode)
+		o.JSONEq(`{"message": "order not found"}`, string(body))
+		o.Equal("placed", o.orderDAO.FindOneById(orderSchema.Id).Status)
+	})
+}
+
+func (o *OrderCancellationsSuite) Test6() {
+	o.Run("when the body is invalid, then returns 400", func() {
+		response := o.testEnvironment.Request(o.accessToken, "POST", "/v1/cancel-order", `{"orderId": "123"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(400, response.StatusCode)
+		o.JSONEq(`{"message": ["orderId must be uuidv4"]}`, string(body))
+	})
+}
+
+func (o *OrderCancellationsSuite) Test7() {
+	o.Run("given a customer access token, when cancelling or fulfilling through the admin routes, then returns 403", func() {
+		orderSchema := o.placeOrder()
+
+		response := o.cancelOrder(o.accessToken, "/v1/admin/cancel-order", orderSchema.Id)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		o.Equal(403, response.StatusCode)
+		o.JSONEq(`{"message": "admin role is required"}`, string(body))
+
+		response = o.testEnvironment.Request(o.accessToken, "POST", "/v1/admin/fulfil-order", fmt.Sprintf(`{"orderId": "%s"}`, orderSchema.Id))
+		o.Equal(403, response.StatusCode)
+
+		o.Equal("placed", o.orderDAO.FindOneById(orderSchema.Id).Status)
+		o.Empty(o.refundDAO.FindAllByPaymentId(o.paymentDAO.FindOneByOrderId(orderSchema.Id).Id))
+	})
+}
+
+func TestOrderCancellationsSuite(t *testing.T) {
+	suite.Run(t, new(OrderCancellationsSuite))
+}
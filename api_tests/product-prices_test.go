@@ -0,0 +1,226 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductPricesSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	productDAO      daos.ProductDAO
+	productPriceDAO daos.ProductPriceDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (p *ProductPricesSuite) SetupSuite() {
+	p.testEnvironment = testhelpers.NewTestEnvironment()
+	p.testEnvironment.Start()
+
+	p.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	p.customerDAO = daos.NewCustomerDAO(p.testEnvironment.PgxPool())
+	p.productDAO = daos.NewProductDAO(p.testEnvironment.PgxPool())
+	p.productPriceDAO = daos.NewProductPriceDAO(p.testEnvironment.PgxPool())
+	p.inventoryDAO = daos.NewInventoryDAO(p.testEnvironment.PgxPool())
+	p.cartDAO = daos.NewCartDAO(p.testEnvironment.PgxPool())
+	p.cartItemDAO = daos.NewCartItemDAO(p.testEnvironment.PgxPool())
+}
+
+func (p *ProductPricesSuite) SetupTest() {
+	p.customerDAO.DeletAll()
+	p.productDAO.DeletAll()
+	p.productPriceDAO.DeletAll()
+	p.inventoryDAO.DeletAll()
+	p.cartDAO.DeletAll()
+	p.cartItemDAO.DeletAll()
+
+	p.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	p.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		Currency:    "USD",
+		CreatedAt:   time.Now().UTC(),
+	})
+	p.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+	p.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	p.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.MustParse("b999870f-f969-4d24-8955-499dbf3c689e"),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (p *ProductPricesSuite) Test1() {
+	p.Run("when setting a price in another currency, then returns 204 and adds it to the product price list", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-price", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"currency": "brl",
+				"price": 14990
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+
+		productPriceSchema := p.productPriceDAO.FindOneByProductIdAndCurrency(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"), "BRL")
+		p.Require().NotNil(productPriceSchema)
+		p.Require().Equal(int64(14990), productPriceSchema.Price)
+
+		response = utils.GetOrThrow(p.testEnvironment.Client().Get(p.testEnvironment.BaseUrl() + "/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.Contains(string(body), `"price":2999,"currency":"USD"`)
+		p.Contains(string(body), `"prices":[{"currency":"BRL","price":14990}]`)
+	})
+}
+
+func (p *ProductPricesSuite) Test2() {
+	p.Run("when setting a price in the product base currency, then returns 204 and updates the product price", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-price", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"currency": "USD",
+				"price": 3499
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+
+		productSchema := p.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		p.Require().Equal(int64(3499), productSchema.Price)
+		p.Require().Empty(p.productPriceDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+	})
+}
+
+func (p *ProductPricesSuite) Test3() {
+	p.Run("when setting a price in an unsupported currency, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-price", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"currency": "XYZ",
+				"price": 3499
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "currency is not supported"}`, string(body))
+	})
+}
+
+func (p *ProductPricesSuite) Test4() {
+	p.Run("given that a cart item has no price in the currency, when switching the cart currency, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/set-cart-currency", `{"currency": "BRL"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "some products in the cart are not priced in this currency"}`, string(body))
+	})
+}
+
+func (p *ProductPricesSuite) Test5() {
+	p.Run("given that the cart items are priced in the currency, when switching the cart currency, then the cart is priced from the price list", func() {
+		p.productPriceDAO.Create(daos.ProductPriceSchema{
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Currency:  "BRL",
+			Price:     14990,
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+		})
+
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/set-cart-currency", `{"currency": "BRL"}`)
+		p.Equal(204, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "GET", "/v1/cart", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.JSONEq(`
+			{
+				"data": {
+					"cartId": "bb8357b2-b978-4675-9521-ef2da0bd1747",
+					"version": 1,
+					"currency": "BRL",
+					"totalItems": 1,
+					"totalQuantity": 2,
+					"subtotalPrice": 29980,
+					"couponCode": null,
+					"discounts": [],
+					"freeShipping": false,
+					"shippingMethod": null,
+					"shippingPrice": 0,
+					"taxState": null,
+					"taxPrice": 0,
+					"taxes": [],
+					"totalPrice": 29980,
+					"items": [
+						{
+							"id": "b999870f-f969-4d24-8955-499dbf3c689e",
+							"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+							"name": "ErgoClick Pro Wireless Mouse",
+							"description": "Ergonomically designed wireless optical mouse ...",
+							"quantity": 2,
+							"price": 14990
+						}
+					],
+					"warnings": [],
+					"relatedProducts": []
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (p *ProductPricesSuite) Test6() {
+	p.Run("given a cart in a currency the product is not priced in, when adding the product to cart, then returns 409", func() {
+		p.cartItemDAO.DeletAll()
+		_ = utils.GetOrThrow(p.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE carts SET currency = 'BRL'"))
+
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/add-product-to-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "product is not priced in the cart currency"}`, string(body))
+	})
+}
+
+func TestProductPricesSuite(t *testing.T) {
+	suite.Run(t, new(ProductPricesSuite))
+}
@@ -0,0 +1,270 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProductRecommendationsSuite struct {
+	suite.Suite
+	customerDAO              daos.CustomerDAO
+	productDAO               daos.ProductDAO
+	inventoryDAO             daos.InventoryDAO
+	orderDAO                 daos.OrderDAO
+	orderItemDAO             daos.OrderItemDAO
+	cartDAO                  daos.CartDAO
+	cartItemDAO              daos.CartItemDAO
+	productRecommendationDAO daos.ProductRecommendationDAO
+	accessToken              string
+	testEnvironment          *testhelpers.TestEnvironment
+}
+
+func (p *ProductRecommendationsSuite) SetupSuite() {
+	p.testEnvironment = testhelpers.NewTestEnvironment()
+	p.testEnvironment.Start()
+
+	p.accessToken = testhelpers.TestGenerateAccessToken(uuid.New())
+
+	p.customerDAO = daos.NewCustomerDAO(p.testEnvironment.PgxPool())
+	p.productDAO = daos.NewProductDAO(p.testEnvironment.PgxPool())
+	p.inventoryDAO = daos.NewInventoryDAO(p.testEnvironment.PgxPool())
+	p.orderDAO = daos.NewOrderDAO(p.testEnvironment.PgxPool())
+	p.orderItemDAO = daos.NewOrderItemDAO(p.testEnvironment.PgxPool())
+	p.cartDAO = daos.NewCartDAO(p.testEnvironment.PgxPool())
+	p.cartItemDAO = daos.NewCartItemDAO(p.testEnvironment.PgxPool())
+	p.productRecommendationDAO = daos.NewProductRecommendationDAO(p.testEnvironment.PgxPool())
+}
+
+func (p *ProductRecommendationsSuite) SetupTest() {
+	p.customerDAO.DeletAll()
+	p.productDAO.DeletAll()
+	p.inventoryDAO.DeletAll()
+	p.orderDAO.DeletAll()
+	p.orderItemDAO.DeletAll()
+	p.cartDAO.DeletAll()
+	p.cartItemDAO.DeletAll()
+	p.productRecommendationDAO.DeletAll()
+
+	p.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+
+	products := []daos.ProductSchema{
+		{Id: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"), Status: "published", Name: "ErgoClick Pro Wireless Mouse", Price: 2999},
+		{Id: uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"), Status: "published", Name: "Kinesis Freestyle2 Wireless Ergonomic Keyboard", Price: 99286},
+		{Id: uuid.MustParse("b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2"), Status: "unpublished", Name: "JBL Tune 520BT Wireless Headphones", Price: 22167},
+		{Id: uuid.MustParse("3e2a1f4c-6b7d-4c8e-9f0a-1b2c3d4e5f60"), Status: "published", Name: "SteelSeries QcK Mouse Pad", Price: 1499},
+		{Id: uuid.MustParse("8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d"), Status: "published", Name: "Dell UltraSharp 27 Monitor", Price: 45999},
+	}
+	for _, product := range products {
+		product.CreatedAt = time.Now().UTC()
+		p.productDAO.Create(product)
+	}
+
+	for productId, stock := range map[string]int32{
+		"c0981e5b-9cb7-4623-9713-55db0317dc1a": 10,
+		"7ab00199-6f9c-4af7-ad54-a02503226282": 10,
+		"b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2": 10,
+		"3e2a1f4c-6b7d-4c8e-9f0a-1b2c3d4e5f60": 0,
+		"8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d": 10,
+	} {
+		p.inventoryDAO.Create(daos.InventorySchema{
+			Id:            uuid.New(),
+			ProductId:     uuid.MustParse(productId),
+			StockQuantity: stock,
+			CreatedAt:     time.Now().UTC(),
+		})
+	}
+
+	orders := [][]string{
+		{"c0981e5b-9cb7-4623-9713-55db0317dc1a", "7ab00199-6f9c-4af7-ad54-a02503226282", "b0d11d8f-d8a7-4f2a-81ad-5df8c0db75d2", "3e2a1f4c-6b7d-4c8e-9f0a-1b2c3d4e5f60"},
+		{"c0981e5b-9cb7-4623-9713-55db0317dc1a", "7ab00199-6f9c-4af7-ad54-a02503226282"},
+		{"c0981e5b-9cb7-4623-9713-55db0317dc1a", "8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d"},
+	}
+	for _, productIds := range orders {
+		orderId := uuid.New()
+		p.orderDAO.Create(daos.OrderSchema{
+			Id:            orderId,
+			CustomerId:    uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			TotalPrice:    0,
+			TotalQuantity: int32(len(productIds)),
+			CreatedAt:     time.Now().UTC(),
+		})
+
+		for _, productId := range productIds {
+			p.orderItemDAO.Create(daos.OrderItemSchema{
+				Id:        uuid.New(),
+				OrderId:   orderId,
+				ProductId: uuid.MustParse(productId),
+				Quantity:  1,
+				Price:     0,
+				CreatedAt: time.Now().UTC(),
+			})
+		}
+	}
+}
+
+func (p *ProductRecommendationsSuite) Test1() {
+	p.Run("when computing recommendations, then returns 200 and stores co-purchase scores", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/compute-product-recommendations", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.JSONEq(`
+			{
+				"data": {
+					"recommendationsCount": 14
+				}
+			}
+		`, string(body))
+
+		productRecommendationsSchema := p.productRecommendationDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		p.Require().Len(productRecommendationsSchema, 4)
+		p.Require().Equal(uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"), productRecommendationsSchema[0].RelatedProductId)
+		p.Require().Equal(int32(2), productRecommendationsSchema[0].Score)
+	})
+}
+
+func (p *ProductRecommendationsSuite) Test2() {
+	p.Run("given computed recommendations, when getting a product, then returns only published and in-stock related products", func() {
+		p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/compute-product-recommendations", "")
+
+		response := utils.GetOrThrow(p.testEnvironment.Client().Get(p.testEnvironment.BaseUrl() + "/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.JSONEq(`
+			{
+				"data": {
+					"id": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"slug": null,
+					"name": "ErgoClick Pro Wireless Mouse",
+					"description": null,
+					"price": 2999,
+					"currency": "USD",
+					"inStock": true,
+					"averageRating": 0,
+					"reviewCount": 0,
+					"prices": [],
+					"relatedProducts": [
+						{
+							"id": "7ab00199-6f9c-4af7-ad54-a02503226282",
+							"slug": null,
+							"name": "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+							"price": 99286,
+							"currency": "USD"
+						},
+						{
+							"id": "8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d",
+							"slug": null,
+							"name": "Dell UltraSharp 27 Monitor",
+							"price": 45999,
+							"currency": "USD"
+						}
+					]
+				}
+			}
+		`, string(body))
+	})
+}
+
+func (p *ProductRecommendationsSuite) Test3() {
+	p.Run("given a pinned related product, when getting a product, then the pinned product comes first", func() {
+		p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/compute-product-recommendations", "")
+
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/pin-related-product", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"relatedProductId": "8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d",
+				"position": 1
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+
+		response = utils.GetOrThrow(p.testEnvironment.Client().Get(p.testEnvironment.BaseUrl() + "/v1/products/c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.Contains(string(body), `"relatedProducts":[{"id":"8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d"`)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/unpin-related-product", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"relatedProductId": "8d9c0b1a-2e3f-4a5b-8c6d-7e8f9a0b1c2d"
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+		p.Empty(p.productRecommendationDAO.FindAllPinsByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+	})
+}
+
+func (p *ProductRecommendationsSuite) Test4() {
+	p.Run("given computed recommendations, when getting the cart, then returns related products not already in the cart", func() {
+		p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/compute-product-recommendations", "")
+
+		p.cartDAO.Create(daos.CartSchema{
+			Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+			CreatedAt:  time.Now().UTC(),
+		})
+		p.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.MustParse("b999870f-f969-4d24-8955-499dbf3c689e"),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+			Quantity:  1,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		response := p.testEnvironment.Request(p.accessToken, "GET", "/v1/cart", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(200, response.StatusCode)
+		p.Contains(string(body), `"relatedProducts":[{"id":"c0981e5b-9cb7-4623-9713-55db0317dc1a","slug":null,"name":"ErgoClick Pro Wireless Mouse","price":2999,"currency":"USD"}]`)
+	})
+}
+
+func (p *ProductRecommendationsSuite) Test5() {
+	p.Run("when pinning a product to itself, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/pin-related-product", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"relatedProductId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"position": 1
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "a product cannot be related to itself"}`, string(body))
+	})
+}
+
+func (p *ProductRecommendationsSuite) Test6() {
+	p.Run("when unpinning a product that is not pinned, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/unpin-related-product", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"relatedProductId": "7ab00199-6f9c-4af7-ad54-a02503226282"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "related product is not pinned"}`, string(body))
+	})
+}
+
+func TestProductRecommendationsSuite(t *testing.T) {
+	suite.Run(t, new(ProductRecommendationsSuite))
+}
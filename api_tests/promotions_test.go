@@ -0,0 +1,302 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type PromotionsSuite struct {
+	suite.Suite
+	customerDAO       daos.CustomerDAO
+	addressDAO        daos.AddressDAO
+	productDAO        daos.ProductDAO
+	inventoryDAO      daos.InventoryDAO
+	cartDAO           daos.CartDAO
+	cartItemDAO       daos.CartItemDAO
+	orderDAO          daos.OrderDAO
+	promotionDAO      daos.PromotionDAO
+	orderDiscountDAO  daos.OrderDiscountDAO
+	shippingMethodDAO daos.ShippingMethodDAO
+	accessToken       string
+	testEnvironment   *testhelpers.TestEnvironment
+}
+
+func (p *PromotionsSuite) SetupSuite() {
+	p.testEnvironment = testhelpers.NewTestEnvironment()
+	p.testEnvironment.Start()
+
+	p.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	p.customerDAO = daos.NewCustomerDAO(p.testEnvironment.PgxPool())
+	p.addressDAO = daos.NewAddressDAO(p.testEnvironment.PgxPool())
+	p.productDAO = daos.NewProductDAO(p.testEnvironment.PgxPool())
+	p.inventoryDAO = daos.NewInventoryDAO(p.testEnvironment.PgxPool())
+	p.cartDAO = daos.NewCartDAO(p.testEnvironment.PgxPool())
+	p.cartItemDAO = daos.NewCartItemDAO(p.testEnvironment.PgxPool())
+	p.orderDAO = daos.NewOrderDAO(p.testEnvironment.PgxPool())
+	p.promotionDAO = daos.NewPromotionDAO(p.testEnvironment.PgxPool())
+	p.orderDiscountDAO = daos.NewOrderDiscountDAO(p.testEnvironment.PgxPool())
+	p.shippingMethodDAO = daos.NewShippingMethodDAO(p.testEnvironment.PgxPool())
+}
+
+func (p *PromotionsSuite) SetupTest() {
+	p.orderDiscountDAO.DeletAll()
+	p.orderDAO.DeletAll()
+	p.promotionDAO.DeletAll()
+	p.cartItemDAO.DeletAll()
+	p.cartDAO.DeletAll()
+	p.shippingMethodDAO.DeletAll()
+	p.addressDAO.DeletAll()
+	p.customerDAO.DeletAll()
+	p.inventoryDAO.DeletAll()
+	p.productDAO.DeletAll()
+
+	p.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	p.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	p.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	p.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 50,
+		CreatedAt:     time.Now().UTC(),
+	})
+	p.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	p.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  4,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (p *PromotionsSuite) cart() map[string]any {
+	response := p.testEnvironment.Request(p.accessToken, "GET", "/v1/cart", "")
+	p.Require().Equal(200, response.StatusCode)
+
+	return utils.ParseJSONBody[map[string]map[string]any](response.Body)["data"]
+}
+
+func (p *PromotionsSuite) checkout() {
+	response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747"+
+		"&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+	p.Require().Equal(200, response.StatusCode)
+}
+
+func (p *PromotionsSuite) Test1() {
+	p.Run("given a percentage coupon, when applying it and checking out, then the cart and order are discounted and the discount is kept", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "save10",
+				"type": "percentage",
+				"percentage": 10
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "SAVE10"}`)
+		p.Require().Equal(204, response.StatusCode)
+
+		cart := p.cart()
+		p.Equal("SAVE10", cart["couponCode"])
+		p.Equal(float64(11996), cart["subtotalPrice"])
+		p.Equal(float64(10797), cart["totalPrice"])
+		p.Equal([]any{map[string]any{"code": "SAVE10", "type": "percentage", "amount": float64(1199)}}, cart["discounts"])
+
+		p.checkout()
+
+		orderSchema := p.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		p.Require().NotNil(orderSchema)
+		p.Equal(int64(10797), orderSchema.TotalPrice)
+
+		orderDiscountsSchema := p.orderDiscountDAO.FindAllByOrderId(orderSchema.Id)
+		p.Require().Len(orderDiscountsSchema, 1)
+		p.Equal("SAVE10", orderDiscountsSchema[0].Code)
+		p.Equal(int64(1199), orderDiscountsSchema[0].Amount)
+
+		p.Nil(p.cartDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")).PromotionId)
+	})
+}
+
+func (p *PromotionsSuite) Test2() {
+	p.Run("given a buy 2 get 1 coupon, when applying it, then every third unit is free", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "B2G1",
+				"type": "buy_x_get_y",
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"buyQuantity": 2,
+				"getQuantity": 1
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "b2g1"}`)
+		p.Require().Equal(204, response.StatusCode)
+
+		cart := p.cart()
+		p.Equal(float64(8997), cart["totalPrice"])
+	})
+}
+
+func (p *PromotionsSuite) Test3() {
+	p.Run("given a coupon with a minimum subtotal above the cart, when applying it, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "BIGSPENDER",
+				"type": "fixed_amount",
+				"amount": 1000,
+				"currency": "USD",
+				"minimumSubtotal": 20000
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "BIGSPENDER"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "cart subtotal is below the coupon minimum"}`, string(body))
+	})
+}
+
+func (p *PromotionsSuite) Test4() {
+	p.Run("given a coupon used up to its per-customer limit, when applying it again, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "ONCE",
+				"type": "free_shipping",
+				"perCustomerLimit": 1
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "ONCE"}`)
+		p.Require().Equal(204, response.StatusCode)
+		p.Equal(true, p.cart()["freeShipping"])
+
+		p.checkout()
+
+		p.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.New(),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:  1,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "ONCE"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "coupon was already used the maximum number of times"}`, string(body))
+	})
+}
+
+func (p *PromotionsSuite) Test5() {
+	p.Run("given a coupon that has ended, when applying it, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "EXPIRED",
+				"type": "percentage",
+				"percentage": 50,
+				"startsAt": "2020-01-01T00:00:00Z",
+				"endsAt": "2020-02-01T00:00:00Z"
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "EXPIRED"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "coupon is not active"}`, string(body))
+	})
+}
+
+func (p *PromotionsSuite) Test6() {
+	p.Run("given a coupon that ends after the payment preference was created, when the payment comes in, then the order keeps the quoted discount and flags the new total", func() {
+		p.shippingMethodDAO.Create(daos.ShippingMethodSchema{
+			Id:        uuid.MustParse("5b1f2c3d-8e7a-4b6c-9d0e-1f2a3b4c5d6e"),
+			Name:      "Standard",
+			Type:      "flat",
+			Rate:      utils.NewPointer(int64(500)),
+			IsActive:  true,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "save10",
+				"type": "percentage",
+				"percentage": 10
+			}
+		`)
+		p.Require().Equal(201, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/apply-coupon", `{"code": "SAVE10"}`)
+		p.Require().Equal(204, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/select-shipping-method", `{"shippingMethodId": "5b1f2c3d-8e7a-4b6c-9d0e-1f2a3b4c5d6e"}`)
+		p.Require().Equal(204, response.StatusCode)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/checkout-prepayment", "")
+		p.Require().Equal(200, response.StatusCode)
+
+		_ = utils.GetOrThrow(p.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE promotions SET ends_at = $1",
+			time.Now().UTC()))
+
+		p.checkout()
+
+		orderSchema := p.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		p.Require().NotNil(orderSchema)
+		p.Equal(int64(11297), orderSchema.TotalPrice)
+		p.Require().NotNil(orderSchema.RepricedTotal)
+		p.Equal(int64(12496), *orderSchema.RepricedTotal)
+
+		orderDiscountsSchema := p.orderDiscountDAO.FindAllByOrderId(orderSchema.Id)
+		p.Require().Len(orderDiscountsSchema, 1)
+		p.Equal("SAVE10", orderDiscountsSchema[0].Code)
+		p.Equal(int64(1199), orderDiscountsSchema[0].Amount)
+	})
+}
+
+func TestPromotions(t *testing.T) {
+	suite.Run(t, new(PromotionsSuite))
+}
@@ -0,0 +1,224 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type PurchaseLimitsSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	orderDAO        daos.OrderDAO
+	orderItemDAO    daos.OrderItemDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (p *PurchaseLimitsSuite) SetupSuite() {
+	p.testEnvironment = testhelpers.NewTestEnvironment()
+	p.testEnvironment.Start()
+
+	p.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	p.customerDAO = daos.NewCustomerDAO(p.testEnvironment.PgxPool())
+	p.productDAO = daos.NewProductDAO(p.testEnvironment.PgxPool())
+	p.inventoryDAO = daos.NewInventoryDAO(p.testEnvironment.PgxPool())
+	p.cartDAO = daos.NewCartDAO(p.testEnvironment.PgxPool())
+	p.cartItemDAO = daos.NewCartItemDAO(p.testEnvironment.PgxPool())
+	p.orderDAO = daos.NewOrderDAO(p.testEnvironment.PgxPool())
+	p.orderItemDAO = daos.NewOrderItemDAO(p.testEnvironment.PgxPool())
+}
+
+func (p *PurchaseLimitsSuite) SetupTest() {
+	p.orderItemDAO.DeletAll()
+	p.orderDAO.DeletAll()
+	p.cartItemDAO.DeletAll()
+	p.cartDAO.DeletAll()
+	p.customerDAO.DeletAll()
+	p.inventoryDAO.DeletAll()
+	p.productDAO.DeletAll()
+
+	p.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	p.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	p.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+	p.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	p.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (p *PurchaseLimitsSuite) createOrder(quantity int32, createdAt time.Time) {
+	orderId := uuid.New()
+
+	p.orderDAO.Create(daos.OrderSchema{
+		Id:            orderId,
+		CustomerId:    uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		TotalPrice:    2999 * int64(quantity),
+		TotalQuantity: quantity,
+		CreatedAt:     createdAt,
+	})
+	p.orderItemDAO.Create(daos.OrderItemSchema{
+		Id:        uuid.New(),
+		OrderId:   orderId,
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  quantity,
+		Price:     2999,
+		CreatedAt: createdAt,
+	})
+}
+
+func (p *PurchaseLimitsSuite) Test1() {
+	p.Run("given a product in the cart, when adding more than the stock left, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/add-product-to-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 9
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "product quantity exceeds the stock available"}`, string(body))
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/increase-product-quantity-in-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 9
+			}
+		`)
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "product quantity exceeds the stock available"}`, string(body))
+	})
+}
+
+func (p *PurchaseLimitsSuite) Test2() {
+	p.Run("given a maximum per order, when adding to the cart past it, then returns 409 and keeps the cart", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-purchase-limits", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"maxPerOrder": 3
+			}
+		`)
+		p.Require().Equal(204, response.StatusCode)
+		p.Equal(utils.NewPointer(int32(3)), p.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).MaxPerOrder)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/add-product-to-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 2
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "product quantity exceeds the maximum per order"}`, string(body))
+
+		cartItemSchema := p.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		p.Equal(int32(2), cartItemSchema.Quantity)
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/add-product-to-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+	})
+}
+
+func (p *PurchaseLimitsSuite) Test3() {
+	p.Run("given a maximum per customer, when increasing the cart past what is left of it, then returns 409 until the orders leave the period", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-purchase-limits", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"maxPerCustomer": 4,
+				"periodDays": 30
+			}
+		`)
+		p.Require().Equal(204, response.StatusCode)
+
+		p.createOrder(2, time.Now().UTC().AddDate(0, 0, -5))
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/increase-product-quantity-in-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "product quantity exceeds the maximum per customer"}`, string(body))
+
+		_ = utils.GetOrThrow(p.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE orders SET created_at = created_at - INTERVAL '30 days'"))
+
+		response = p.testEnvironment.Request(p.accessToken, "POST", "/v1/increase-product-quantity-in-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+		p.Equal(204, response.StatusCode)
+	})
+}
+
+func (p *PurchaseLimitsSuite) Test4() {
+	p.Run("given a maximum per customer without a period, when setting the purchase limits, then returns 409", func() {
+		response := p.testEnvironment.Request(p.accessToken, "POST", "/v1/admin/set-product-purchase-limits", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"maxPerCustomer": 4
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		p.Equal(409, response.StatusCode)
+		p.JSONEq(`{"message": "maximum per customer and period days must be set together"}`, string(body))
+	})
+}
+
+func TestPurchaseLimits(t *testing.T) {
+	suite.Run(t, new(PurchaseLimitsSuite))
+}
@@ -0,0 +1,258 @@
+package apitests_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type RestockSubscriptionsSuite struct {
+	suite.Suite
+	customerDAO                     daos.CustomerDAO
+	productDAO                      daos.ProductDAO
+	inventoryDAO                    daos.InventoryDAO
+	restockSubscriptionDAO          daos.RestockSubscriptionDAO
+	notifyRestockSubscribersUsecase usecases.NotifyRestockSubscribersUsecase
+	accessToken                     string
+	testEnvironment                 *testhelpers.TestEnvironment
+}
+
+func (r *RestockSubscriptionsSuite) SetupSuite() {
+	r.testEnvironment = testhelpers.NewTestEnvironment()
+	r.testEnvironment.Start()
+
+	r.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	r.customerDAO = daos.NewCustomerDAO(r.testEnvironment.PgxPool())
+	r.productDAO = daos.NewProductDAO(r.testEnvironment.PgxPool())
+	r.inventoryDAO = daos.NewInventoryDAO(r.testEnvironment.PgxPool())
+	r.restockSubscriptionDAO = daos.NewRestockSubscriptionDAO(r.testEnvironment.PgxPool())
+	r.notifyRestockSubscribersUsecase = usecases.NewNotifyRestockSubscribersUsecase(r.testEnvironment.PgxPool(),
+		gateways.NewRabbitmqRestockNotifier(r.testEnvironment.RabbitmqConn()), "https://shop.example.com")
+}
+
+func (r *RestockSubscriptionsSuite) SetupTest() {
+	r.restockSubscriptionDAO.DeletAll()
+	r.customerDAO.DeletAll()
+	r.productDAO.DeletAll()
+	r.inventoryDAO.DeletAll()
+
+	channel := utils.GetOrThrow(r.testEnvironment.RabbitmqConn().Channel())
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	_ = utils.GetOrThrow(channel.QueueDeclare(gateways.RestockNotificationsQueue, true, false, false, false, nil))
+	_ = utils.GetOrThrow(channel.QueuePurge(gateways.RestockNotificationsQueue, false))
+
+	r.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	r.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	r.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 0,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (r *RestockSubscriptionsSuite) subscribe() *http.Response {
+	return r.testEnvironment.Request(r.accessToken, "POST", "/v1/subscribe-to-restock", `
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"
+		}
+	`)
+}
+
+func (r *RestockSubscriptionsSuite) addStock(stock int32) {
+	response := r.testEnvironment.Request(r.accessToken, "POST", "/v1/admin/add-stock", fmt.Sprintf(`
+		{
+			"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+			"stock": %d
+		}
+	`, stock))
+	r.Require().Equal(204, response.StatusCode)
+}
+
+func (r *RestockSubscriptionsSuite) Test1() {
+	r.Run("given that the product is out of stock, when it is restocked, then it notifies the subscriber once with an unsubscribe link", func() {
+		response := r.subscribe()
+		r.Equal(204, response.StatusCode)
+
+		response = r.subscribe()
+		r.Equal(204, response.StatusCode)
+
+		restockSubscriptionsSchema := r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().Len(restockSubscriptionsSchema, 1)
+		r.Require().Nil(restockSubscriptionsSchema[0].NotifiedAt)
+
+		output, err := r.notifyRestockSubscribersUsecase.Execute()
+		r.Require().NoError(err)
+		r.Require().Equal(int64(0), output.NotifiedCount)
+
+		r.addStock(5)
+
+		restockSubscriptionsSchema = r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().NotNil(restockSubscriptionsSchema[0].QueuedAt)
+
+		output, err = r.notifyRestockSubscribersUsecase.Execute()
+		r.Require().NoError(err)
+		r.Require().Equal(int64(1), output.NotifiedCount)
+
+		channel := utils.GetOrThrow(r.testEnvironment.RabbitmqConn().Channel())
+		defer func() {
+			_ = channel.Close()
+		}()
+
+		message, ok, err := channel.Get(gateways.RestockNotificationsQueue, true)
+		r.Require().NoError(err)
+		r.Require().True(ok)
+		r.Require().Equal(restockSubscriptionsSchema[0].Id.String(), message.MessageId)
+
+		var notification gateways.RestockNotification
+		utils.ThrowOnError(json.Unmarshal(message.Body, &notification))
+		r.Require().Equal("f59207c8-e837-4159-b67d-78c716510747", notification.CustomerId.String())
+		r.Require().Equal("john.doe@gmail.com", notification.CustomerEmail)
+		r.Require().Equal("c0981e5b-9cb7-4623-9713-55db0317dc1a", notification.ProductId.String())
+		r.Require().Equal("ErgoClick Pro Wireless Mouse", notification.ProductName)
+		r.Require().Equal("https://shop.example.com/v1/unsubscribe-from-restock?token="+
+			url.QueryEscape(restockSubscriptionsSchema[0].UnsubscribeToken), notification.UnsubscribeUrl)
+
+		restockSubscriptionsSchema = r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().NotNil(restockSubscriptionsSchema[0].NotifiedAt)
+
+		output, err = r.notifyRestockSubscribersUsecase.Execute()
+		r.Require().NoError(err)
+		r.Require().Equal(int64(0), output.NotifiedCount)
+	})
+}
+
+func (r *RestockSubscriptionsSuite) Test2() {
+	r.Run("given that the customer unsubscribed, when the product is restocked, then it does not notify them", func() {
+		response := r.subscribe()
+		r.Equal(204, response.StatusCode)
+
+		restockSubscriptionsSchema := r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().Len(restockSubscriptionsSchema, 1)
+
+		unsubscribeUrl := r.testEnvironment.BaseUrl() + "/v1/unsubscribe-from-restock?token=" +
+			url.QueryEscape(restockSubscriptionsSchema[0].UnsubscribeToken)
+
+		response = utils.GetOrThrow(r.testEnvironment.Client().Get(unsubscribeUrl))
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(200, response.StatusCode)
+		r.JSONEq(`
+			{
+				"data": {
+					"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+					"productName": "ErgoClick Pro Wireless Mouse",
+					"unsubscribed": false
+				}
+			}
+		`, string(body))
+
+		restockSubscriptionSchema := r.restockSubscriptionDAO.FindOneByUnsubscribeToken(restockSubscriptionsSchema[0].UnsubscribeToken)
+		r.Require().Nil(restockSubscriptionSchema.UnsubscribedAt)
+
+		response = utils.GetOrThrow(r.testEnvironment.Client().Post(unsubscribeUrl, "application/x-www-form-urlencoded",
+			strings.NewReader("List-Unsubscribe=One-Click")))
+		r.Equal(204, response.StatusCode)
+
+		restockSubscriptionSchema = r.restockSubscriptionDAO.FindOneByUnsubscribeToken(restockSubscriptionsSchema[0].UnsubscribeToken)
+		r.Require().NotNil(restockSubscriptionSchema.UnsubscribedAt)
+
+		r.addStock(5)
+
+		output, err := r.notifyRestockSubscribersUsecase.Execute()
+		r.Require().NoError(err)
+		r.Require().Equal(int64(0), output.NotifiedCount)
+	})
+}
+
+func (r *RestockSubscriptionsSuite) Test3() {
+	r.Run("given that the product is in stock, when subscribing, then it returns 409", func() {
+		r.addStock(5)
+
+		response := r.subscribe()
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`
+			{
+				"message": "product is in stock"
+			}
+		`, string(body))
+	})
+}
+
+func (r *RestockSubscriptionsSuite) Test4() {
+	r.Run("when unsubscribing with an unknown token, then it returns 409", func() {
+		response := utils.GetOrThrow(r.testEnvironment.Client().Post(r.testEnvironment.BaseUrl()+
+			"/v1/unsubscribe-from-restock?token=unknown", "application/x-www-form-urlencoded", strings.NewReader("List-Unsubscribe=One-Click")))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`
+			{
+				"message": "subscription not found"
+			}
+		`, string(body))
+	})
+}
+
+func (r *RestockSubscriptionsSuite) Test5() {
+	r.Run("given that the product was already back in stock, when it is restocked again, then it does not queue the subscriber twice", func() {
+		response := r.subscribe()
+		r.Require().Equal(204, response.StatusCode)
+
+		r.addStock(5)
+
+		restockSubscriptionsSchema := r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().Len(restockSubscriptionsSchema, 1)
+		r.Require().NotNil(restockSubscriptionsSchema[0].QueuedAt)
+		queuedAt := *restockSubscriptionsSchema[0].QueuedAt
+
+		r.addStock(5)
+
+		restockSubscriptionsSchema = r.restockSubscriptionDAO.FindAllByCustomerIdAndProductId(
+			uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"), uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		r.Require().True(queuedAt.Equal(*restockSubscriptionsSchema[0].QueuedAt))
+
+		output, err := r.notifyRestockSubscribersUsecase.Execute()
+		r.Require().NoError(err)
+		r.Require().Equal(int64(1), output.NotifiedCount)
+	})
+}
+
+func TestRestockSubscriptions(t *testing.T) {
+	suite.Run(t, new(RestockSubscriptionsSuite))
+}
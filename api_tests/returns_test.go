@@ -0,0 +1,385 @@
+package apitests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReturnsSuite struct {
+	suite.Suite
+	customerDAO      daos.CustomerDAO
+	addressDAO       daos.AddressDAO
+	productDAO       daos.ProductDAO
+	inventoryDAO     daos.InventoryDAO
+	cartDAO          daos.CartDAO
+	cartItemDAO      daos.CartItemDAO
+	orderDAO         daos.OrderDAO
+	orderItemDAO     daos.OrderItemDAO
+	paymentDAO       daos.PaymentDAO
+	refundDAO        daos.RefundDAO
+	returnRequestDAO daos.ReturnRequestDAO
+	accessToken      string
+	adminAccessToken string
+	testEnvironment  *testhelpers.TestEnvironment
+}
+
+func (r *ReturnsSuite) SetupSuite() {
+	r.testEnvironment = testhelpers.NewTestEnvironment()
+	r.testEnvironment.Start()
+
+	r.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+	r.adminAccessToken = testhelpers.TestGenerateAdminAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+
+	r.customerDAO = daos.NewCustomerDAO(r.testEnvironment.PgxPool())
+	r.addressDAO = daos.NewAddressDAO(r.testEnvironment.PgxPool())
+	r.productDAO = daos.NewProductDAO(r.testEnvironment.PgxPool())
+	r.inventoryDAO = daos.NewInventoryDAO(r.testEnvironment.PgxPool())
+	r.cartDAO = daos.NewCartDAO(r.testEnvironment.PgxPool())
+	r.cartItemDAO = daos.NewCartItemDAO(r.testEnvironment.PgxPool())
+	r.orderDAO = daos.NewOrderDAO(r.testEnvironment.PgxPool())
+	r.orderItemDAO = daos.NewOrderItemDAO(r.testEnvironment.PgxPool())
+	r.paymentDAO = daos.NewPaymentDAO(r.testEnvironment.PgxPool())
+	r.refundDAO = daos.NewRefundDAO(r.testEnvironment.PgxPool())
+	r.returnRequestDAO = daos.NewReturnRequestDAO(r.testEnvironment.PgxPool())
+}
+
+func (r *ReturnsSuite) SetupTest() {
+	r.customerDAO.DeletAll()
+	r.addressDAO.DeletAll()
+	r.productDAO.DeletAll()
+	r.inventoryDAO.DeletAll()
+	r.cartDAO.DeletAll()
+	r.cartItemDAO.DeletAll()
+	r.orderDAO.DeletAll()
+	r.orderItemDAO.DeletAll()
+	r.paymentDAO.DeletAll()
+	r.refundDAO.DeletAll()
+	r.returnRequestDAO.DeletAll()
+
+	r.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	r.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	r.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	r.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 5,
+		CreatedAt:     time.Now().UTC(),
+	})
+	r.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+// placeOrder checks out two units of the product and returns the order.
+func (r *ReturnsSuite) placeOrder() *daos.OrderSchema {
+	response := r.testEnvironment.Request(r.accessToken, "POST", "/v1/add-product-to-cart", `
+		{
+			"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"quantity": 2
+		}
+	`)
+	r.Require().Equal(204, response.StatusCode)
+
+	response = r.testEnvironment.Request(r.accessToken, "POST",
+		"/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+	r.Require().Equal(200, response.StatusCode)
+
+	return r.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+}
+
+// placeFulfilledOrder places an order and fulfils it, and returns its order item.
+func (r *ReturnsSuite) placeFulfilledOrder() daos.OrderItemSchema {
+	orderSchema := r.placeOrder()
+
+	response := r.testEnvironment.Request(r.adminAccessToken, "POST", "/v1/admin/fulfil-order", fmt.Sprintf(`{"orderId": "%s"}`, orderSchema.Id))
+	r.Require().Equal(204, response.StatusCode)
+
+	return r.orderItemDAO.FindAllByOrderId(orderSchema.Id)[0]
+}
+
+func (r *ReturnsSuite) requestReturn(orderItemId uuid.UUID, quantity int32) *http.Response {
+	return r.testEnvironment.Request(r.accessToken, "POST", "/v1/request-return", fmt.Sprintf(`
+		{
+			"orderItemId": "%s",
+			"quantity": %d,
+			"reasonCode": "damaged",
+			"comment": "The scroll wheel is broken"
+		}
+	`, orderItemId, quantity))
+}
+
+func (r *ReturnsSuite) returnRequestId(response *http.Response) uuid.UUID {
+	var body struct {
+		Data struct {
+			ReturnRequestId uuid.UUID `json:"returnRequestId"`
+		} `json:"data"`
+	}
+
+	r.Require().Equal(201, response.StatusCode)
+	r.Require().NoError(json.NewDecoder(response.Body).Decode(&body))
+
+	return body.Data.ReturnRequestId
+}
+
+func (r *ReturnsSuite) moderateReturnRequest(accessToken string, returnRequestId uuid.UUID, status string) *http.Response {
+	return r.testEnvironment.Request(accessToken, "POST", "/v1/admin/moderate-return-request", fmt.Sprintf(`
+		{
+			"returnRequestId": "%s",
+			"status": "%s"
+		}
+	`, returnRequestId, status))
+}
+
+func (r *ReturnsSuite) receiveReturnRequest(accessToken string, returnRequestId uuid.UUID, restock bool) *http.Response {
+	return r.testEnvironment.Request(accessToken, "POST", "/v1/admin/receive-return-request", fmt.Sprintf(`
+		{
+			"returnRequestId": "%s",
+			"restock": %t
+		}
+	`, returnRequestId, restock))
+}
+
+func (r *ReturnsSuite) Test1() {
+	r.Run("given an approved return request, when it is received with restock, then returns 200, restocks and refunds the returned unit", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		returnRequestId := r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+		returnRequestSchema := r.returnRequestDAO.FindOneById(returnRequestId)
+		r.Equal("requested", returnRequestSchema.Status)
+		r.Equal("damaged", returnRequestSchema.ReasonCode)
+		r.Equal(utils.NewPointer("The scroll wheel is broken"), returnRequestSchema.Comment)
+
+		r.Require().Equal(204, r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "approved").StatusCode)
+		r.Equal("approved", r.returnRequestDAO.FindOneById(returnRequestId).Status)
+
+		response := r.receiveReturnRequest(r.adminAccessToken, returnRequestId, true)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(200, response.StatusCode)
+		r.JSONEq(`{"data": {"refundedAmount": 2999}}`, string(body))
+
+		r.Equal(int32(4), r.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+
+		returnRequestSchema = r.returnRequestDAO.FindOneById(returnRequestId)
+		r.Equal("received", returnRequestSchema.Status)
+		r.True(returnRequestSchema.Restocked)
+		r.Equal(int64(2999), returnRequestSchema.RefundedAmount)
+		r.NotNil(returnRequestSchema.ReceivedAt)
+
+		paymentSchema := r.paymentDAO.FindOneByOrderId(orderItemSchema.OrderId)
+		r.Equal(int64(2999), paymentSchema.RefundedAmount)
+
+		refundsSchema := r.refundDAO.FindAllByPaymentId(paymentSchema.Id)
+		r.Require().Len(refundsSchema, 1)
+		r.Equal(int64(2999), refundsSchema[0].Amount)
+		r.Equal("return", refundsSchema[0].Reason)
+	})
+}
+
+func (r *ReturnsSuite) Test2() {
+	r.Run("given an approved return request, when it is received without restock, then returns 200 and only refunds", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		returnRequestId := r.returnRequestId(r.requestReturn(orderItemSchema.Id, 2))
+		r.Require().Equal(204, r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "approved").StatusCode)
+
+		response := r.receiveReturnRequest(r.adminAccessToken, returnRequestId, false)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(200, response.StatusCode)
+		r.JSONEq(`{"data": {"refundedAmount": 5998}}`, string(body))
+
+		r.Equal(int32(3), r.inventoryDAO.FindOneByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).StockQuantity)
+		r.False(r.returnRequestDAO.FindOneById(returnRequestId).Restocked)
+	})
+}
+
+func (r *ReturnsSuite) Test3() {
+	r.Run("given an order that was not fulfilled, when requesting a return, then returns 409", func() {
+		orderSchema := r.placeOrder()
+
+		response := r.requestReturn(r.orderItemDAO.FindAllByOrderId(orderSchema.Id)[0].Id, 1)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "order has not been fulfilled"}`, string(body))
+	})
+}
+
+func (r *ReturnsSuite) Test4() {
+	r.Run("given an order fulfilled before the return window, when requesting a return, then returns 409", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		_ = utils.GetOrThrow(r.testEnvironment.PgxPool().Exec(context.Background(),
+			"UPDATE orders SET fulfilled_at = $1 WHERE id = $2", time.Now().UTC().AddDate(0, 0, -31), orderItemSchema.OrderId))
+
+		response := r.requestReturn(orderItemSchema.Id, 1)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "return window has expired"}`, string(body))
+
+		response = r.testEnvironment.Request(r.adminAccessToken, "POST", "/v1/admin/set-product-return-window", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"returnWindowDays": 60
+			}
+		`)
+		r.Require().Equal(204, response.StatusCode)
+		r.Equal(int32(60), r.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).ReturnWindowDays)
+
+		r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+	})
+}
+
+func (r *ReturnsSuite) Test5() {
+	r.Run("given a product that is not returnable, when requesting a return, then returns 409", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		response := r.testEnvironment.Request(r.adminAccessToken, "POST", "/v1/admin/set-product-return-window", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"returnWindowDays": 0
+			}
+		`)
+		r.Require().Equal(204, response.StatusCode)
+
+		response = r.requestReturn(orderItemSchema.Id, 1)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "order item is not returnable"}`, string(body))
+	})
+}
+
+func (r *ReturnsSuite) Test6() {
+	r.Run("given units already requested, when requesting more than were shipped, then returns 409 until the request is rejected", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		returnRequestId := r.returnRequestId(r.requestReturn(orderItemSchema.Id, 2))
+
+		response := r.requestReturn(orderItemSchema.Id, 1)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "return quantity exceeds the quantity shipped"}`, string(body))
+
+		r.Require().Equal(204, r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "rejected").StatusCode)
+		r.Equal("rejected", r.returnRequestDAO.FindOneById(returnRequestId).Status)
+
+		r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+	})
+}
+
+func (r *ReturnsSuite) Test7() {
+	r.Run("given a reviewed return request, when reviewing it again or receiving a rejected one, then returns 409", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		returnRequestId := r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+		r.Require().Equal(204, r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "rejected").StatusCode)
+
+		response := r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "approved")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "return request was already reviewed"}`, string(body))
+
+		response = r.receiveReturnRequest(r.adminAccessToken, returnRequestId, true)
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "return request is not approved"}`, string(body))
+	})
+}
+
+func (r *ReturnsSuite) Test8() {
+	r.Run("given an order with a return request, when an admin cancels it, then returns 409", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+		r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+
+		response := r.testEnvironment.Request(r.adminAccessToken, "POST", "/v1/admin/cancel-order", fmt.Sprintf(`{"orderId": "%s"}`, orderItemSchema.OrderId))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "order has return requests"}`, string(body))
+	})
+}
+
+func (r *ReturnsSuite) Test9() {
+	r.Run("when the reason code is not valid, then returns 409", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+
+		response := r.testEnvironment.Request(r.accessToken, "POST", "/v1/request-return", fmt.Sprintf(`
+			{
+				"orderItemId": "%s",
+				"quantity": 1,
+				"reasonCode": "changed_mind"
+			}
+		`, orderItemSchema.Id))
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(409, response.StatusCode)
+		r.JSONEq(`{"message": "reason code is not valid"}`, string(body))
+	})
+}
+
+func (r *ReturnsSuite) Test10() {
+	r.Run("given a customer access token, when moderating or receiving a return request, then returns 403 and refunds nothing", func() {
+		orderItemSchema := r.placeFulfilledOrder()
+		returnRequestId := r.returnRequestId(r.requestReturn(orderItemSchema.Id, 1))
+
+		response := r.moderateReturnRequest(r.accessToken, returnRequestId, "approved")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		r.Equal(403, response.StatusCode)
+		r.JSONEq(`{"message": "admin role is required"}`, string(body))
+
+		r.Require().Equal(204, r.moderateReturnRequest(r.adminAccessToken, returnRequestId, "approved").StatusCode)
+
+		response = r.receiveReturnRequest(r.accessToken, returnRequestId, true)
+		r.Equal(403, response.StatusCode)
+
+		r.Equal("approved", r.returnRequestDAO.FindOneById(returnRequestId).Status)
+		r.Empty(r.refundDAO.FindAllByPaymentId(r.paymentDAO.FindOneByOrderId(orderItemSchema.OrderId).Id))
+	})
+}
+
+func TestReturnsSuite(t *testing.T) {
+	suite.Run(t, new(ReturnsSuite))
+}
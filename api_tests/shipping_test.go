@@ -0,0 +1,317 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShippingSuite struct {
+	suite.Suite
+	customerDAO         daos.CustomerDAO
+	addressDAO          daos.AddressDAO
+	productDAO          daos.ProductDAO
+	bundleComponentDAO  daos.BundleComponentDAO
+	inventoryDAO        daos.InventoryDAO
+	cartDAO             daos.CartDAO
+	cartItemDAO         daos.CartItemDAO
+	orderDAO            daos.OrderDAO
+	orderItemDAO        daos.OrderItemDAO
+	promotionDAO        daos.PromotionDAO
+	shippingMethodDAO   daos.ShippingMethodDAO
+	shippingZoneRateDAO daos.ShippingZoneRateDAO
+	accessToken         string
+	testEnvironment     *testhelpers.TestEnvironment
+}
+
+func (s *ShippingSuite) SetupSuite() {
+	s.testEnvironment = testhelpers.NewTestEnvironment()
+	s.testEnvironment.Start()
+
+	s.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	s.customerDAO = daos.NewCustomerDAO(s.testEnvironment.PgxPool())
+	s.addressDAO = daos.NewAddressDAO(s.testEnvironment.PgxPool())
+	s.productDAO = daos.NewProductDAO(s.testEnvironment.PgxPool())
+	s.bundleComponentDAO = daos.NewBundleComponentDAO(s.testEnvironment.PgxPool())
+	s.inventoryDAO = daos.NewInventoryDAO(s.testEnvironment.PgxPool())
+	s.cartDAO = daos.NewCartDAO(s.testEnvironment.PgxPool())
+	s.cartItemDAO = daos.NewCartItemDAO(s.testEnvironment.PgxPool())
+	s.orderDAO = daos.NewOrderDAO(s.testEnvironment.PgxPool())
+	s.orderItemDAO = daos.NewOrderItemDAO(s.testEnvironment.PgxPool())
+	s.promotionDAO = daos.NewPromotionDAO(s.testEnvironment.PgxPool())
+	s.shippingMethodDAO = daos.NewShippingMethodDAO(s.testEnvironment.PgxPool())
+	s.shippingZoneRateDAO = daos.NewShippingZoneRateDAO(s.testEnvironment.PgxPool())
+}
+
+func (s *ShippingSuite) SetupTest() {
+	s.orderItemDAO.DeletAll()
+	s.orderDAO.DeletAll()
+	s.promotionDAO.DeletAll()
+	s.cartItemDAO.DeletAll()
+	s.cartDAO.DeletAll()
+	s.shippingZoneRateDAO.DeletAll()
+	s.shippingMethodDAO.DeletAll()
+	s.addressDAO.DeletAll()
+	s.customerDAO.DeletAll()
+	s.inventoryDAO.DeletAll()
+	s.bundleComponentDAO.DeletAll()
+	s.productDAO.DeletAll()
+
+	s.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	s.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	s.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	s.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 50,
+		CreatedAt:     time.Now().UTC(),
+	})
+	s.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	s.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  4,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (s *ShippingSuite) addShippingMethod(body string) string {
+	response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/add-shipping-method", body)
+	s.Require().Equal(201, response.StatusCode)
+
+	return utils.ParseJSONBody[map[string]map[string]string](response.Body)["data"]["shippingMethodId"]
+}
+
+func (s *ShippingSuite) cart() map[string]any {
+	response := s.testEnvironment.Request(s.accessToken, "GET", "/v1/cart", "")
+	s.Require().Equal(200, response.StatusCode)
+
+	return utils.ParseJSONBody[map[string]map[string]any](response.Body)["data"]
+}
+
+func (s *ShippingSuite) Test1() {
+	s.Run("given each type of shipping method, when quoting the cart, then returns what each method charges for the address", func() {
+		flatId := s.addShippingMethod(`{"name": "Standard", "type": "flat", "rate": 799}`)
+		weightBasedId := s.addShippingMethod(`{"name": "Parcel", "type": "weight_based", "rate": 500, "ratePerKg": 300}`)
+		freeOverThresholdId := s.addShippingMethod(`{"name": "Economy", "type": "free_over_threshold", "rate": 999, "freeThreshold": 10000}`)
+		zoneId := s.addShippingMethod(`
+			{
+				"name": "Regional",
+				"type": "zone",
+				"zoneRates": [
+					{"zipPrefix": "7", "rate": 700},
+					{"zipPrefix": "787", "rate": 450}
+				]
+			}
+		`)
+		_ = s.addShippingMethod(`{"name": "West Coast", "type": "zone", "zoneRates": [{"zipPrefix": "9", "rate": 300}]}`)
+		_ = s.addShippingMethod(`{"name": "Euro Post", "type": "flat", "rate": 500, "currency": "EUR"}`)
+
+		// 20 x 10 x 10 cm ships as 400 g, above its 250 g, so 4 units ship as 1.6 kg and are charged 2 kg.
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/set-product-shipping-dimensions", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"weightGrams": 250,
+				"lengthCm": 20,
+				"widthCm": 10,
+				"heightCm": 10
+			}
+		`)
+		s.Require().Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "GET", "/v1/shipping-rates?address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(200, response.StatusCode)
+		s.JSONEq(`
+			{
+				"data": [
+					{"shippingMethodId": "`+freeOverThresholdId+`", "name": "Economy", "type": "free_over_threshold", "price": 0, "currency": "USD"},
+					{"shippingMethodId": "`+weightBasedId+`", "name": "Parcel", "type": "weight_based", "price": 1100, "currency": "USD"},
+					{"shippingMethodId": "`+zoneId+`", "name": "Regional", "type": "zone", "price": 450, "currency": "USD"},
+					{"shippingMethodId": "`+flatId+`", "name": "Standard", "type": "flat", "price": 799, "currency": "USD"}
+				]
+			}
+		`, string(body))
+	})
+}
+
+func (s *ShippingSuite) Test2() {
+	s.Run("given a selected shipping method, when checking out, then the order stores the method and its cost", func() {
+		shippingMethodId := s.addShippingMethod(`{"name": "Standard", "type": "flat", "rate": 799}`)
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/select-shipping-method", `{"shippingMethodId": "`+shippingMethodId+`"}`)
+		s.Require().Equal(204, response.StatusCode)
+
+		cart := s.cart()
+		s.Equal(map[string]any{"shippingMethodId": shippingMethodId, "name": "Standard", "type": "flat"}, cart["shippingMethod"])
+		s.Equal(float64(799), cart["shippingPrice"])
+		s.Equal(float64(12795), cart["totalPrice"])
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747"+
+			"&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+		s.Require().Equal(200, response.StatusCode)
+
+		orderSchema := s.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		s.Require().NotNil(orderSchema)
+		s.Equal(int64(12795), orderSchema.TotalPrice)
+		s.Equal(int64(799), orderSchema.ShippingPrice)
+		s.Equal(uuid.MustParse(shippingMethodId), *orderSchema.ShippingMethodId)
+		s.Equal("Standard", *orderSchema.ShippingMethodName)
+		s.Nil(s.cartDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747")).ShippingMethodId)
+	})
+}
+
+func (s *ShippingSuite) Test3() {
+	s.Run("given no shipping method or one that does not ship to the address, when checking out, then returns 409", func() {
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/checkout-prepayment", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(409, response.StatusCode)
+		s.JSONEq(`{"message": "shipping method is required"}`, string(body))
+
+		shippingMethodId := s.addShippingMethod(`{"name": "West Coast", "type": "zone", "zoneRates": [{"zipPrefix": "9", "rate": 300}]}`)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/select-shipping-method", `{"shippingMethodId": "`+shippingMethodId+`"}`)
+		s.Require().Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/checkout-prepayment", "")
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(409, response.StatusCode)
+		s.JSONEq(`{"message": "shipping method is not available for the address"}`, string(body))
+	})
+}
+
+func (s *ShippingSuite) Test4() {
+	s.Run("given a free shipping coupon, when getting the cart, then the selected method costs nothing", func() {
+		shippingMethodId := s.addShippingMethod(`{"name": "Standard", "type": "flat", "rate": 799}`)
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/select-shipping-method", `{"shippingMethodId": "`+shippingMethodId+`"}`)
+		s.Require().Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/add-promotion", `{"code": "SHIPFREE", "type": "free_shipping"}`)
+		s.Require().Equal(201, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/apply-coupon", `{"code": "SHIPFREE"}`)
+		s.Require().Equal(204, response.StatusCode)
+
+		cart := s.cart()
+		s.Equal(float64(0), cart["shippingPrice"])
+		s.Equal(float64(11996), cart["totalPrice"])
+	})
+}
+
+func (s *ShippingSuite) Test5() {
+	s.Run("given a shipping method in another currency or a bad zip prefix, when selecting or adding it, then returns 409", func() {
+		shippingMethodId := s.addShippingMethod(`{"name": "Euro Post", "type": "flat", "rate": 500, "currency": "EUR"}`)
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/select-shipping-method", `{"shippingMethodId": "`+shippingMethodId+`"}`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(409, response.StatusCode)
+		s.JSONEq(`{"message": "shipping method does not apply to the cart currency"}`, string(body))
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/add-shipping-method", `
+			{
+				"name": "Regional",
+				"type": "zone",
+				"zoneRates": [{"zipPrefix": "78A", "rate": 450}]
+			}
+		`)
+
+		body = utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(409, response.StatusCode)
+		s.JSONEq(`{"message": "zip prefix must have 1 to 5 digits"}`, string(body))
+	})
+}
+
+func (s *ShippingSuite) Test6() {
+	s.Run("given a bundle in the cart, when quoting a weight based method, then the bundle weighs what its components do", func() {
+		weightBasedId := s.addShippingMethod(`{"name": "Parcel", "type": "weight_based", "rate": 500, "ratePerKg": 300}`)
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/set-product-shipping-dimensions", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"weightGrams": 250
+			}
+		`)
+		s.Require().Equal(204, response.StatusCode)
+
+		s.productDAO.Create(daos.ProductSchema{
+			Id:        uuid.MustParse("5d0c3a4e-8f7b-4b1e-9a2d-6c3e1f0b7a95"),
+			Status:    "published",
+			Name:      "ErgoClick Pro Triple Pack",
+			Price:     7999,
+			IsBundle:  true,
+			CreatedAt: time.Now().UTC(),
+		})
+		s.bundleComponentDAO.Create(daos.BundleComponentSchema{
+			Id:                 uuid.New(),
+			BundleProductId:    uuid.MustParse("5d0c3a4e-8f7b-4b1e-9a2d-6c3e1f0b7a95"),
+			ComponentProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:           3,
+			CreatedAt:          time.Now().UTC(),
+		})
+		s.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.New(),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("5d0c3a4e-8f7b-4b1e-9a2d-6c3e1f0b7a95"),
+			Quantity:  2,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		// 4 mice and 2 packs of 3 weigh 10 x 250 g, so 2.5 kg are charged 3 kg.
+		response = s.testEnvironment.Request(s.accessToken, "GET", "/v1/shipping-rates?address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(200, response.StatusCode)
+		s.JSONEq(`
+			{
+				"data": [
+					{"shippingMethodId": "`+weightBasedId+`", "name": "Parcel", "type": "weight_based", "price": 1400, "currency": "USD"}
+				]
+			}
+		`, string(body))
+	})
+}
+
+func TestShipping(t *testing.T) {
+	suite.Run(t, new(ShippingSuite))
+}
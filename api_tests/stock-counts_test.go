@@ -0,0 +1,320 @@
+package apitests_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type StockCountsSuite struct {
+	suite.Suite
+	productDAO           daos.ProductDAO
+	inventoryDAO         daos.InventoryDAO
+	inventoryMovementDAO daos.InventoryMovementDAO
+	warehouseDAO         daos.WarehouseDAO
+	stockCountDAO        daos.StockCountDAO
+	accessToken          string
+	testEnvironment      *testhelpers.TestEnvironment
+}
+
+func (s *StockCountsSuite) SetupSuite() {
+	s.testEnvironment = testhelpers.NewTestEnvironment()
+	s.testEnvironment.Start()
+
+	s.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90"))
+
+	s.productDAO = daos.NewProductDAO(s.testEnvironment.PgxPool())
+	s.inventoryDAO = daos.NewInventoryDAO(s.testEnvironment.PgxPool())
+	s.inventoryMovementDAO = daos.NewInventoryMovementDAO(s.testEnvironment.PgxPool())
+	s.warehouseDAO = daos.NewWarehouseDAO(s.testEnvironment.PgxPool())
+	s.stockCountDAO = daos.NewStockCountDAO(s.testEnvironment.PgxPool())
+}
+
+func (s *StockCountsSuite) SetupTest() {
+	s.stockCountDAO.DeletAll()
+	s.productDAO.DeletAll()
+	s.inventoryMovementDAO.DeletAll()
+	s.warehouseDAO.DeletAll()
+
+	s.warehouseDAO.Create(daos.WarehouseSchema{
+		Id:        uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+		Name:      "Dallas warehouse",
+		ZipCode:   utils.NewPointer("75201"),
+		CreatedAt: time.Now().UTC(),
+	})
+	s.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	s.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		Name:        "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+		Description: utils.NewPointer("A split-design wireless ergonomic keyboard ..."),
+		Price:       99286,
+		CreatedAt:   time.Now().UTC(),
+	})
+	s.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("cf23ee55-88c0-4898-ada4-15645c75645d"),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+		StockQuantity: 10,
+		CreatedAt:     time.Now().UTC(),
+	})
+	s.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.MustParse("3fede283-d7f3-4423-bfe1-63163978c03f"),
+		ProductId:     uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+		WarehouseId:   uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"),
+		StockQuantity: 4,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (s *StockCountsSuite) startStockCount() uuid.UUID {
+	response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/start-stock-count", `{"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"}`)
+	s.Require().Equal(201, response.StatusCode)
+
+	var output struct {
+		Data struct {
+			StockCountId uuid.UUID `json:"stockCountId"`
+		} `json:"data"`
+	}
+	utils.ThrowOnError(json.NewDecoder(response.Body).Decode(&output))
+
+	return output.Data.StockCountId
+}
+
+func (s *StockCountsSuite) Test1() {
+	s.Run("when counting, reviewing and applying a stock count, then it applies the variance on top of the current stock", func() {
+		stockCountId := s.startStockCount()
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/submit-stock-count", fmt.Sprintf(`
+			{
+				"stockCountId": "%s",
+				"items": [
+					{ "inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d", "countedQuantity": 7 }
+				]
+			}
+		`, stockCountId))
+		s.Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "GET", "/v1/admin/stock-counts/"+stockCountId.String(), "")
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+
+		stockCountSchema := s.stockCountDAO.FindOneById(stockCountId)
+		s.Require().NotNil(stockCountSchema)
+		s.Require().Equal("submitted", stockCountSchema.Status)
+
+		s.Equal(200, response.StatusCode)
+		s.JSONEq(fmt.Sprintf(`
+			{
+				"data": {
+					"id": "%s",
+					"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f",
+					"status": "submitted",
+					"createdAt": "%s",
+					"submittedAt": "%s",
+					"appliedAt": null,
+					"totalVariance": -3,
+					"items": [
+						{
+							"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+							"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+							"productName": "ErgoClick Pro Wireless Mouse",
+							"expectedQuantity": 10,
+							"countedQuantity": 7,
+							"variance": -3
+						},
+						{
+							"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f",
+							"productId": "7ab00199-6f9c-4af7-ad54-a02503226282",
+							"productName": "Kinesis Freestyle2 Wireless Ergonomic Keyboard",
+							"expectedQuantity": 4,
+							"countedQuantity": null,
+							"variance": null
+						}
+					]
+				}
+			}
+		`, stockCountId, stockCountSchema.CreatedAt.Format(time.RFC3339Nano), stockCountSchema.SubmittedAt.Format(time.RFC3339Nano)), string(body))
+
+		// Stock that moves while the count is under review must survive the application of the count.
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/adjust-stock", `
+			{
+				"inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d",
+				"quantity": -2,
+				"reason": "damage",
+				"note": "Dropped during the count"
+			}
+		`)
+		s.Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/apply-stock-count", fmt.Sprintf(`{"stockCountId": "%s"}`, stockCountId))
+		s.Equal(204, response.StatusCode)
+
+		mouseInventorySchema := s.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		s.Require().Equal(int32(5), mouseInventorySchema.StockQuantity)
+
+		keyboardInventorySchema := s.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("7ab00199-6f9c-4af7-ad54-a02503226282"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		s.Require().Equal(int32(4), keyboardInventorySchema.StockQuantity)
+
+		inventoryMovementsSchema := s.inventoryMovementDAO.FindAllByProductId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		s.Require().Len(inventoryMovementsSchema, 2)
+		s.Require().Equal("count", inventoryMovementsSchema[1].Reason)
+		s.Require().Equal(int32(-3), inventoryMovementsSchema[1].Quantity)
+		s.Require().Equal(int32(5), inventoryMovementsSchema[1].Balance)
+		s.Require().Equal(stockCountId, *inventoryMovementsSchema[1].ReferenceId)
+
+		stockCountSchema = s.stockCountDAO.FindOneById(stockCountId)
+		s.Require().Equal("applied", stockCountSchema.Status)
+		s.Require().Equal("0d4b8f3e-5a61-4c7e-9b2d-8e1f3a6c7d90", stockCountSchema.AppliedBy.String())
+		s.Require().NotNil(stockCountSchema.AppliedAt)
+	})
+}
+
+func (s *StockCountsSuite) Test2() {
+	s.Run("given that the variance would take stock below zero, when applying the count, then it returns 409 and applies nothing", func() {
+		stockCountId := s.startStockCount()
+
+		response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/submit-stock-count", fmt.Sprintf(`
+			{
+				"stockCountId": "%s",
+				"items": [
+					{ "inventoryId": "cf23ee55-88c0-4898-ada4-15645c75645d", "countedQuantity": 2 },
+					{ "inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f", "countedQuantity": 0 }
+				]
+			}
+		`, stockCountId))
+		s.Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/adjust-stock", `
+			{
+				"inventoryId": "3fede283-d7f3-4423-bfe1-63163978c03f",
+				"quantity": -1,
+				"reason": "damage",
+				"note": "Broken keycap"
+			}
+		`)
+		s.Equal(204, response.StatusCode)
+
+		response = s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/apply-stock-count", fmt.Sprintf(`{"stockCountId": "%s"}`, stockCountId))
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		s.Equal(409, response.StatusCode)
+		s.JSONEq(`
+			{
+				"message": "stock cannot be negative"
+			}
+		`, string(body))
+
+		mouseInventorySchema := s.inventoryDAO.FindOneByProductIdAndWarehouseId(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			uuid.MustParse("1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"))
+		s.Require().Equal(int32(10), mouseInventorySchema.StockQuantity)
+
+		stockCountSchema := s.stockCountDAO.FindOneById(stockCountId)
+		s.Require().Equal("submitted", stockCountSchema.Status)
+	})
+}
+
+func (s *StockCountsSuite) Test3() {
+	s.Run("when the workflow steps are taken out of order, then it returns 409", func() {
+		stockCountId := s.startStockCount()
+
+		templates := []map[string]string{
+			{
+				"path":  "/v1/admin/start-stock-count",
+				"body":  `{"warehouseId": "1b3f5e27-8c4d-4a6b-9e0f-2d7c8a9b1e3f"}`,
+				"error": "a stock count is already in progress for this warehouse",
+			},
+			{
+				"path":  "/v1/admin/apply-stock-count",
+				"body":  fmt.Sprintf(`{"stockCountId": "%s"}`, stockCountId),
+				"error": "stock count must be submitted before it is applied",
+			},
+			{
+				"path": "/v1/admin/submit-stock-count",
+				"body": fmt.Sprintf(`{"stockCountId": "%s", "items": [{"inventoryId": "%s", "countedQuantity": 1}]}`,
+					stockCountId, "5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"),
+				"error": "inventory is not part of the stock count",
+			},
+			{
+				"path":  "/v1/admin/start-stock-count",
+				"body":  `{"warehouseId": "5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17"}`,
+				"error": "warehouse not found",
+			},
+		}
+
+		for _, template := range templates {
+			response := s.testEnvironment.Request(s.accessToken, "POST", template["path"], template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			s.Equal(409, response.StatusCode)
+			s.JSONEq(fmt.Sprintf(`
+				{
+					"message": "%s"
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func (s *StockCountsSuite) Test4() {
+	s.Run("when submitting a stock count and body is invalid, then returns 400", func() {
+		templates := []map[string]string{
+			{
+				"body": `{}`,
+				"error": `[
+					"stockCountId is required",
+					"items is required"
+				]`,
+			},
+			{
+				"body": `{
+					"stockCountId": "5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17",
+					"items": []
+				}`,
+				"error": `[
+					"items must not be empty"
+				]`,
+			},
+			{
+				"body": `{
+					"stockCountId": "5e2a7c91-3d6b-4f8e-a1c4-9b0d2e6f8a17",
+					"items": [1, { "inventoryId": "x", "countedQuantity": -1 }]
+				}`,
+				"error": `[
+					"items[0] must be object",
+					"items[1].inventoryId must be uuidv4",
+					"items[1].countedQuantity must be positive"
+				]`,
+			},
+		}
+
+		for _, template := range templates {
+			response := s.testEnvironment.Request(s.accessToken, "POST", "/v1/admin/submit-stock-count", template["body"])
+
+			body := utils.GetOrThrow(io.ReadAll(response.Body))
+			s.Equal(400, response.StatusCode)
+			s.JSONEq(fmt.Sprintf(`
+				{
+					"message": %s
+				}
+			`, template["error"]), string(body))
+		}
+	})
+}
+
+func TestStockCounts(t *testing.T) {
+	suite.Run(t, new(StockCountsSuite))
+}
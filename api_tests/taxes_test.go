@@ -0,0 +1,247 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxesSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	addressDAO      daos.AddressDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	orderDAO        daos.OrderDAO
+	orderItemDAO    daos.OrderItemDAO
+	orderItemTaxDAO daos.OrderItemTaxDAO
+	promotionDAO    daos.PromotionDAO
+	taxRateDAO      daos.TaxRateDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (t *TaxesSuite) SetupSuite() {
+	t.testEnvironment = testhelpers.NewTestEnvironment()
+	t.testEnvironment.Start()
+
+	t.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	t.customerDAO = daos.NewCustomerDAO(t.testEnvironment.PgxPool())
+	t.addressDAO = daos.NewAddressDAO(t.testEnvironment.PgxPool())
+	t.productDAO = daos.NewProductDAO(t.testEnvironment.PgxPool())
+	t.inventoryDAO = daos.NewInventoryDAO(t.testEnvironment.PgxPool())
+	t.cartDAO = daos.NewCartDAO(t.testEnvironment.PgxPool())
+	t.cartItemDAO = daos.NewCartItemDAO(t.testEnvironment.PgxPool())
+	t.orderDAO = daos.NewOrderDAO(t.testEnvironment.PgxPool())
+	t.orderItemDAO = daos.NewOrderItemDAO(t.testEnvironment.PgxPool())
+	t.orderItemTaxDAO = daos.NewOrderItemTaxDAO(t.testEnvironment.PgxPool())
+	t.promotionDAO = daos.NewPromotionDAO(t.testEnvironment.PgxPool())
+	t.taxRateDAO = daos.NewTaxRateDAO(t.testEnvironment.PgxPool())
+}
+
+func (t *TaxesSuite) SetupTest() {
+	t.orderItemTaxDAO.DeletAll()
+	t.orderItemDAO.DeletAll()
+	t.orderDAO.DeletAll()
+	t.promotionDAO.DeletAll()
+	t.taxRateDAO.DeletAll()
+	t.cartItemDAO.DeletAll()
+	t.cartDAO.DeletAll()
+	t.addressDAO.DeletAll()
+	t.customerDAO.DeletAll()
+	t.inventoryDAO.DeletAll()
+	t.productDAO.DeletAll()
+
+	t.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	t.addressDAO.Create(daos.AddressSchema{
+		Id:          uuid.MustParse("9a6a0e64-4790-4ad2-99af-182f85bbac5b"),
+		CustomerId:  uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		IsDefault:   true,
+		Street:      "Maple Grove Lane",
+		Number:      "4767",
+		City:        "Austin",
+		State:       "TX",
+		ZipCode:     "78739",
+		AddressLine: "4767 Maple Grove Lane, Austin, TX 78739",
+		CreatedAt:   time.Now().UTC(),
+	})
+	t.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	t.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 50,
+		CreatedAt:     time.Now().UTC(),
+	})
+	t.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	t.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  4,
+		CreatedAt: time.Now().UTC(),
+	})
+	t.taxRateDAO.Create(daos.TaxRateSchema{
+		Id:              uuid.New(),
+		State:           "TX",
+		TaxCategory:     "general",
+		RateBasisPoints: 825,
+		CreatedAt:       time.Now().UTC(),
+	})
+}
+
+func (t *TaxesSuite) cart() map[string]any {
+	response := t.testEnvironment.Request(t.accessToken, "GET", "/v1/cart", "")
+	t.Require().Equal(200, response.StatusCode)
+
+	return utils.ParseJSONBody[map[string]map[string]any](response.Body)["data"]
+}
+
+func (t *TaxesSuite) checkout() {
+	response := t.testEnvironment.Request(t.accessToken, "POST", "/v1/checkout-postpayment?data_id=123456&customer_id=f59207c8-e837-4159-b67d-78c716510747"+
+		"&address_id=9a6a0e64-4790-4ad2-99af-182f85bbac5b", "")
+	t.Require().Equal(200, response.StatusCode)
+}
+
+func (t *TaxesSuite) Test1() {
+	t.Run("given a state rate, when getting the cart and checking out, then the tax is added to the total and kept per order item", func() {
+		cart := t.cart()
+		t.Equal("TX", cart["taxState"])
+		t.Equal(float64(990), cart["taxPrice"])
+		t.Equal(float64(12986), cart["totalPrice"])
+		t.Equal([]any{map[string]any{
+			"productId":       "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+			"jurisdiction":    "TX",
+			"taxCategory":     "general",
+			"rateBasisPoints": float64(825),
+			"amount":          float64(990),
+		}}, cart["taxes"])
+
+		t.checkout()
+
+		orderSchema := t.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		t.Require().NotNil(orderSchema)
+		t.Equal(int64(12986), orderSchema.TotalPrice)
+		t.Equal(int64(990), orderSchema.TaxAmount)
+
+		orderItemsSchema := t.orderItemDAO.FindAllByOrderId(orderSchema.Id)
+		t.Require().Len(orderItemsSchema, 1)
+
+		orderItemTaxesSchema := t.orderItemTaxDAO.FindAllByOrderItemId(orderItemsSchema[0].Id)
+		t.Require().Len(orderItemTaxesSchema, 1)
+		t.Equal("TX", orderItemTaxesSchema[0].Jurisdiction)
+		t.Equal(int32(825), orderItemTaxesSchema[0].RateBasisPoints)
+		t.Equal(int64(11996), orderItemTaxesSchema[0].TaxableAmount)
+		t.Equal(int64(990), orderItemTaxesSchema[0].Amount)
+	})
+}
+
+func (t *TaxesSuite) Test2() {
+	t.Run("given a coupon, when getting the cart, then the tax is on the discounted amount", func() {
+		response := t.testEnvironment.Request(t.accessToken, "POST", "/v1/admin/add-promotion", `
+			{
+				"code": "SAVE10",
+				"type": "percentage",
+				"percentage": 10
+			}
+		`)
+		t.Require().Equal(201, response.StatusCode)
+
+		response = t.testEnvironment.Request(t.accessToken, "POST", "/v1/apply-coupon", `{"code": "SAVE10"}`)
+		t.Require().Equal(204, response.StatusCode)
+
+		cart := t.cart()
+		t.Equal(float64(891), cart["taxPrice"])
+		t.Equal(float64(11688), cart["totalPrice"])
+	})
+}
+
+func (t *TaxesSuite) Test3() {
+	t.Run("given a product category the state does not tax, when getting the cart, then it is not taxed", func() {
+		response := t.testEnvironment.Request(t.accessToken, "POST", "/v1/admin/set-tax-rate", `
+			{
+				"state": "tx",
+				"taxCategory": "Groceries",
+				"rateBasisPoints": 0
+			}
+		`)
+		t.Require().Equal(204, response.StatusCode)
+
+		response = t.testEnvironment.Request(t.accessToken, "POST", "/v1/admin/set-product-tax-category", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"taxCategory": "groceries"
+			}
+		`)
+		t.Require().Equal(204, response.StatusCode)
+		t.Equal("groceries", t.productDAO.FindOneById(uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")).TaxCategory)
+
+		cart := t.cart()
+		t.Equal(float64(0), cart["taxPrice"])
+		t.Equal(float64(11996), cart["totalPrice"])
+	})
+}
+
+func (t *TaxesSuite) Test4() {
+	t.Run("given a tax-exempt customer, when checking out, then the order has no tax", func() {
+		response := t.testEnvironment.Request(t.accessToken, "POST", "/v1/admin/set-customer-tax-exemption", `
+			{
+				"customerId": "f59207c8-e837-4159-b67d-78c716510747",
+				"taxExempt": true
+			}
+		`)
+		t.Require().Equal(204, response.StatusCode)
+
+		t.checkout()
+
+		orderSchema := t.orderDAO.FindOneByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		t.Require().NotNil(orderSchema)
+		t.Equal(int64(11996), orderSchema.TotalPrice)
+		t.Equal(int64(0), orderSchema.TaxAmount)
+	})
+}
+
+func (t *TaxesSuite) Test5() {
+	t.Run("given a state that is not in the U.S., when setting a tax rate, then returns 409", func() {
+		response := t.testEnvironment.Request(t.accessToken, "POST", "/v1/admin/set-tax-rate", `
+			{
+				"state": "ZZ",
+				"taxCategory": "general",
+				"rateBasisPoints": 500
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		t.Equal(409, response.StatusCode)
+		t.JSONEq(`{"message": "state must be a valid 2-letter U.S. abbreviation (e.g. NY, CA)"}`, string(body))
+	})
+}
+
+func TestTaxes(t *testing.T) {
+	suite.Run(t, new(TaxesSuite))
+}
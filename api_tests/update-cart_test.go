@@ -0,0 +1,247 @@
+package apitests_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateCartSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (u *UpdateCartSuite) SetupSuite() {
+	u.testEnvironment = testhelpers.NewTestEnvironment()
+	u.testEnvironment.Start()
+
+	u.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	u.customerDAO = daos.NewCustomerDAO(u.testEnvironment.PgxPool())
+	u.productDAO = daos.NewProductDAO(u.testEnvironment.PgxPool())
+	u.inventoryDAO = daos.NewInventoryDAO(u.testEnvironment.PgxPool())
+	u.cartDAO = daos.NewCartDAO(u.testEnvironment.PgxPool())
+	u.cartItemDAO = daos.NewCartItemDAO(u.testEnvironment.PgxPool())
+}
+
+func (u *UpdateCartSuite) SetupTest() {
+	u.cartItemDAO.DeletAll()
+	u.cartDAO.DeletAll()
+	u.customerDAO.DeletAll()
+	u.inventoryDAO.DeletAll()
+	u.productDAO.DeletAll()
+
+	u.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	u.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	u.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b"),
+		Name:        "ClearView 27 Monitor",
+		Description: utils.NewPointer("27 inch monitor ..."),
+		Price:       19999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	u.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: 5,
+		CreatedAt:     time.Now().UTC(),
+	})
+	u.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b"),
+		StockQuantity: 2,
+		CreatedAt:     time.Now().UTC(),
+	})
+	u.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+	u.cartItemDAO.Create(daos.CartItemSchema{
+		Id:        uuid.New(),
+		CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Quantity:  2,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (u *UpdateCartSuite) cartETag() string {
+	response := u.testEnvironment.Request(u.accessToken, "GET", "/v1/cart", "")
+	u.Require().Equal(200, response.StatusCode)
+
+	return response.Header.Get("ETag")
+}
+
+func (u *UpdateCartSuite) Test1() {
+	u.Run("when replacing the cart with the current ETag, then returns 204 with a new ETag and replaces every line", func() {
+		etag := u.cartETag()
+
+		response := u.testEnvironment.Request(u.accessToken, "PUT", "/v1/cart", `
+			{
+				"mode": "replace",
+				"items": [
+					{
+						"productId": "a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b",
+						"quantity": 2
+					}
+				]
+			}
+		`, "If-Match", etag)
+		u.Require().Equal(204, response.StatusCode)
+		u.NotEqual(etag, response.Header.Get("ETag"))
+		u.Equal(response.Header.Get("ETag"), u.cartETag())
+
+		cartId := uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747")
+		u.Nil(u.cartItemDAO.FindOneByCartIdAndProductId(cartId, uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a")))
+
+		cartItemSchema := u.cartItemDAO.FindOneByCartIdAndProductId(cartId, uuid.MustParse("a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b"))
+		u.Require().NotNil(cartItemSchema)
+		u.Equal(int32(2), cartItemSchema.Quantity)
+	})
+}
+
+func (u *UpdateCartSuite) Test2() {
+	u.Run("when patching the cart, then changes only the given lines", func() {
+		response := u.testEnvironment.Request(u.accessToken, "PUT", "/v1/cart", `
+			{
+				"mode": "patch",
+				"items": [
+					{
+						"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+						"quantity": 4
+					},
+					{
+						"productId": "a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b",
+						"quantity": 1
+					}
+				]
+			}
+		`, "If-Match", u.cartETag())
+		u.Require().Equal(204, response.StatusCode)
+
+		cartId := uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747")
+
+		cartItemSchema := u.cartItemDAO.FindOneByCartIdAndProductId(cartId, uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		u.Require().NotNil(cartItemSchema)
+		u.Equal(int32(4), cartItemSchema.Quantity)
+
+		cartItemSchema = u.cartItemDAO.FindOneByCartIdAndProductId(cartId, uuid.MustParse("a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b"))
+		u.Require().NotNil(cartItemSchema)
+		u.Equal(int32(1), cartItemSchema.Quantity)
+	})
+}
+
+func (u *UpdateCartSuite) Test3() {
+	u.Run("given that the cart changed after it was read, when updating it with the old ETag, then returns 412", func() {
+		etag := u.cartETag()
+
+		response := u.testEnvironment.Request(u.accessToken, "POST", "/v1/increase-product-quantity-in-cart", `
+			{
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+				"quantity": 1
+			}
+		`)
+		u.Require().Equal(204, response.StatusCode)
+
+		response = u.testEnvironment.Request(u.accessToken, "PUT", "/v1/cart", `
+			{
+				"mode": "replace",
+				"items": []
+			}
+		`, "If-Match", etag)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		u.Equal(412, response.StatusCode)
+		u.JSONEq(`
+			{
+				"message": "cart has been modified"
+			}
+		`, string(body))
+
+		cartItemSchema := u.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		u.Require().NotNil(cartItemSchema)
+		u.Equal(int32(3), cartItemSchema.Quantity)
+	})
+}
+
+func (u *UpdateCartSuite) Test4() {
+	u.Run("when updating the cart without If-Match, then returns 428", func() {
+		response := u.testEnvironment.Request(u.accessToken, "PUT", "/v1/cart", `
+			{
+				"mode": "replace",
+				"items": []
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		u.Equal(428, response.StatusCode)
+		u.JSONEq(`
+			{
+				"message": "If-Match header is required"
+			}
+		`, string(body))
+	})
+}
+
+func (u *UpdateCartSuite) Test5() {
+	u.Run("when one line exceeds the stock available, then returns 409 and changes no line", func() {
+		response := u.testEnvironment.Request(u.accessToken, "PUT", "/v1/cart", `
+			{
+				"mode": "patch",
+				"items": [
+					{
+						"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+						"quantity": 0
+					},
+					{
+						"productId": "a4b3f1c2-5d6e-4f70-8a9b-0c1d2e3f4a5b",
+						"quantity": 3
+					}
+				]
+			}
+		`, "If-Match", u.cartETag())
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		u.Equal(409, response.StatusCode)
+		u.JSONEq(`
+			{
+				"message": "product quantity exceeds the stock available"
+			}
+		`, string(body))
+
+		cartItemSchema := u.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		u.Require().NotNil(cartItemSchema)
+		u.Equal(int32(2), cartItemSchema.Quantity)
+	})
+}
+
+func TestUpdateCart(t *testing.T) {
+	suite.Run(t, new(UpdateCartSuite))
+}
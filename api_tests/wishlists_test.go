@@ -0,0 +1,236 @@
+package apitests_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	testhelpers "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/test_helpers"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type WishlistsSuite struct {
+	suite.Suite
+	customerDAO     daos.CustomerDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	wishlistDAO     daos.WishlistDAO
+	wishlistItemDAO daos.WishlistItemDAO
+	accessToken     string
+	testEnvironment *testhelpers.TestEnvironment
+}
+
+func (w *WishlistsSuite) SetupSuite() {
+	w.testEnvironment = testhelpers.NewTestEnvironment()
+	w.testEnvironment.Start()
+
+	w.accessToken = testhelpers.TestGenerateAccessToken(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+
+	w.customerDAO = daos.NewCustomerDAO(w.testEnvironment.PgxPool())
+	w.productDAO = daos.NewProductDAO(w.testEnvironment.PgxPool())
+	w.inventoryDAO = daos.NewInventoryDAO(w.testEnvironment.PgxPool())
+	w.cartDAO = daos.NewCartDAO(w.testEnvironment.PgxPool())
+	w.cartItemDAO = daos.NewCartItemDAO(w.testEnvironment.PgxPool())
+	w.wishlistDAO = daos.NewWishlistDAO(w.testEnvironment.PgxPool())
+	w.wishlistItemDAO = daos.NewWishlistItemDAO(w.testEnvironment.PgxPool())
+}
+
+func (w *WishlistsSuite) SetupTest() {
+	w.wishlistItemDAO.DeletAll()
+	w.wishlistDAO.DeletAll()
+	w.cartItemDAO.DeletAll()
+	w.cartDAO.DeletAll()
+	w.customerDAO.DeletAll()
+	w.inventoryDAO.DeletAll()
+	w.productDAO.DeletAll()
+
+	w.customerDAO.Create(daos.CustomerSchema{
+		Id:        uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		Name:      "John Doe",
+		Email:     "john.doe@gmail.com",
+		Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+		CreatedAt: time.Now().UTC(),
+	})
+	w.productDAO.Create(daos.ProductSchema{
+		Id:          uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		Status:      "published",
+		Name:        "ErgoClick Pro Wireless Mouse",
+		Description: utils.NewPointer("Ergonomically designed wireless optical mouse ..."),
+		Price:       2999,
+		CreatedAt:   time.Now().UTC(),
+	})
+	w.cartDAO.Create(daos.CartSchema{
+		Id:         uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+		CustomerId: uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"),
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (w *WishlistsSuite) addStock(stockQuantity int32) {
+	w.inventoryDAO.Create(daos.InventorySchema{
+		Id:            uuid.New(),
+		ProductId:     uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+		StockQuantity: stockQuantity,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
+
+func (w *WishlistsSuite) Test1() {
+	w.Run("given a wished product whose price dropped, when listing wishlists, then shows the price drop and stock status", func() {
+		w.addStock(0)
+
+		response := w.testEnvironment.Request(w.accessToken, "POST", "/v1/create-wishlist", `{"name": "Birthday"}`)
+		w.Require().Equal(201, response.StatusCode)
+		wishlistId := utils.ParseJSONBody[map[string]map[string]string](response.Body)["data"]["wishlistId"]
+
+		response = w.testEnvironment.Request(w.accessToken, "POST", "/v1/add-product-to-wishlist", `
+			{
+				"wishlistId": "`+wishlistId+`",
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"
+			}
+		`)
+		w.Require().Equal(204, response.StatusCode)
+
+		_ = utils.GetOrThrow(w.testEnvironment.PgxPool().Exec(context.Background(), "UPDATE products SET price = 2499"))
+
+		response = w.testEnvironment.Request(w.accessToken, "GET", "/v1/wishlists", "")
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		w.Equal(200, response.StatusCode)
+		w.JSONEq(`
+			{
+				"data": [
+					{
+						"id": "`+wishlistId+`",
+						"kind": "wishlist",
+						"name": "Birthday",
+						"items": [
+							{
+								"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a",
+								"name": "ErgoClick Pro Wireless Mouse",
+								"quantity": 1,
+								"currency": "USD",
+								"priceAtAdd": 2999,
+								"price": 2499,
+								"priceDropped": true,
+								"stockStatus": "out_of_stock"
+							}
+						]
+					}
+				]
+			}
+		`, string(body))
+	})
+}
+
+func (w *WishlistsSuite) Test2() {
+	w.Run("given a cart item, when saving it for later and moving it back, then it keeps its quantity", func() {
+		w.addStock(10)
+		w.cartItemDAO.Create(daos.CartItemSchema{
+			Id:         uuid.New(),
+			CartId:     uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId:  uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:   3,
+			PriceAtAdd: utils.NewPointer(int64(2999)),
+			CreatedAt:  time.Now().UTC(),
+		})
+
+		response := w.testEnvironment.Request(w.accessToken, "POST", "/v1/save-product-for-later", `{"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"}`)
+		w.Require().Equal(204, response.StatusCode)
+		w.Empty(w.cartItemDAO.FindAllByCartId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747")))
+
+		wishlistsSchema := w.wishlistDAO.FindAllByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		w.Require().Len(wishlistsSchema, 1)
+		w.Equal("saved_for_later", wishlistsSchema[0].Kind)
+
+		wishlistItemsSchema := w.wishlistItemDAO.FindAllByWishlistId(wishlistsSchema[0].Id)
+		w.Require().Len(wishlistItemsSchema, 1)
+		w.Equal(int32(3), wishlistItemsSchema[0].Quantity)
+
+		response = w.testEnvironment.Request(w.accessToken, "POST", "/v1/move-wishlist-item-to-cart", `
+			{
+				"wishlistId": "`+wishlistsSchema[0].Id.String()+`",
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"
+			}
+		`)
+		w.Require().Equal(204, response.StatusCode)
+		w.Empty(w.wishlistItemDAO.FindAllByWishlistId(wishlistsSchema[0].Id))
+
+		cartItemSchema := w.cartItemDAO.FindOneByCartIdAndProductId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"))
+		w.Require().NotNil(cartItemSchema)
+		w.Equal(int32(3), cartItemSchema.Quantity)
+	})
+}
+
+func (w *WishlistsSuite) Test3() {
+	w.Run("given a saved product with less stock than its quantity, when moving it to the cart, then returns 409 and keeps it saved", func() {
+		w.addStock(1)
+		w.cartItemDAO.Create(daos.CartItemSchema{
+			Id:        uuid.New(),
+			CartId:    uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747"),
+			ProductId: uuid.MustParse("c0981e5b-9cb7-4623-9713-55db0317dc1a"),
+			Quantity:  2,
+			CreatedAt: time.Now().UTC(),
+		})
+
+		response := w.testEnvironment.Request(w.accessToken, "POST", "/v1/save-product-for-later", `{"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"}`)
+		w.Require().Equal(204, response.StatusCode)
+
+		wishlistsSchema := w.wishlistDAO.FindAllByCustomerId(uuid.MustParse("f59207c8-e837-4159-b67d-78c716510747"))
+		w.Require().Len(wishlistsSchema, 1)
+
+		response = w.testEnvironment.Request(w.accessToken, "POST", "/v1/move-wishlist-item-to-cart", `
+			{
+				"wishlistId": "`+wishlistsSchema[0].Id.String()+`",
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		w.Equal(409, response.StatusCode)
+		w.JSONEq(`{"message": "product quantity exceeds the stock available"}`, string(body))
+		w.Len(w.wishlistItemDAO.FindAllByWishlistId(wishlistsSchema[0].Id), 1)
+		w.Empty(w.cartItemDAO.FindAllByCartId(uuid.MustParse("bb8357b2-b978-4675-9521-ef2da0bd1747")))
+	})
+}
+
+func (w *WishlistsSuite) Test4() {
+	w.Run("given a wishlist of another customer, when adding a product to it, then returns 409", func() {
+		w.customerDAO.Create(daos.CustomerSchema{
+			Id:        uuid.MustParse("0b2e3c1a-4d5f-4a6b-8c7d-9e0f1a2b3c4d"),
+			Name:      "Jane Doe",
+			Email:     "jane.doe@gmail.com",
+			Password:  "$2a$10$asLIHej6kxd3Fsdc76QHieBugwCGvsYJeLiZmP1K7/t1GbIbUy.pK",
+			CreatedAt: time.Now().UTC(),
+		})
+		w.wishlistDAO.Create(daos.WishlistSchema{
+			Id:         uuid.MustParse("5d1f0f0e-6c3b-4f4a-9b8e-2a7c6d5e4f3a"),
+			CustomerId: uuid.MustParse("0b2e3c1a-4d5f-4a6b-8c7d-9e0f1a2b3c4d"),
+			Kind:       "wishlist",
+			Name:       "Birthday",
+			CreatedAt:  time.Now().UTC(),
+		})
+
+		response := w.testEnvironment.Request(w.accessToken, "POST", "/v1/add-product-to-wishlist", `
+			{
+				"wishlistId": "5d1f0f0e-6c3b-4f4a-9b8e-2a7c6d5e4f3a",
+				"productId": "c0981e5b-9cb7-4623-9713-55db0317dc1a"
+			}
+		`)
+
+		body := utils.GetOrThrow(io.ReadAll(response.Body))
+		w.Equal(409, response.StatusCode)
+		w.JSONEq(`{"message": "wishlist not found"}`, string(body))
+	})
+}
+
+func TestWishlists(t *testing.T) {
+	suite.Run(t, new(WishlistsSuite))
+}
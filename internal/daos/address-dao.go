@@ -71,6 +71,46 @@ func (c *AddressDAO) FindAllByCustomerId(customerId uuid.UUID) []AddressSchema {
 	return addressSchema
 }
 
+func (c *AddressDAO) FindOneByIdAndCustomerId(id uuid.UUID, customerId uuid.UUID) *AddressSchema {
+	var addressSchema AddressSchema
+
+	err := c.pgxPool.QueryRow(context.Background(),
+		`SELECT id, customer_id, is_default, street, city, state, number, zip_code, address_line, created_at FROM addresses
+		WHERE id = $1 AND customer_id = $2`, id, customerId).
+		Scan(&addressSchema.Id, &addressSchema.CustomerId, &addressSchema.IsDefault, &addressSchema.Street, &addressSchema.City,
+			&addressSchema.State, &addressSchema.Number, &addressSchema.ZipCode, &addressSchema.AddressLine, &addressSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &addressSchema
+}
+
+func (c *AddressDAO) FindOneDefaultByCustomerId(customerId uuid.UUID) *AddressSchema {
+	var addressSchema AddressSchema
+
+	err := c.pgxPool.QueryRow(context.Background(),
+		`SELECT id, customer_id, is_default, street, city, state, number, zip_code, address_line, created_at FROM addresses
+		WHERE customer_id = $1 AND is_default = TRUE ORDER BY created_at DESC LIMIT 1`, customerId).
+		Scan(&addressSchema.Id, &addressSchema.CustomerId, &addressSchema.IsDefault, &addressSchema.Street, &addressSchema.City,
+			&addressSchema.State, &addressSchema.Number, &addressSchema.ZipCode, &addressSchema.AddressLine, &addressSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &addressSchema
+}
+
 func (c *AddressDAO) DeletAll() {
 	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "TRUNCATE TABLE addresses CASCADE"))
 }
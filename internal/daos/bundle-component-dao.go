@@ -0,0 +1,53 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BundleComponentSchema struct {
+	Id                 uuid.UUID
+	BundleProductId    uuid.UUID
+	ComponentProductId uuid.UUID
+	Quantity           int32
+	CreatedAt          time.Time
+}
+
+type BundleComponentDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewBundleComponentDAO(pgxPool *pgxpool.Pool) BundleComponentDAO {
+	return BundleComponentDAO{pgxPool}
+}
+
+func (b *BundleComponentDAO) Create(bundleComponentSchema BundleComponentSchema) {
+	_ = utils.GetOrThrow(b.pgxPool.Exec(context.Background(),
+		"INSERT INTO bundle_components (id, bundle_product_id, component_product_id, quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
+		bundleComponentSchema.Id, bundleComponentSchema.BundleProductId, bundleComponentSchema.ComponentProductId,
+		bundleComponentSchema.Quantity, bundleComponentSchema.CreatedAt))
+}
+
+func (b *BundleComponentDAO) FindAllByBundleProductId(bundleProductId uuid.UUID) []BundleComponentSchema {
+	rows := utils.GetOrThrow(b.pgxPool.Query(context.Background(),
+		`SELECT id, bundle_product_id, component_product_id, quantity, created_at FROM bundle_components
+		WHERE bundle_product_id = $1 ORDER BY component_product_id`, bundleProductId))
+
+	bundleComponentsSchema := []BundleComponentSchema{}
+	for rows.Next() {
+		var item BundleComponentSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.BundleProductId, &item.ComponentProductId, &item.Quantity, &item.CreatedAt))
+		bundleComponentsSchema = append(bundleComponentsSchema, item)
+	}
+
+	return bundleComponentsSchema
+}
+
+func (b *BundleComponentDAO) DeletAll() {
+	_ = utils.GetOrThrow(b.pgxPool.Exec(context.Background(), "TRUNCATE TABLE bundle_components"))
+}
@@ -11,9 +11,14 @@ import (
 )
 
 type CartSchema struct {
-	Id         uuid.UUID
-	CustomerId uuid.UUID
-	CreatedAt  time.Time
+	Id               uuid.UUID
+	CustomerId       uuid.UUID
+	Currency         string
+	Version          int32
+	PromotionId      *uuid.UUID
+	ShippingMethodId *uuid.UUID
+	LastActivityAt   time.Time
+	CreatedAt        time.Time
 }
 
 type CartDAO struct {
@@ -25,15 +30,16 @@ func NewCartDAO(pgxPool *pgxpool.Pool) CartDAO {
 }
 
 func (c *CartDAO) Create(cartSchema CartSchema) {
-	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "INSERT INTO carts (id, customer_id, created_at) VALUES ($1, $2, $3)",
-		cartSchema.Id, cartSchema.CustomerId, cartSchema.CreatedAt))
+	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "INSERT INTO carts (id, customer_id, currency, last_activity_at, created_at) VALUES ($1, $2, COALESCE(NULLIF($3, ''), 'USD'), $4, $4)",
+		cartSchema.Id, cartSchema.CustomerId, cartSchema.Currency, cartSchema.CreatedAt))
 }
 
 func (c *CartDAO) FindOneByCustomerId(customerId uuid.UUID) *CartSchema {
 	var cartSchema CartSchema
 
-	err := c.pgxPool.QueryRow(context.Background(), "SELECT id, customer_id, created_at FROM carts WHERE customer_id = $1", customerId).
-		Scan(&cartSchema.Id, &cartSchema.CustomerId, &cartSchema.CreatedAt)
+	err := c.pgxPool.QueryRow(context.Background(), "SELECT id, customer_id, currency, version, promotion_id, shipping_method_id, last_activity_at, created_at FROM carts WHERE customer_id = $1", customerId).
+		Scan(&cartSchema.Id, &cartSchema.CustomerId, &cartSchema.Currency, &cartSchema.Version, &cartSchema.PromotionId, &cartSchema.ShippingMethodId,
+			&cartSchema.LastActivityAt, &cartSchema.CreatedAt)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
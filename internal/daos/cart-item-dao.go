@@ -11,11 +11,12 @@ import (
 )
 
 type CartItemSchema struct {
-	Id        uuid.UUID
-	CartId    uuid.UUID
-	ProductId uuid.UUID
-	Quantity  int32
-	CreatedAt time.Time
+	Id         uuid.UUID
+	CartId     uuid.UUID
+	ProductId  uuid.UUID
+	Quantity   int32
+	PriceAtAdd *int64
+	CreatedAt  time.Time
 }
 
 type CartItemDAO struct {
@@ -27,19 +28,19 @@ func NewCartItemDAO(pgxPool *pgxpool.Pool) CartItemDAO {
 }
 
 func (c *CartItemDAO) Create(cartItemSchema CartItemSchema) {
-	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "INSERT INTO cart_items (id, cart_id, product_id, quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
-		cartItemSchema.Id, cartItemSchema.CartId, cartItemSchema.ProductId, cartItemSchema.Quantity, cartItemSchema.CreatedAt))
+	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "INSERT INTO cart_items (id, cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		cartItemSchema.Id, cartItemSchema.CartId, cartItemSchema.ProductId, cartItemSchema.Quantity, cartItemSchema.PriceAtAdd, cartItemSchema.CreatedAt))
 }
 
 func (c *CartItemDAO) FindAllByCartId(cartId uuid.UUID) []CartItemSchema {
 	rows := utils.GetOrThrow(c.pgxPool.Query(context.Background(),
-		"SELECT id, cart_id, product_id, quantity, created_at FROM cart_items WHERE cart_id = $1", cartId))
+		"SELECT id, cart_id, product_id, quantity, price_at_add, created_at FROM cart_items WHERE cart_id = $1", cartId))
 
 	var cartItemsSchema []CartItemSchema
 	for rows.Next() {
 		var item CartItemSchema
 
-		utils.ThrowOnError(rows.Scan(&item.Id, &item.CartId, &item.ProductId, &item.Quantity, &item.CreatedAt))
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.CartId, &item.ProductId, &item.Quantity, &item.PriceAtAdd, &item.CreatedAt))
 		cartItemsSchema = append(cartItemsSchema, item)
 	}
 
@@ -66,8 +67,8 @@ func (c *CartItemDAO) FindOneByCartIdAndProductId(cartId uuid.UUID, productId uu
 	var cartItemSchema CartItemSchema
 
 	err := c.pgxPool.QueryRow(context.Background(),
-		"SELECT id, cart_id, product_id, quantity, created_at FROM cart_items WHERE cart_id = $1 AND product_id = $2", cartId, productId).
-		Scan(&cartItemSchema.Id, &cartItemSchema.CartId, &cartItemSchema.ProductId, &cartItemSchema.Quantity, &cartItemSchema.CreatedAt)
+		"SELECT id, cart_id, product_id, quantity, price_at_add, created_at FROM cart_items WHERE cart_id = $1 AND product_id = $2", cartId, productId).
+		Scan(&cartItemSchema.Id, &cartItemSchema.CartId, &cartItemSchema.ProductId, &cartItemSchema.Quantity, &cartItemSchema.PriceAtAdd, &cartItemSchema.CreatedAt)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
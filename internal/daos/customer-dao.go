@@ -11,11 +11,14 @@ import (
 )
 
 type CustomerSchema struct {
-	Id        uuid.UUID
-	Name      string
-	Email     string
-	Password  string
-	CreatedAt time.Time
+	Id                    uuid.UUID
+	Name                  string
+	Email                 string
+	Password              string
+	IsGuest               bool
+	CartRemindersOptedOut bool
+	TaxExempt             bool
+	CreatedAt             time.Time
 }
 
 type CustomerDAO struct {
@@ -28,16 +31,40 @@ func NewCustomerDAO(pgxPool *pgxpool.Pool) CustomerDAO {
 
 func (p *CustomerDAO) Create(customerSchema CustomerSchema) {
 	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
-		"INSERT INTO customers (id, name, email, password, created_at) VALUES ($1, $2, $3, $4, $5)",
-		customerSchema.Id, customerSchema.Name, customerSchema.Email, customerSchema.Password, customerSchema.CreatedAt))
+		`INSERT INTO customers (id, name, email, password, is_guest, cart_reminders_opted_out, tax_exempt, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		customerSchema.Id, customerSchema.Name, customerSchema.Email, customerSchema.Password, customerSchema.IsGuest,
+		customerSchema.CartRemindersOptedOut, customerSchema.TaxExempt, customerSchema.CreatedAt))
 }
 
 func (c *CustomerDAO) FindOneByEmail(email string) *CustomerSchema {
 	var customerSchema CustomerSchema
 
 	err := c.pgxPool.QueryRow(context.Background(),
-		"SELECT id, name, email, password, created_at FROM customers WHERE email = $1", email).
-		Scan(&customerSchema.Id, &customerSchema.Name, &customerSchema.Email, &customerSchema.Password, &customerSchema.CreatedAt)
+		`SELECT id, name, email, password, is_guest, cart_reminders_opted_out, tax_exempt, created_at FROM customers
+		WHERE email = $1`, email).
+		Scan(&customerSchema.Id, &customerSchema.Name, &customerSchema.Email, &customerSchema.Password, &customerSchema.IsGuest,
+			&customerSchema.CartRemindersOptedOut, &customerSchema.TaxExempt, &customerSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &customerSchema
+}
+
+func (c *CustomerDAO) FindOneById(id uuid.UUID) *CustomerSchema {
+	var customerSchema CustomerSchema
+
+	err := c.pgxPool.QueryRow(context.Background(),
+		`SELECT id, name, email, password, is_guest, cart_reminders_opted_out, tax_exempt, created_at FROM customers
+		WHERE id = $1`, id).
+		Scan(&customerSchema.Id, &customerSchema.Name, &customerSchema.Email, &customerSchema.Password, &customerSchema.IsGuest,
+			&customerSchema.CartRemindersOptedOut, &customerSchema.TaxExempt, &customerSchema.CreatedAt)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
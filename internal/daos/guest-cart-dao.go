@@ -0,0 +1,57 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GuestCartSchema struct {
+	Id         uuid.UUID
+	Currency   string
+	ExpiresAt  time.Time
+	CustomerId *uuid.UUID
+	AddressId  *uuid.UUID
+	CreatedAt  time.Time
+}
+
+type GuestCartDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGuestCartDAO(pgxPool *pgxpool.Pool) GuestCartDAO {
+	return GuestCartDAO{pgxPool}
+}
+
+func (g *GuestCartDAO) Create(guestCartSchema GuestCartSchema) {
+	_ = utils.GetOrThrow(g.pgxPool.Exec(context.Background(),
+		"INSERT INTO guest_carts (id, currency, expires_at, created_at) VALUES ($1, COALESCE(NULLIF($2, ''), 'USD'), $3, $4)",
+		guestCartSchema.Id, guestCartSchema.Currency, guestCartSchema.ExpiresAt, guestCartSchema.CreatedAt))
+}
+
+func (g *GuestCartDAO) FindOneById(id uuid.UUID) *GuestCartSchema {
+	var guestCartSchema GuestCartSchema
+
+	err := g.pgxPool.QueryRow(context.Background(),
+		"SELECT id, currency, expires_at, customer_id, address_id, created_at FROM guest_carts WHERE id = $1", id).
+		Scan(&guestCartSchema.Id, &guestCartSchema.Currency, &guestCartSchema.ExpiresAt, &guestCartSchema.CustomerId,
+			&guestCartSchema.AddressId, &guestCartSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &guestCartSchema
+}
+
+func (g *GuestCartDAO) DeletAll() {
+	_ = utils.GetOrThrow(g.pgxPool.Exec(context.Background(), "TRUNCATE TABLE guest_carts CASCADE"))
+}
@@ -0,0 +1,75 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GuestCartItemSchema struct {
+	Id          uuid.UUID
+	GuestCartId uuid.UUID
+	ProductId   uuid.UUID
+	Quantity    int32
+	PriceAtAdd  *int64
+	CreatedAt   time.Time
+}
+
+type GuestCartItemDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGuestCartItemDAO(pgxPool *pgxpool.Pool) GuestCartItemDAO {
+	return GuestCartItemDAO{pgxPool}
+}
+
+func (g *GuestCartItemDAO) Create(guestCartItemSchema GuestCartItemSchema) {
+	_ = utils.GetOrThrow(g.pgxPool.Exec(context.Background(),
+		"INSERT INTO guest_cart_items (id, guest_cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		guestCartItemSchema.Id, guestCartItemSchema.GuestCartId, guestCartItemSchema.ProductId, guestCartItemSchema.Quantity,
+		guestCartItemSchema.PriceAtAdd, guestCartItemSchema.CreatedAt))
+}
+
+func (g *GuestCartItemDAO) FindAllByGuestCartId(guestCartId uuid.UUID) []GuestCartItemSchema {
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		"SELECT id, guest_cart_id, product_id, quantity, price_at_add, created_at FROM guest_cart_items WHERE guest_cart_id = $1 ORDER BY created_at",
+		guestCartId))
+
+	var guestCartItemsSchema []GuestCartItemSchema
+	for rows.Next() {
+		var item GuestCartItemSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.GuestCartId, &item.ProductId, &item.Quantity, &item.PriceAtAdd, &item.CreatedAt))
+		guestCartItemsSchema = append(guestCartItemsSchema, item)
+	}
+
+	return guestCartItemsSchema
+}
+
+func (g *GuestCartItemDAO) FindOneByGuestCartIdAndProductId(guestCartId uuid.UUID, productId uuid.UUID) *GuestCartItemSchema {
+	var guestCartItemSchema GuestCartItemSchema
+
+	err := g.pgxPool.QueryRow(context.Background(),
+		"SELECT id, guest_cart_id, product_id, quantity, price_at_add, created_at FROM guest_cart_items WHERE guest_cart_id = $1 AND product_id = $2",
+		guestCartId, productId).
+		Scan(&guestCartItemSchema.Id, &guestCartItemSchema.GuestCartId, &guestCartItemSchema.ProductId, &guestCartItemSchema.Quantity,
+			&guestCartItemSchema.PriceAtAdd, &guestCartItemSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &guestCartItemSchema
+}
+
+func (g *GuestCartItemDAO) DeletAll() {
+	_ = utils.GetOrThrow(g.pgxPool.Exec(context.Background(), "TRUNCATE TABLE guest_cart_items CASCADE"))
+}
@@ -13,7 +13,9 @@ import (
 type InventorySchema struct {
 	Id            uuid.UUID
 	ProductId     uuid.UUID
+	WarehouseId   uuid.UUID
 	StockQuantity int32
+	ReorderPoint  int32
 	CreatedAt     time.Time
 }
 
@@ -27,16 +29,22 @@ func NewInventoryDAO(pgxPool *pgxpool.Pool) InventoryDAO {
 
 func (p *InventoryDAO) Create(inventorySchema InventorySchema) {
 	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
-		"INSERT INTO inventories (id, product_id, stock_quantity, created_at) VALUES ($1, $2, $3, $4)",
-		inventorySchema.Id, inventorySchema.ProductId, inventorySchema.StockQuantity, inventorySchema.CreatedAt))
+		`INSERT INTO inventories (id, product_id, warehouse_id, stock_quantity, reorder_point, created_at)
+		VALUES ($1, $2, COALESCE(NULLIF($3, $7::UUID), (SELECT id FROM warehouses WHERE is_default)), $4, $5, $6)`,
+		inventorySchema.Id, inventorySchema.ProductId, inventorySchema.WarehouseId, inventorySchema.StockQuantity, inventorySchema.ReorderPoint,
+		inventorySchema.CreatedAt, uuid.Nil))
 }
 
+// FindOneByProductId returns the product inventory in the default warehouse.
 func (m *InventoryDAO) FindOneByProductId(productId uuid.UUID) *InventorySchema {
 	var inventorySchema InventorySchema
 
 	err := m.pgxPool.QueryRow(context.Background(),
-		"SELECT id, product_id, stock_quantity, created_at FROM inventories WHERE product_id = $1", productId).
-		Scan(&inventorySchema.Id, &inventorySchema.ProductId, &inventorySchema.StockQuantity, &inventorySchema.CreatedAt)
+		`SELECT i.id, i.product_id, i.warehouse_id, i.stock_quantity, i.reorder_point, i.created_at FROM inventories i
+		JOIN warehouses w ON w.id = i.warehouse_id
+		WHERE i.product_id = $1 AND w.is_default`, productId).
+		Scan(&inventorySchema.Id, &inventorySchema.ProductId, &inventorySchema.WarehouseId, &inventorySchema.StockQuantity,
+			&inventorySchema.ReorderPoint, &inventorySchema.CreatedAt)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
@@ -49,6 +57,37 @@ func (m *InventoryDAO) FindOneByProductId(productId uuid.UUID) *InventorySchema
 	return &inventorySchema
 }
 
+func (m *InventoryDAO) FindOneByProductIdAndWarehouseId(productId uuid.UUID, warehouseId uuid.UUID) *InventorySchema {
+	var inventorySchema InventorySchema
+
+	err := m.pgxPool.QueryRow(context.Background(),
+		"SELECT id, product_id, warehouse_id, stock_quantity, reorder_point, created_at FROM inventories WHERE product_id = $1 AND warehouse_id = $2",
+		productId, warehouseId).
+		Scan(&inventorySchema.Id, &inventorySchema.ProductId, &inventorySchema.WarehouseId, &inventorySchema.StockQuantity,
+			&inventorySchema.ReorderPoint, &inventorySchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &inventorySchema
+}
+
+// SumStockQuantityByProductId returns the stock available for a product across all warehouses. For a
+// bundle it is the number of bundles its component stock can fill.
+func (m *InventoryDAO) SumStockQuantityByProductId(productId uuid.UUID) int32 {
+	var stockQuantity int32
+
+	utils.ThrowOnError(m.pgxPool.QueryRow(context.Background(),
+		"SELECT COALESCE(SUM(stock_quantity), 0)::INT FROM product_available_stock WHERE product_id = $1", productId).Scan(&stockQuantity))
+
+	return stockQuantity
+}
+
 func (m *InventoryDAO) ExistsById(id uuid.UUID) bool {
 	var inventorySchema InventorySchema
 
@@ -0,0 +1,62 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InventoryMovementSchema struct {
+	Id          uuid.UUID
+	InventoryId uuid.UUID
+	ProductId   uuid.UUID
+	Reason      string
+	Quantity    int32
+	Balance     int32
+	ActorId     *uuid.UUID
+	ReferenceId *uuid.UUID
+	Note        *string
+	CreatedAt   time.Time
+}
+
+type InventoryMovementDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewInventoryMovementDAO(pgxPool *pgxpool.Pool) InventoryMovementDAO {
+	return InventoryMovementDAO{pgxPool}
+}
+
+func (i *InventoryMovementDAO) Create(inventoryMovementSchema InventoryMovementSchema) {
+	_ = utils.GetOrThrow(i.pgxPool.Exec(context.Background(),
+		`INSERT INTO inventory_movements (id, inventory_id, product_id, reason, quantity, balance, actor_id, reference_id, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		inventoryMovementSchema.Id, inventoryMovementSchema.InventoryId, inventoryMovementSchema.ProductId, inventoryMovementSchema.Reason,
+		inventoryMovementSchema.Quantity, inventoryMovementSchema.Balance, inventoryMovementSchema.ActorId, inventoryMovementSchema.ReferenceId,
+		inventoryMovementSchema.Note, inventoryMovementSchema.CreatedAt))
+}
+
+func (i *InventoryMovementDAO) FindAllByProductId(productId uuid.UUID) []InventoryMovementSchema {
+	rows := utils.GetOrThrow(i.pgxPool.Query(context.Background(),
+		`SELECT id, inventory_id, product_id, reason, quantity, balance, actor_id, reference_id, note, created_at FROM inventory_movements
+		WHERE product_id = $1 ORDER BY created_at, id`, productId))
+
+	var inventoryMovementsSchema []InventoryMovementSchema
+	for rows.Next() {
+		var item InventoryMovementSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.InventoryId, &item.ProductId, &item.Reason, &item.Quantity, &item.Balance,
+			&item.ActorId, &item.ReferenceId, &item.Note, &item.CreatedAt))
+		inventoryMovementsSchema = append(inventoryMovementsSchema, item)
+	}
+
+	return inventoryMovementsSchema
+}
+
+// DeletAll truncates the ledger. TRUNCATE does not fire the append-only row trigger, so it is meant for tests only.
+func (i *InventoryMovementDAO) DeletAll() {
+	_ = utils.GetOrThrow(i.pgxPool.Exec(context.Background(), "TRUNCATE TABLE inventory_movements"))
+}
@@ -0,0 +1,50 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LowStockAlertSchema struct {
+	Id            uuid.UUID
+	InventoryId   uuid.UUID
+	ProductId     uuid.UUID
+	WarehouseId   uuid.UUID
+	StockQuantity int32
+	ReorderPoint  int32
+	CreatedAt     time.Time
+	NotifiedAt    *time.Time
+}
+
+type LowStockAlertDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewLowStockAlertDAO(pgxPool *pgxpool.Pool) LowStockAlertDAO {
+	return LowStockAlertDAO{pgxPool}
+}
+
+func (l *LowStockAlertDAO) FindAllByProductId(productId uuid.UUID) []LowStockAlertSchema {
+	rows := utils.GetOrThrow(l.pgxPool.Query(context.Background(),
+		`SELECT id, inventory_id, product_id, warehouse_id, stock_quantity, reorder_point, created_at, notified_at FROM low_stock_alerts
+		WHERE product_id = $1 ORDER BY created_at, id`, productId))
+
+	var lowStockAlertsSchema []LowStockAlertSchema
+	for rows.Next() {
+		var item LowStockAlertSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.InventoryId, &item.ProductId, &item.WarehouseId, &item.StockQuantity,
+			&item.ReorderPoint, &item.CreatedAt, &item.NotifiedAt))
+		lowStockAlertsSchema = append(lowStockAlertsSchema, item)
+	}
+
+	return lowStockAlertsSchema
+}
+
+func (l *LowStockAlertDAO) DeletAll() {
+	_ = utils.GetOrThrow(l.pgxPool.Exec(context.Background(), "TRUNCATE TABLE low_stock_alerts"))
+}
@@ -11,11 +11,25 @@ import (
 )
 
 type OrderSchema struct {
-	Id            uuid.UUID
-	CustomerId    uuid.UUID
-	TotalPrice    int64
-	TotalQuantity int32
-	CreatedAt     time.Time
+	Id               uuid.UUID
+	CustomerId       uuid.UUID
+	TotalPrice       int64
+	TaxAmount        int64
+	ShippingPrice    int64
+	TotalQuantity    int32
+	Currency         string
+	CreatedAt        time.Time
+	LookupLinkSentAt *time.Time
+
+	ShippingMethodId   *uuid.UUID
+	ShippingMethodName *string
+
+	Status      string
+	FulfilledAt *time.Time
+	CancelledAt *time.Time
+	CancelledBy *uuid.UUID
+
+	RepricedTotal *int64
 }
 
 type OrderDAO struct {
@@ -28,16 +42,45 @@ func NewOrderDAO(pgxPool *pgxpool.Pool) OrderDAO {
 
 func (o *OrderDAO) Create(orderSchema OrderSchema) {
 	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(),
-		"INSERT INTO carts (id, customer_id, total_price, total_quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
-		orderSchema.Id, orderSchema.CustomerId, orderSchema.TotalPrice, orderSchema.TotalQuantity, orderSchema.CreatedAt))
+		`INSERT INTO orders (id, customer_id, total_price, tax_amount, total_quantity, currency, created_at, status)
+		VALUES ($1, $2, $3, $4, $5, COALESCE(NULLIF($6, ''), 'USD'), $7, COALESCE(NULLIF($8, ''), 'placed'))`,
+		orderSchema.Id, orderSchema.CustomerId, orderSchema.TotalPrice, orderSchema.TaxAmount, orderSchema.TotalQuantity, orderSchema.Currency,
+		orderSchema.CreatedAt, orderSchema.Status))
 }
 
 func (o *OrderDAO) FindOneByCustomerId(customerId uuid.UUID) *OrderSchema {
 	var orderSchema OrderSchema
 
 	err := o.pgxPool.QueryRow(context.Background(),
-		"SELECT id, customer_id, total_price, total_quantity, created_at FROM orders WHERE customer_id = $1", customerId).
-		Scan(&orderSchema.Id, &orderSchema.CustomerId, &orderSchema.TotalPrice, &orderSchema.TotalQuantity, &orderSchema.CreatedAt)
+		`SELECT id, customer_id, total_price, tax_amount, total_quantity, currency, created_at, lookup_link_sent_at,
+		shipping_price, shipping_method_id, shipping_method_name, status, fulfilled_at, cancelled_at, cancelled_by, repriced_total FROM orders
+		WHERE customer_id = $1`,
+		customerId).
+		Scan(&orderSchema.Id, &orderSchema.CustomerId, &orderSchema.TotalPrice, &orderSchema.TaxAmount, &orderSchema.TotalQuantity, &orderSchema.Currency,
+			&orderSchema.CreatedAt, &orderSchema.LookupLinkSentAt, &orderSchema.ShippingPrice, &orderSchema.ShippingMethodId, &orderSchema.ShippingMethodName,
+			&orderSchema.Status, &orderSchema.FulfilledAt, &orderSchema.CancelledAt, &orderSchema.CancelledBy, &orderSchema.RepricedTotal)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &orderSchema
+}
+
+func (o *OrderDAO) FindOneById(id uuid.UUID) *OrderSchema {
+	var orderSchema OrderSchema
+
+	err := o.pgxPool.QueryRow(context.Background(),
+		`SELECT id, customer_id, total_price, tax_amount, total_quantity, currency, created_at, lookup_link_sent_at,
+		shipping_price, shipping_method_id, shipping_method_name, status, fulfilled_at, cancelled_at, cancelled_by, repriced_total FROM orders
+		WHERE id = $1`, id).
+		Scan(&orderSchema.Id, &orderSchema.CustomerId, &orderSchema.TotalPrice, &orderSchema.TaxAmount, &orderSchema.TotalQuantity, &orderSchema.Currency,
+			&orderSchema.CreatedAt, &orderSchema.LookupLinkSentAt, &orderSchema.ShippingPrice, &orderSchema.ShippingMethodId, &orderSchema.ShippingMethodName,
+			&orderSchema.Status, &orderSchema.FulfilledAt, &orderSchema.CancelledAt, &orderSchema.CancelledBy, &orderSchema.RepricedTotal)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
@@ -0,0 +1,50 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderDiscountSchema struct {
+	Id          uuid.UUID
+	OrderId     uuid.UUID
+	PromotionId uuid.UUID
+	Code        string
+	Type        string
+	Amount      int64
+	Currency    string
+	CreatedAt   time.Time
+}
+
+type OrderDiscountDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewOrderDiscountDAO(pgxPool *pgxpool.Pool) OrderDiscountDAO {
+	return OrderDiscountDAO{pgxPool}
+}
+
+func (o *OrderDiscountDAO) FindAllByOrderId(orderId uuid.UUID) []OrderDiscountSchema {
+	rows := utils.GetOrThrow(o.pgxPool.Query(context.Background(),
+		"SELECT id, order_id, promotion_id, code, type, amount, currency, created_at FROM order_discounts WHERE order_id = $1 ORDER BY created_at",
+		orderId))
+
+	orderDiscountsSchema := []OrderDiscountSchema{}
+	for rows.Next() {
+		var item OrderDiscountSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.OrderId, &item.PromotionId, &item.Code, &item.Type, &item.Amount, &item.Currency,
+			&item.CreatedAt))
+		orderDiscountsSchema = append(orderDiscountsSchema, item)
+	}
+
+	return orderDiscountsSchema
+}
+
+func (o *OrderDiscountDAO) DeletAll() {
+	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(), "TRUNCATE TABLE order_discounts CASCADE"))
+}
@@ -0,0 +1,47 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderItemAllocationSchema struct {
+	Id          uuid.UUID
+	OrderItemId uuid.UUID
+	InventoryId uuid.UUID
+	WarehouseId uuid.UUID
+	Quantity    int32
+	CreatedAt   time.Time
+}
+
+type OrderItemAllocationDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewOrderItemAllocationDAO(pgxPool *pgxpool.Pool) OrderItemAllocationDAO {
+	return OrderItemAllocationDAO{pgxPool}
+}
+
+func (o *OrderItemAllocationDAO) FindAllByOrderItemId(orderItemId uuid.UUID) []OrderItemAllocationSchema {
+	rows := utils.GetOrThrow(o.pgxPool.Query(context.Background(),
+		`SELECT id, order_item_id, inventory_id, warehouse_id, quantity, created_at FROM order_item_allocations
+		WHERE order_item_id = $1 ORDER BY quantity DESC, id`, orderItemId))
+
+	var orderItemAllocationsSchema []OrderItemAllocationSchema
+	for rows.Next() {
+		var item OrderItemAllocationSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.OrderItemId, &item.InventoryId, &item.WarehouseId, &item.Quantity, &item.CreatedAt))
+		orderItemAllocationsSchema = append(orderItemAllocationsSchema, item)
+	}
+
+	return orderItemAllocationsSchema
+}
+
+func (o *OrderItemAllocationDAO) DeletAll() {
+	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(), "TRUNCATE TABLE order_item_allocations"))
+}
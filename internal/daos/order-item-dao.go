@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -15,7 +16,12 @@ type OrderItemSchema struct {
 	ProductId uuid.UUID
 	Quantity  int32
 	Price     int64
+	Currency  string
 	CreatedAt time.Time
+
+	BackorderedQuantity int32
+	ExpectedShipDate    *time.Time
+	ParentOrderItemId   *uuid.UUID
 }
 
 type OrderItemDAO struct {
@@ -28,25 +34,60 @@ func NewOrderItemDAO(pgxPool *pgxpool.Pool) OrderItemDAO {
 
 func (o *OrderItemDAO) Create(orderItemSchema OrderItemSchema) {
 	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(),
-		"INSERT INTO order_items (id, order_id, product_id, quantity, price, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
-		orderItemSchema.Id, orderItemSchema.OrderId, orderItemSchema.ProductId, orderItemSchema.Quantity, orderItemSchema.Price, orderItemSchema.CreatedAt))
+		`INSERT INTO order_items (id, order_id, product_id, quantity, price, currency, created_at, backordered_quantity, expected_ship_date,
+		parent_order_item_id)
+		VALUES ($1, $2, $3, $4, $5, COALESCE(NULLIF($6, ''), 'USD'), $7, $8, $9, $10)`,
+		orderItemSchema.Id, orderItemSchema.OrderId, orderItemSchema.ProductId, orderItemSchema.Quantity, orderItemSchema.Price,
+		orderItemSchema.Currency, orderItemSchema.CreatedAt, orderItemSchema.BackorderedQuantity, orderItemSchema.ExpectedShipDate,
+		orderItemSchema.ParentOrderItemId))
 }
 
 func (o *OrderItemDAO) FindAllByOrderId(orderId uuid.UUID) []OrderItemSchema {
 	rows := utils.GetOrThrow(o.pgxPool.Query(context.Background(),
-		"SELECT id, order_id, product_id, quantity, price, created_at FROM order_items WHERE order_id = $1", orderId))
+		`SELECT id, order_id, product_id, quantity, price, currency, created_at, backordered_quantity, expected_ship_date,
+		parent_order_item_id
+		FROM order_items WHERE order_id = $1`, orderId))
 
 	var cartItemsSchema []OrderItemSchema
 	for rows.Next() {
 		var item OrderItemSchema
 
-		utils.ThrowOnError(rows.Scan(&item.Id, &item.OrderId, &item.ProductId, &item.Quantity, &item.Price, &item.CreatedAt))
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.OrderId, &item.ProductId, &item.Quantity, &item.Price, &item.Currency, &item.CreatedAt,
+			&item.BackorderedQuantity, &item.ExpectedShipDate, &item.ParentOrderItemId))
 		cartItemsSchema = append(cartItemsSchema, item)
 	}
 
 	return cartItemsSchema
 }
 
+func (o *OrderItemDAO) ExistsByCustomerIdAndProductId(customerId uuid.UUID, productId uuid.UUID) bool {
+	var id uuid.UUID
+
+	err := o.pgxPool.QueryRow(context.Background(),
+		"SELECT oi.id FROM order_items oi JOIN orders o ON o.id = oi.order_id WHERE o.customer_id = $1 AND oi.product_id = $2 LIMIT 1",
+		customerId, productId).Scan(&id)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return false
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return true
+}
+
+// SumBackorderedQuantityByProductId returns the units of a product sold on backorder or pre-order that are still waiting for stock.
+func (o *OrderItemDAO) SumBackorderedQuantityByProductId(productId uuid.UUID) int32 {
+	var backorderedQuantity int32
+
+	utils.ThrowOnError(o.pgxPool.QueryRow(context.Background(),
+		"SELECT COALESCE(SUM(backordered_quantity), 0)::INT FROM order_items WHERE product_id = $1", productId).Scan(&backorderedQuantity))
+
+	return backorderedQuantity
+}
+
 func (o *OrderItemDAO) DeletAll() {
 	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(), "TRUNCATE TABLE order_items CASCADE"))
 }
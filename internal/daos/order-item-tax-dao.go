@@ -0,0 +1,51 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderItemTaxSchema struct {
+	Id              uuid.UUID
+	OrderItemId     uuid.UUID
+	Jurisdiction    string
+	TaxCategory     string
+	RateBasisPoints int32
+	TaxableAmount   int64
+	Amount          int64
+	Currency        string
+	CreatedAt       time.Time
+}
+
+type OrderItemTaxDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewOrderItemTaxDAO(pgxPool *pgxpool.Pool) OrderItemTaxDAO {
+	return OrderItemTaxDAO{pgxPool}
+}
+
+func (o *OrderItemTaxDAO) FindAllByOrderItemId(orderItemId uuid.UUID) []OrderItemTaxSchema {
+	rows := utils.GetOrThrow(o.pgxPool.Query(context.Background(),
+		`SELECT id, order_item_id, jurisdiction, tax_category, rate_basis_points, taxable_amount, amount, currency, created_at
+		FROM order_item_taxes WHERE order_item_id = $1 ORDER BY created_at`, orderItemId))
+
+	orderItemTaxesSchema := []OrderItemTaxSchema{}
+	for rows.Next() {
+		var item OrderItemTaxSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.OrderItemId, &item.Jurisdiction, &item.TaxCategory, &item.RateBasisPoints,
+			&item.TaxableAmount, &item.Amount, &item.Currency, &item.CreatedAt))
+		orderItemTaxesSchema = append(orderItemTaxesSchema, item)
+	}
+
+	return orderItemTaxesSchema
+}
+
+func (o *OrderItemTaxDAO) DeletAll() {
+	_ = utils.GetOrThrow(o.pgxPool.Exec(context.Background(), "TRUNCATE TABLE order_item_taxes"))
+}
@@ -15,6 +15,7 @@ type PaymentSchema struct {
 	OrderId                     uuid.UUID
 	PaymentGatewayTransactionId string
 	PaymentGatewayName          string
+	RefundedAmount              int64
 	CreatedAt                   time.Time
 }
 
@@ -36,8 +37,30 @@ func (p *PaymentDAO) FindOneByCustomerId(customerId uuid.UUID) *PaymentSchema {
 	var paymentSchema PaymentSchema
 
 	err := p.pgxPool.QueryRow(context.Background(),
-		"SELECT p.* FROM payments p JOIN orders o ON o.id = p.order_id WHERE o.customer_id = $1", customerId).
-		Scan(&paymentSchema.Id, &paymentSchema.OrderId, &paymentSchema.PaymentGatewayName, &paymentSchema.PaymentGatewayTransactionId, &paymentSchema.CreatedAt)
+		`SELECT p.id, p.order_id, p.payment_gateway_name, p.payment_gateway_transaction_id, p.refunded_amount, p.created_at
+		FROM payments p JOIN orders o ON o.id = p.order_id WHERE o.customer_id = $1`, customerId).
+		Scan(&paymentSchema.Id, &paymentSchema.OrderId, &paymentSchema.PaymentGatewayName, &paymentSchema.PaymentGatewayTransactionId,
+			&paymentSchema.RefundedAmount, &paymentSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &paymentSchema
+}
+
+func (p *PaymentDAO) FindOneByOrderId(orderId uuid.UUID) *PaymentSchema {
+	var paymentSchema PaymentSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, order_id, payment_gateway_name, payment_gateway_transaction_id, refunded_amount, created_at
+		FROM payments WHERE order_id = $1`, orderId).
+		Scan(&paymentSchema.Id, &paymentSchema.OrderId, &paymentSchema.PaymentGatewayName, &paymentSchema.PaymentGatewayTransactionId,
+			&paymentSchema.RefundedAmount, &paymentSchema.CreatedAt)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
@@ -12,11 +12,33 @@ import (
 
 type ProductSchema struct {
 	Id          uuid.UUID
+	Sku         *string
+	Slug        *string
 	Status      string
 	Name        string
 	Description *string
 	Price       int64
+	Currency    string
 	CreatedAt   time.Time
+	IsBundle    bool
+
+	AllowsBackorder  bool
+	AllowsPreorder   bool
+	BackorderLimit   int32
+	ExpectedShipDate *time.Time
+
+	MaxPerOrder              *int32
+	MaxPerCustomer           *int32
+	MaxPerCustomerPeriodDays *int32
+
+	TaxCategory string
+
+	WeightGrams int32
+	LengthCm    *int32
+	WidthCm     *int32
+	HeightCm    *int32
+
+	ReturnWindowDays int32
 }
 
 type ProductDAO struct {
@@ -29,16 +51,30 @@ func NewProductDAO(pgxPool *pgxpool.Pool) ProductDAO {
 
 func (p *ProductDAO) Create(productSchema ProductSchema) {
 	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
-		"INSERT INTO products (id, status, name, description, price, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
-		productSchema.Id, productSchema.Status, productSchema.Name, productSchema.Description, productSchema.Price, productSchema.CreatedAt))
+		`INSERT INTO products (id, sku, slug, status, name, description, price, currency, created_at, is_bundle, allows_backorder,
+		allows_preorder, backorder_limit, expected_ship_date, max_per_order, max_per_customer, max_per_customer_period_days,
+		tax_category, weight_grams, length_cm, width_cm, height_cm, return_window_days)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE(NULLIF($8, ''), 'USD'), $9, $10, $11, $12, $13, $14, $15, $16, $17,
+		COALESCE(NULLIF($18, ''), 'general'), $19, $20, $21, $22, COALESCE(NULLIF($23, 0), 30))`,
+		productSchema.Id, productSchema.Sku, productSchema.Slug, productSchema.Status, productSchema.Name, productSchema.Description,
+		productSchema.Price, productSchema.Currency, productSchema.CreatedAt, productSchema.IsBundle, productSchema.AllowsBackorder,
+		productSchema.AllowsPreorder, productSchema.BackorderLimit, productSchema.ExpectedShipDate, productSchema.MaxPerOrder,
+		productSchema.MaxPerCustomer, productSchema.MaxPerCustomerPeriodDays, productSchema.TaxCategory, productSchema.WeightGrams,
+		productSchema.LengthCm, productSchema.WidthCm, productSchema.HeightCm, productSchema.ReturnWindowDays))
 }
 
 func (p *ProductDAO) FindOneById(id uuid.UUID) *ProductSchema {
 	var productSchema ProductSchema
 
 	err := p.pgxPool.QueryRow(context.Background(),
-		"SELECT id, status, name, description, price, created_at FROM products WHERE id = $1", id).
-		Scan(&productSchema.Id, &productSchema.Status, &productSchema.Name, &productSchema.Description, &productSchema.Price, &productSchema.CreatedAt)
+		`SELECT id, sku, slug, status, name, description, price, currency, created_at, is_bundle, allows_backorder,
+		allows_preorder, backorder_limit, expected_ship_date, max_per_order, max_per_customer, max_per_customer_period_days,
+		tax_category, weight_grams, length_cm, width_cm, height_cm, return_window_days FROM products WHERE id = $1`, id).
+		Scan(&productSchema.Id, &productSchema.Sku, &productSchema.Slug, &productSchema.Status, &productSchema.Name, &productSchema.Description,
+			&productSchema.Price, &productSchema.Currency, &productSchema.CreatedAt, &productSchema.IsBundle, &productSchema.AllowsBackorder,
+			&productSchema.AllowsPreorder, &productSchema.BackorderLimit, &productSchema.ExpectedShipDate, &productSchema.MaxPerOrder,
+			&productSchema.MaxPerCustomer, &productSchema.MaxPerCustomerPeriodDays, &productSchema.TaxCategory, &productSchema.WeightGrams,
+			&productSchema.LengthCm, &productSchema.WidthCm, &productSchema.HeightCm, &productSchema.ReturnWindowDays)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
@@ -55,8 +91,62 @@ func (p *ProductDAO) FindOneByName(name string) *ProductSchema {
 	var productSchema ProductSchema
 
 	err := p.pgxPool.QueryRow(context.Background(),
-		"SELECT id, status, name, description, price, created_at FROM products WHERE name = $1", name).
-		Scan(&productSchema.Id, &productSchema.Status, &productSchema.Name, &productSchema.Description, &productSchema.Price, &productSchema.CreatedAt)
+		`SELECT id, sku, slug, status, name, description, price, currency, created_at, is_bundle, allows_backorder,
+		allows_preorder, backorder_limit, expected_ship_date, max_per_order, max_per_customer, max_per_customer_period_days,
+		tax_category, weight_grams, length_cm, width_cm, height_cm, return_window_days FROM products WHERE name = $1`, name).
+		Scan(&productSchema.Id, &productSchema.Sku, &productSchema.Slug, &productSchema.Status, &productSchema.Name, &productSchema.Description,
+			&productSchema.Price, &productSchema.Currency, &productSchema.CreatedAt, &productSchema.IsBundle, &productSchema.AllowsBackorder,
+			&productSchema.AllowsPreorder, &productSchema.BackorderLimit, &productSchema.ExpectedShipDate, &productSchema.MaxPerOrder,
+			&productSchema.MaxPerCustomer, &productSchema.MaxPerCustomerPeriodDays, &productSchema.TaxCategory, &productSchema.WeightGrams,
+			&productSchema.LengthCm, &productSchema.WidthCm, &productSchema.HeightCm, &productSchema.ReturnWindowDays)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &productSchema
+}
+
+func (p *ProductDAO) FindOneBySku(sku string) *ProductSchema {
+	var productSchema ProductSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, sku, slug, status, name, description, price, currency, created_at, is_bundle, allows_backorder,
+		allows_preorder, backorder_limit, expected_ship_date, max_per_order, max_per_customer, max_per_customer_period_days,
+		tax_category, weight_grams, length_cm, width_cm, height_cm, return_window_days FROM products WHERE sku = $1`, sku).
+		Scan(&productSchema.Id, &productSchema.Sku, &productSchema.Slug, &productSchema.Status, &productSchema.Name, &productSchema.Description,
+			&productSchema.Price, &productSchema.Currency, &productSchema.CreatedAt, &productSchema.IsBundle, &productSchema.AllowsBackorder,
+			&productSchema.AllowsPreorder, &productSchema.BackorderLimit, &productSchema.ExpectedShipDate, &productSchema.MaxPerOrder,
+			&productSchema.MaxPerCustomer, &productSchema.MaxPerCustomerPeriodDays, &productSchema.TaxCategory, &productSchema.WeightGrams,
+			&productSchema.LengthCm, &productSchema.WidthCm, &productSchema.HeightCm, &productSchema.ReturnWindowDays)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &productSchema
+}
+
+func (p *ProductDAO) FindOneBySlug(slug string) *ProductSchema {
+	var productSchema ProductSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, sku, slug, status, name, description, price, currency, created_at, is_bundle, allows_backorder,
+		allows_preorder, backorder_limit, expected_ship_date, max_per_order, max_per_customer, max_per_customer_period_days,
+		tax_category, weight_grams, length_cm, width_cm, height_cm, return_window_days FROM products WHERE slug = $1`, slug).
+		Scan(&productSchema.Id, &productSchema.Sku, &productSchema.Slug, &productSchema.Status, &productSchema.Name, &productSchema.Description,
+			&productSchema.Price, &productSchema.Currency, &productSchema.CreatedAt, &productSchema.IsBundle, &productSchema.AllowsBackorder,
+			&productSchema.AllowsPreorder, &productSchema.BackorderLimit, &productSchema.ExpectedShipDate, &productSchema.MaxPerOrder,
+			&productSchema.MaxPerCustomer, &productSchema.MaxPerCustomerPeriodDays, &productSchema.TaxCategory, &productSchema.WeightGrams,
+			&productSchema.LengthCm, &productSchema.WidthCm, &productSchema.HeightCm, &productSchema.ReturnWindowDays)
 
 	if err != nil && err == pgx.ErrNoRows {
 		return nil
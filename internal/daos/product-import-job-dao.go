@@ -0,0 +1,88 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductImportJobSchema struct {
+	Id            uuid.UUID
+	Status        string
+	Format        string
+	Content       []byte
+	TotalRows     int32
+	ProcessedRows int32
+	FailedRows    int32
+	CreatedAt     time.Time
+	FinishedAt    *time.Time
+}
+
+type ProductImportJobErrorSchema struct {
+	Id                 uuid.UUID
+	ProductImportJobId uuid.UUID
+	RowNumber          int32
+	Message            string
+	CreatedAt          time.Time
+}
+
+type ProductImportJobDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewProductImportJobDAO(pgxPool *pgxpool.Pool) ProductImportJobDAO {
+	return ProductImportJobDAO{pgxPool}
+}
+
+func (p *ProductImportJobDAO) Create(productImportJobSchema ProductImportJobSchema) {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		`INSERT INTO product_import_jobs (id, status, format, content, total_rows, processed_rows, failed_rows, created_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		productImportJobSchema.Id, productImportJobSchema.Status, productImportJobSchema.Format, productImportJobSchema.Content,
+		productImportJobSchema.TotalRows, productImportJobSchema.ProcessedRows, productImportJobSchema.FailedRows, productImportJobSchema.CreatedAt,
+		productImportJobSchema.FinishedAt))
+}
+
+func (p *ProductImportJobDAO) FindOneById(id uuid.UUID) *ProductImportJobSchema {
+	var productImportJobSchema ProductImportJobSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		"SELECT id, status, format, content, total_rows, processed_rows, failed_rows, created_at, finished_at FROM product_import_jobs WHERE id = $1", id).
+		Scan(&productImportJobSchema.Id, &productImportJobSchema.Status, &productImportJobSchema.Format, &productImportJobSchema.Content,
+			&productImportJobSchema.TotalRows, &productImportJobSchema.ProcessedRows, &productImportJobSchema.FailedRows, &productImportJobSchema.CreatedAt,
+			&productImportJobSchema.FinishedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &productImportJobSchema
+}
+
+func (p *ProductImportJobDAO) FindAllErrorsByJobId(productImportJobId uuid.UUID) []ProductImportJobErrorSchema {
+	rows := utils.GetOrThrow(p.pgxPool.Query(context.Background(),
+		`SELECT id, product_import_job_id, row_number, message, created_at FROM product_import_job_errors
+		WHERE product_import_job_id = $1 ORDER BY row_number`, productImportJobId))
+
+	var productImportJobErrorsSchema []ProductImportJobErrorSchema
+	for rows.Next() {
+		var item ProductImportJobErrorSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.ProductImportJobId, &item.RowNumber, &item.Message, &item.CreatedAt))
+		productImportJobErrorsSchema = append(productImportJobErrorsSchema, item)
+	}
+
+	return productImportJobErrorsSchema
+}
+
+func (p *ProductImportJobDAO) DeletAll() {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(), "TRUNCATE TABLE product_import_jobs CASCADE"))
+}
@@ -0,0 +1,73 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductPriceSchema struct {
+	ProductId uuid.UUID
+	Currency  string
+	Price     int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ProductPriceDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewProductPriceDAO(pgxPool *pgxpool.Pool) ProductPriceDAO {
+	return ProductPriceDAO{pgxPool}
+}
+
+func (p *ProductPriceDAO) Create(productPriceSchema ProductPriceSchema) {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		"INSERT INTO product_prices (product_id, currency, price, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)",
+		productPriceSchema.ProductId, productPriceSchema.Currency, productPriceSchema.Price, productPriceSchema.CreatedAt,
+		productPriceSchema.UpdatedAt))
+}
+
+func (p *ProductPriceDAO) FindOneByProductIdAndCurrency(productId uuid.UUID, currency string) *ProductPriceSchema {
+	var productPriceSchema ProductPriceSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		"SELECT product_id, currency, price, created_at, updated_at FROM product_prices WHERE product_id = $1 AND currency = $2",
+		productId, currency).
+		Scan(&productPriceSchema.ProductId, &productPriceSchema.Currency, &productPriceSchema.Price, &productPriceSchema.CreatedAt,
+			&productPriceSchema.UpdatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &productPriceSchema
+}
+
+func (p *ProductPriceDAO) FindAllByProductId(productId uuid.UUID) []ProductPriceSchema {
+	rows := utils.GetOrThrow(p.pgxPool.Query(context.Background(),
+		"SELECT product_id, currency, price, created_at, updated_at FROM product_prices WHERE product_id = $1 ORDER BY currency", productId))
+
+	productPricesSchema := []ProductPriceSchema{}
+	for rows.Next() {
+		var item ProductPriceSchema
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.Currency, &item.Price, &item.CreatedAt, &item.UpdatedAt))
+		productPricesSchema = append(productPricesSchema, item)
+	}
+
+	return productPricesSchema
+}
+
+func (p *ProductPriceDAO) DeletAll() {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(), "TRUNCATE TABLE product_prices"))
+}
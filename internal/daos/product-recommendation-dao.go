@@ -0,0 +1,75 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductRecommendationSchema struct {
+	ProductId        uuid.UUID
+	RelatedProductId uuid.UUID
+	Score            int32
+	ComputedAt       time.Time
+}
+
+type ProductRecommendationPinSchema struct {
+	ProductId        uuid.UUID
+	RelatedProductId uuid.UUID
+	Position         int32
+	CreatedAt        time.Time
+}
+
+type ProductRecommendationDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewProductRecommendationDAO(pgxPool *pgxpool.Pool) ProductRecommendationDAO {
+	return ProductRecommendationDAO{pgxPool}
+}
+
+func (p *ProductRecommendationDAO) Create(productRecommendationSchema ProductRecommendationSchema) {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		"INSERT INTO product_recommendations (product_id, related_product_id, score, computed_at) VALUES ($1, $2, $3, $4)",
+		productRecommendationSchema.ProductId, productRecommendationSchema.RelatedProductId, productRecommendationSchema.Score,
+		productRecommendationSchema.ComputedAt))
+}
+
+func (p *ProductRecommendationDAO) FindAllByProductId(productId uuid.UUID) []ProductRecommendationSchema {
+	rows := utils.GetOrThrow(p.pgxPool.Query(context.Background(),
+		`SELECT product_id, related_product_id, score, computed_at FROM product_recommendations
+		WHERE product_id = $1 ORDER BY score DESC, related_product_id`, productId))
+
+	var productRecommendationsSchema []ProductRecommendationSchema
+	for rows.Next() {
+		var item ProductRecommendationSchema
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.RelatedProductId, &item.Score, &item.ComputedAt))
+		productRecommendationsSchema = append(productRecommendationsSchema, item)
+	}
+
+	return productRecommendationsSchema
+}
+
+func (p *ProductRecommendationDAO) FindAllPinsByProductId(productId uuid.UUID) []ProductRecommendationPinSchema {
+	rows := utils.GetOrThrow(p.pgxPool.Query(context.Background(),
+		`SELECT product_id, related_product_id, position, created_at FROM product_recommendation_pins
+		WHERE product_id = $1 ORDER BY position, related_product_id`, productId))
+
+	var productRecommendationPinsSchema []ProductRecommendationPinSchema
+	for rows.Next() {
+		var item ProductRecommendationPinSchema
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.RelatedProductId, &item.Position, &item.CreatedAt))
+		productRecommendationPinsSchema = append(productRecommendationPinsSchema, item)
+	}
+
+	return productRecommendationPinsSchema
+}
+
+func (p *ProductRecommendationDAO) DeletAll() {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(), "TRUNCATE TABLE product_recommendations, product_recommendation_pins"))
+}
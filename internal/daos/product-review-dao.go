@@ -0,0 +1,65 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductReviewSchema struct {
+	Id           uuid.UUID
+	ProductId    uuid.UUID
+	CustomerId   uuid.UUID
+	Rating       int16
+	Title        string
+	Body         string
+	Status       string
+	HelpfulCount int32
+	CreatedAt    time.Time
+	ModeratedAt  *time.Time
+}
+
+type ProductReviewDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewProductReviewDAO(pgxPool *pgxpool.Pool) ProductReviewDAO {
+	return ProductReviewDAO{pgxPool}
+}
+
+func (p *ProductReviewDAO) Create(productReviewSchema ProductReviewSchema) {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		`INSERT INTO product_reviews (id, product_id, customer_id, rating, title, body, status, helpful_count, created_at, moderated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		productReviewSchema.Id, productReviewSchema.ProductId, productReviewSchema.CustomerId, productReviewSchema.Rating, productReviewSchema.Title,
+		productReviewSchema.Body, productReviewSchema.Status, productReviewSchema.HelpfulCount, productReviewSchema.CreatedAt, productReviewSchema.ModeratedAt))
+}
+
+func (p *ProductReviewDAO) FindOneById(id uuid.UUID) *ProductReviewSchema {
+	var productReviewSchema ProductReviewSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, product_id, customer_id, rating, title, body, status, helpful_count, created_at, moderated_at
+		FROM product_reviews WHERE id = $1`, id).
+		Scan(&productReviewSchema.Id, &productReviewSchema.ProductId, &productReviewSchema.CustomerId, &productReviewSchema.Rating,
+			&productReviewSchema.Title, &productReviewSchema.Body, &productReviewSchema.Status, &productReviewSchema.HelpfulCount,
+			&productReviewSchema.CreatedAt, &productReviewSchema.ModeratedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &productReviewSchema
+}
+
+func (p *ProductReviewDAO) DeletAll() {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(), "TRUNCATE TABLE product_reviews CASCADE"))
+}
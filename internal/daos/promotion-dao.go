@@ -0,0 +1,96 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromotionSchema struct {
+	Id               uuid.UUID
+	Code             string
+	Type             string
+	Percentage       *int32
+	Amount           *int64
+	Currency         *string
+	ProductId        *uuid.UUID
+	BuyQuantity      *int32
+	GetQuantity      *int32
+	MinimumSubtotal  *int64
+	UsageLimit       *int32
+	PerCustomerLimit *int32
+	StartsAt         *time.Time
+	EndsAt           *time.Time
+	CreatedAt        time.Time
+}
+
+type PromotionDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewPromotionDAO(pgxPool *pgxpool.Pool) PromotionDAO {
+	return PromotionDAO{pgxPool}
+}
+
+func (p *PromotionDAO) Create(promotionSchema PromotionSchema) {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		`INSERT INTO promotions (id, code, type, percentage, amount, currency, product_id, buy_quantity, get_quantity, minimum_subtotal,
+		usage_limit, per_customer_limit, starts_at, ends_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		promotionSchema.Id, promotionSchema.Code, promotionSchema.Type, promotionSchema.Percentage, promotionSchema.Amount,
+		promotionSchema.Currency, promotionSchema.ProductId, promotionSchema.BuyQuantity, promotionSchema.GetQuantity,
+		promotionSchema.MinimumSubtotal, promotionSchema.UsageLimit, promotionSchema.PerCustomerLimit, promotionSchema.StartsAt,
+		promotionSchema.EndsAt, promotionSchema.CreatedAt))
+}
+
+func (p *PromotionDAO) FindOneById(id uuid.UUID) *PromotionSchema {
+	var promotionSchema PromotionSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, code, type, percentage, amount, currency, product_id, buy_quantity, get_quantity, minimum_subtotal, usage_limit,
+		per_customer_limit, starts_at, ends_at, created_at FROM promotions WHERE id = $1`, id).
+		Scan(&promotionSchema.Id, &promotionSchema.Code, &promotionSchema.Type, &promotionSchema.Percentage, &promotionSchema.Amount,
+			&promotionSchema.Currency, &promotionSchema.ProductId, &promotionSchema.BuyQuantity, &promotionSchema.GetQuantity,
+			&promotionSchema.MinimumSubtotal, &promotionSchema.UsageLimit, &promotionSchema.PerCustomerLimit, &promotionSchema.StartsAt,
+			&promotionSchema.EndsAt, &promotionSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &promotionSchema
+}
+
+func (p *PromotionDAO) FindOneByCode(code string) *PromotionSchema {
+	var promotionSchema PromotionSchema
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`SELECT id, code, type, percentage, amount, currency, product_id, buy_quantity, get_quantity, minimum_subtotal, usage_limit,
+		per_customer_limit, starts_at, ends_at, created_at FROM promotions WHERE code = $1`, code).
+		Scan(&promotionSchema.Id, &promotionSchema.Code, &promotionSchema.Type, &promotionSchema.Percentage, &promotionSchema.Amount,
+			&promotionSchema.Currency, &promotionSchema.ProductId, &promotionSchema.BuyQuantity, &promotionSchema.GetQuantity,
+			&promotionSchema.MinimumSubtotal, &promotionSchema.UsageLimit, &promotionSchema.PerCustomerLimit, &promotionSchema.StartsAt,
+			&promotionSchema.EndsAt, &promotionSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &promotionSchema
+}
+
+func (p *PromotionDAO) DeletAll() {
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(), "TRUNCATE TABLE promotions CASCADE"))
+}
@@ -0,0 +1,54 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefundSchema struct {
+	Id                     uuid.UUID
+	PaymentId              uuid.UUID
+	Amount                 int64
+	Currency               string
+	Reason                 string
+	PaymentGatewayRefundId *string
+	Status                 string
+	ActorId                *uuid.UUID
+	CreatedAt              time.Time
+	SentAt                 *time.Time
+}
+
+type RefundDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewRefundDAO(pgxPool *pgxpool.Pool) RefundDAO {
+	return RefundDAO{pgxPool}
+}
+
+func (r *RefundDAO) FindAllByPaymentId(paymentId uuid.UUID) []RefundSchema {
+	rows := utils.GetOrThrow(r.pgxPool.Query(context.Background(),
+		`SELECT id, payment_id, amount, currency, reason, payment_gateway_refund_id, status, actor_id, created_at, sent_at FROM refunds
+		WHERE payment_id = $1 ORDER BY created_at`, paymentId))
+
+	refundsSchema := []RefundSchema{}
+	for rows.Next() {
+		var refundSchema RefundSchema
+
+		utils.ThrowOnError(rows.Scan(&refundSchema.Id, &refundSchema.PaymentId, &refundSchema.Amount, &refundSchema.Currency,
+			&refundSchema.Reason, &refundSchema.PaymentGatewayRefundId, &refundSchema.Status, &refundSchema.ActorId, &refundSchema.CreatedAt,
+			&refundSchema.SentAt))
+
+		refundsSchema = append(refundsSchema, refundSchema)
+	}
+
+	return refundsSchema
+}
+
+func (r *RefundDAO) DeletAll() {
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(), "TRUNCATE TABLE refunds"))
+}
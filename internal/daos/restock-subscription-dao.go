@@ -0,0 +1,81 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RestockSubscriptionSchema struct {
+	Id               uuid.UUID
+	CustomerId       uuid.UUID
+	ProductId        uuid.UUID
+	UnsubscribeToken string
+	CreatedAt        time.Time
+	NotifiedAt       *time.Time
+	UnsubscribedAt   *time.Time
+	QueuedAt         *time.Time
+}
+
+type RestockSubscriptionDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewRestockSubscriptionDAO(pgxPool *pgxpool.Pool) RestockSubscriptionDAO {
+	return RestockSubscriptionDAO{pgxPool}
+}
+
+func (r *RestockSubscriptionDAO) Create(restockSubscriptionSchema RestockSubscriptionSchema) {
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(),
+		`INSERT INTO restock_subscriptions (id, customer_id, product_id, unsubscribe_token, created_at, notified_at, unsubscribed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		restockSubscriptionSchema.Id, restockSubscriptionSchema.CustomerId, restockSubscriptionSchema.ProductId,
+		restockSubscriptionSchema.UnsubscribeToken, restockSubscriptionSchema.CreatedAt, restockSubscriptionSchema.NotifiedAt,
+		restockSubscriptionSchema.UnsubscribedAt))
+}
+
+func (r *RestockSubscriptionDAO) FindOneByUnsubscribeToken(unsubscribeToken string) *RestockSubscriptionSchema {
+	var restockSubscriptionSchema RestockSubscriptionSchema
+
+	err := r.pgxPool.QueryRow(context.Background(),
+		`SELECT id, customer_id, product_id, unsubscribe_token, created_at, notified_at, unsubscribed_at, queued_at FROM restock_subscriptions
+		WHERE unsubscribe_token = $1`, unsubscribeToken).
+		Scan(&restockSubscriptionSchema.Id, &restockSubscriptionSchema.CustomerId, &restockSubscriptionSchema.ProductId,
+			&restockSubscriptionSchema.UnsubscribeToken, &restockSubscriptionSchema.CreatedAt, &restockSubscriptionSchema.NotifiedAt,
+			&restockSubscriptionSchema.UnsubscribedAt, &restockSubscriptionSchema.QueuedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &restockSubscriptionSchema
+}
+
+func (r *RestockSubscriptionDAO) FindAllByCustomerIdAndProductId(customerId uuid.UUID, productId uuid.UUID) []RestockSubscriptionSchema {
+	rows := utils.GetOrThrow(r.pgxPool.Query(context.Background(),
+		`SELECT id, customer_id, product_id, unsubscribe_token, created_at, notified_at, unsubscribed_at, queued_at FROM restock_subscriptions
+		WHERE customer_id = $1 AND product_id = $2 ORDER BY created_at, id`, customerId, productId))
+
+	var restockSubscriptionsSchema []RestockSubscriptionSchema
+	for rows.Next() {
+		var item RestockSubscriptionSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.CustomerId, &item.ProductId, &item.UnsubscribeToken, &item.CreatedAt,
+			&item.NotifiedAt, &item.UnsubscribedAt, &item.QueuedAt))
+		restockSubscriptionsSchema = append(restockSubscriptionsSchema, item)
+	}
+
+	return restockSubscriptionsSchema
+}
+
+func (r *RestockSubscriptionDAO) DeletAll() {
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(), "TRUNCATE TABLE restock_subscriptions"))
+}
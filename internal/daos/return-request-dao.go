@@ -0,0 +1,73 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReturnRequestSchema struct {
+	Id          uuid.UUID
+	OrderItemId uuid.UUID
+	CustomerId  uuid.UUID
+	Quantity    int32
+	ReasonCode  string
+	Comment     *string
+	Status      string
+	CreatedAt   time.Time
+
+	DecisionNote *string
+	DecidedBy    *uuid.UUID
+	DecidedAt    *time.Time
+
+	Restocked      bool
+	RefundedAmount int64
+	ReceivedBy     *uuid.UUID
+	ReceivedAt     *time.Time
+}
+
+type ReturnRequestDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewReturnRequestDAO(pgxPool *pgxpool.Pool) ReturnRequestDAO {
+	return ReturnRequestDAO{pgxPool}
+}
+
+func (r *ReturnRequestDAO) Create(returnRequestSchema ReturnRequestSchema) {
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(),
+		`INSERT INTO return_requests (id, order_item_id, customer_id, quantity, reason_code, comment, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE(NULLIF($7, ''), 'requested'), $8)`,
+		returnRequestSchema.Id, returnRequestSchema.OrderItemId, returnRequestSchema.CustomerId, returnRequestSchema.Quantity,
+		returnRequestSchema.ReasonCode, returnRequestSchema.Comment, returnRequestSchema.Status, returnRequestSchema.CreatedAt))
+}
+
+func (r *ReturnRequestDAO) FindOneById(id uuid.UUID) *ReturnRequestSchema {
+	var returnRequestSchema ReturnRequestSchema
+
+	err := r.pgxPool.QueryRow(context.Background(),
+		`SELECT id, order_item_id, customer_id, quantity, reason_code, comment, status, created_at, decision_note, decided_by, decided_at,
+		restocked, refunded_amount, received_by, received_at FROM return_requests WHERE id = $1`, id).
+		Scan(&returnRequestSchema.Id, &returnRequestSchema.OrderItemId, &returnRequestSchema.CustomerId, &returnRequestSchema.Quantity,
+			&returnRequestSchema.ReasonCode, &returnRequestSchema.Comment, &returnRequestSchema.Status, &returnRequestSchema.CreatedAt,
+			&returnRequestSchema.DecisionNote, &returnRequestSchema.DecidedBy, &returnRequestSchema.DecidedAt, &returnRequestSchema.Restocked,
+			&returnRequestSchema.RefundedAmount, &returnRequestSchema.ReceivedBy, &returnRequestSchema.ReceivedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &returnRequestSchema
+}
+
+func (r *ReturnRequestDAO) DeletAll() {
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(), "TRUNCATE TABLE return_requests"))
+}
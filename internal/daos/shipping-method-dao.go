@@ -0,0 +1,105 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ShippingMethodSchema struct {
+	Id            uuid.UUID
+	Name          string
+	Type          string
+	Currency      string
+	Rate          *int64
+	RatePerKg     *int64
+	FreeThreshold *int64
+	IsActive      bool
+	CreatedAt     time.Time
+}
+
+type ShippingMethodDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewShippingMethodDAO(pgxPool *pgxpool.Pool) ShippingMethodDAO {
+	return ShippingMethodDAO{pgxPool}
+}
+
+func (s *ShippingMethodDAO) Create(shippingMethodSchema ShippingMethodSchema) {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`INSERT INTO shipping_methods (id, name, type, currency, rate, rate_per_kg, free_threshold, is_active, created_at)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'USD'), $5, $6, $7, $8, $9)`,
+		shippingMethodSchema.Id, shippingMethodSchema.Name, shippingMethodSchema.Type, shippingMethodSchema.Currency,
+		shippingMethodSchema.Rate, shippingMethodSchema.RatePerKg, shippingMethodSchema.FreeThreshold, shippingMethodSchema.IsActive,
+		shippingMethodSchema.CreatedAt))
+}
+
+func (s *ShippingMethodDAO) FindOneById(id uuid.UUID) *ShippingMethodSchema {
+	var shippingMethodSchema ShippingMethodSchema
+
+	err := s.pgxPool.QueryRow(context.Background(),
+		`SELECT id, name, type, currency, rate, rate_per_kg, free_threshold, is_active, created_at FROM shipping_methods
+		WHERE id = $1`, id).
+		Scan(&shippingMethodSchema.Id, &shippingMethodSchema.Name, &shippingMethodSchema.Type, &shippingMethodSchema.Currency,
+			&shippingMethodSchema.Rate, &shippingMethodSchema.RatePerKg, &shippingMethodSchema.FreeThreshold, &shippingMethodSchema.IsActive,
+			&shippingMethodSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &shippingMethodSchema
+}
+
+func (s *ShippingMethodDAO) FindOneByName(name string) *ShippingMethodSchema {
+	var shippingMethodSchema ShippingMethodSchema
+
+	err := s.pgxPool.QueryRow(context.Background(),
+		`SELECT id, name, type, currency, rate, rate_per_kg, free_threshold, is_active, created_at FROM shipping_methods
+		WHERE name = $1`, name).
+		Scan(&shippingMethodSchema.Id, &shippingMethodSchema.Name, &shippingMethodSchema.Type, &shippingMethodSchema.Currency,
+			&shippingMethodSchema.Rate, &shippingMethodSchema.RatePerKg, &shippingMethodSchema.FreeThreshold, &shippingMethodSchema.IsActive,
+			&shippingMethodSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &shippingMethodSchema
+}
+
+func (s *ShippingMethodDAO) FindAllActiveByCurrency(currency string) []ShippingMethodSchema {
+	rows := utils.GetOrThrow(s.pgxPool.Query(context.Background(),
+		`SELECT id, name, type, currency, rate, rate_per_kg, free_threshold, is_active, created_at FROM shipping_methods
+		WHERE is_active AND currency = $1 ORDER BY name`, currency))
+
+	shippingMethodsSchema := []ShippingMethodSchema{}
+	for rows.Next() {
+		var shippingMethodSchema ShippingMethodSchema
+
+		utils.ThrowOnError(rows.Scan(&shippingMethodSchema.Id, &shippingMethodSchema.Name, &shippingMethodSchema.Type,
+			&shippingMethodSchema.Currency, &shippingMethodSchema.Rate, &shippingMethodSchema.RatePerKg, &shippingMethodSchema.FreeThreshold,
+			&shippingMethodSchema.IsActive, &shippingMethodSchema.CreatedAt))
+
+		shippingMethodsSchema = append(shippingMethodsSchema, shippingMethodSchema)
+	}
+
+	return shippingMethodsSchema
+}
+
+func (s *ShippingMethodDAO) DeletAll() {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "TRUNCATE TABLE shipping_methods CASCADE"))
+}
@@ -0,0 +1,55 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ShippingZoneRateSchema struct {
+	Id               uuid.UUID
+	ShippingMethodId uuid.UUID
+	ZipPrefix        string
+	Rate             int64
+	CreatedAt        time.Time
+}
+
+type ShippingZoneRateDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewShippingZoneRateDAO(pgxPool *pgxpool.Pool) ShippingZoneRateDAO {
+	return ShippingZoneRateDAO{pgxPool}
+}
+
+func (s *ShippingZoneRateDAO) Create(shippingZoneRateSchema ShippingZoneRateSchema) {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		"INSERT INTO shipping_zone_rates (id, shipping_method_id, zip_prefix, rate, created_at) VALUES ($1, $2, $3, $4, $5)",
+		shippingZoneRateSchema.Id, shippingZoneRateSchema.ShippingMethodId, shippingZoneRateSchema.ZipPrefix, shippingZoneRateSchema.Rate,
+		shippingZoneRateSchema.CreatedAt))
+}
+
+func (s *ShippingZoneRateDAO) FindAllByShippingMethodId(shippingMethodId uuid.UUID) []ShippingZoneRateSchema {
+	rows := utils.GetOrThrow(s.pgxPool.Query(context.Background(),
+		`SELECT id, shipping_method_id, zip_prefix, rate, created_at FROM shipping_zone_rates
+		WHERE shipping_method_id = $1 ORDER BY zip_prefix`, shippingMethodId))
+
+	shippingZoneRatesSchema := []ShippingZoneRateSchema{}
+	for rows.Next() {
+		var shippingZoneRateSchema ShippingZoneRateSchema
+
+		utils.ThrowOnError(rows.Scan(&shippingZoneRateSchema.Id, &shippingZoneRateSchema.ShippingMethodId, &shippingZoneRateSchema.ZipPrefix,
+			&shippingZoneRateSchema.Rate, &shippingZoneRateSchema.CreatedAt))
+
+		shippingZoneRatesSchema = append(shippingZoneRatesSchema, shippingZoneRateSchema)
+	}
+
+	return shippingZoneRatesSchema
+}
+
+func (s *ShippingZoneRateDAO) DeletAll() {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "TRUNCATE TABLE shipping_zone_rates"))
+}
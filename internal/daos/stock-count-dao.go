@@ -0,0 +1,86 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StockCountSchema struct {
+	Id          uuid.UUID
+	WarehouseId uuid.UUID
+	Status      string
+	StartedBy   uuid.UUID
+	AppliedBy   *uuid.UUID
+	CreatedAt   time.Time
+	SubmittedAt *time.Time
+	AppliedAt   *time.Time
+}
+
+type StockCountItemSchema struct {
+	Id               uuid.UUID
+	StockCountId     uuid.UUID
+	InventoryId      uuid.UUID
+	ExpectedQuantity int32
+	CountedQuantity  *int32
+}
+
+type StockCountDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewStockCountDAO(pgxPool *pgxpool.Pool) StockCountDAO {
+	return StockCountDAO{pgxPool}
+}
+
+func (s *StockCountDAO) FindOneById(id uuid.UUID) *StockCountSchema {
+	var stockCountSchema StockCountSchema
+
+	err := s.pgxPool.QueryRow(context.Background(),
+		"SELECT id, warehouse_id, status, started_by, applied_by, created_at, submitted_at, applied_at FROM stock_counts WHERE id = $1", id).
+		Scan(&stockCountSchema.Id, &stockCountSchema.WarehouseId, &stockCountSchema.Status, &stockCountSchema.StartedBy,
+			&stockCountSchema.AppliedBy, &stockCountSchema.CreatedAt, &stockCountSchema.SubmittedAt, &stockCountSchema.AppliedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &stockCountSchema
+}
+
+func (s *StockCountDAO) ExistsInProgressByWarehouseId(warehouseId uuid.UUID) bool {
+	var exists bool
+
+	utils.ThrowOnError(s.pgxPool.QueryRow(context.Background(),
+		"SELECT EXISTS (SELECT 1 FROM stock_counts WHERE warehouse_id = $1 AND status <> 'applied')", warehouseId).Scan(&exists))
+
+	return exists
+}
+
+func (s *StockCountDAO) FindAllItemsByStockCountId(stockCountId uuid.UUID) []StockCountItemSchema {
+	rows := utils.GetOrThrow(s.pgxPool.Query(context.Background(),
+		`SELECT id, stock_count_id, inventory_id, expected_quantity, counted_quantity FROM stock_count_items
+		WHERE stock_count_id = $1 ORDER BY inventory_id`, stockCountId))
+
+	var stockCountItemsSchema []StockCountItemSchema
+	for rows.Next() {
+		var item StockCountItemSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.StockCountId, &item.InventoryId, &item.ExpectedQuantity, &item.CountedQuantity))
+		stockCountItemsSchema = append(stockCountItemsSchema, item)
+	}
+
+	return stockCountItemsSchema
+}
+
+func (s *StockCountDAO) DeletAll() {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "TRUNCATE TABLE stock_counts, stock_count_items CASCADE"))
+}
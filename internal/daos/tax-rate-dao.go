@@ -0,0 +1,56 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TaxRateSchema struct {
+	Id              uuid.UUID
+	State           string
+	TaxCategory     string
+	RateBasisPoints int32
+	CreatedAt       time.Time
+}
+
+type TaxRateDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewTaxRateDAO(pgxPool *pgxpool.Pool) TaxRateDAO {
+	return TaxRateDAO{pgxPool}
+}
+
+func (t *TaxRateDAO) Create(taxRateSchema TaxRateSchema) {
+	_ = utils.GetOrThrow(t.pgxPool.Exec(context.Background(),
+		"INSERT INTO tax_rates (id, state, tax_category, rate_basis_points, created_at) VALUES ($1, $2, $3, $4, $5)",
+		taxRateSchema.Id, taxRateSchema.State, taxRateSchema.TaxCategory, taxRateSchema.RateBasisPoints, taxRateSchema.CreatedAt))
+}
+
+func (t *TaxRateDAO) FindOneByStateAndTaxCategory(state string, taxCategory string) *TaxRateSchema {
+	var taxRateSchema TaxRateSchema
+
+	err := t.pgxPool.QueryRow(context.Background(),
+		"SELECT id, state, tax_category, rate_basis_points, created_at FROM tax_rates WHERE state = $1 AND tax_category = $2",
+		state, taxCategory).
+		Scan(&taxRateSchema.Id, &taxRateSchema.State, &taxRateSchema.TaxCategory, &taxRateSchema.RateBasisPoints, &taxRateSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &taxRateSchema
+}
+
+func (t *TaxRateDAO) DeletAll() {
+	_ = utils.GetOrThrow(t.pgxPool.Exec(context.Background(), "TRUNCATE TABLE tax_rates"))
+}
@@ -0,0 +1,73 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WarehouseSchema struct {
+	Id        uuid.UUID
+	Name      string
+	ZipCode   *string
+	IsDefault bool
+	CreatedAt time.Time
+}
+
+type WarehouseDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewWarehouseDAO(pgxPool *pgxpool.Pool) WarehouseDAO {
+	return WarehouseDAO{pgxPool}
+}
+
+func (w *WarehouseDAO) Create(warehouseSchema WarehouseSchema) {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(),
+		"INSERT INTO warehouses (id, name, zip_code, is_default, created_at) VALUES ($1, $2, $3, $4, $5)",
+		warehouseSchema.Id, warehouseSchema.Name, warehouseSchema.ZipCode, warehouseSchema.IsDefault, warehouseSchema.CreatedAt))
+}
+
+func (w *WarehouseDAO) FindOneById(id uuid.UUID) *WarehouseSchema {
+	var warehouseSchema WarehouseSchema
+
+	err := w.pgxPool.QueryRow(context.Background(), "SELECT id, name, zip_code, is_default, created_at FROM warehouses WHERE id = $1", id).
+		Scan(&warehouseSchema.Id, &warehouseSchema.Name, &warehouseSchema.ZipCode, &warehouseSchema.IsDefault, &warehouseSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &warehouseSchema
+}
+
+func (w *WarehouseDAO) FindOneByName(name string) *WarehouseSchema {
+	var warehouseSchema WarehouseSchema
+
+	err := w.pgxPool.QueryRow(context.Background(), "SELECT id, name, zip_code, is_default, created_at FROM warehouses WHERE name = $1", name).
+		Scan(&warehouseSchema.Id, &warehouseSchema.Name, &warehouseSchema.ZipCode, &warehouseSchema.IsDefault, &warehouseSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &warehouseSchema
+}
+
+// DeletAll removes every warehouse but the default one, which is seeded by the migrations.
+func (w *WarehouseDAO) DeletAll() {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(), "TRUNCATE TABLE inventories, stock_counts CASCADE"))
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(), "DELETE FROM warehouses WHERE NOT is_default"))
+}
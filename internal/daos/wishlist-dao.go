@@ -0,0 +1,88 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WishlistSchema struct {
+	Id         uuid.UUID
+	CustomerId uuid.UUID
+	Kind       string
+	Name       string
+	CreatedAt  time.Time
+}
+
+type WishlistDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewWishlistDAO(pgxPool *pgxpool.Pool) WishlistDAO {
+	return WishlistDAO{pgxPool}
+}
+
+func (w *WishlistDAO) Create(wishlistSchema WishlistSchema) {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(),
+		"INSERT INTO wishlists (id, customer_id, kind, name, created_at) VALUES ($1, $2, $3, $4, $5)",
+		wishlistSchema.Id, wishlistSchema.CustomerId, wishlistSchema.Kind, wishlistSchema.Name, wishlistSchema.CreatedAt))
+}
+
+func (w *WishlistDAO) FindOneById(id uuid.UUID) *WishlistSchema {
+	var wishlistSchema WishlistSchema
+
+	err := w.pgxPool.QueryRow(context.Background(), "SELECT id, customer_id, kind, name, created_at FROM wishlists WHERE id = $1", id).
+		Scan(&wishlistSchema.Id, &wishlistSchema.CustomerId, &wishlistSchema.Kind, &wishlistSchema.Name, &wishlistSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &wishlistSchema
+}
+
+func (w *WishlistDAO) FindOneByCustomerIdAndName(customerId uuid.UUID, name string) *WishlistSchema {
+	var wishlistSchema WishlistSchema
+
+	err := w.pgxPool.QueryRow(context.Background(),
+		"SELECT id, customer_id, kind, name, created_at FROM wishlists WHERE customer_id = $1 AND name = $2 AND kind = 'wishlist'",
+		customerId, name).
+		Scan(&wishlistSchema.Id, &wishlistSchema.CustomerId, &wishlistSchema.Kind, &wishlistSchema.Name, &wishlistSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &wishlistSchema
+}
+
+func (w *WishlistDAO) FindAllByCustomerId(customerId uuid.UUID) []WishlistSchema {
+	rows := utils.GetOrThrow(w.pgxPool.Query(context.Background(),
+		"SELECT id, customer_id, kind, name, created_at FROM wishlists WHERE customer_id = $1 ORDER BY created_at, id", customerId))
+
+	var wishlistsSchema []WishlistSchema
+	for rows.Next() {
+		var item WishlistSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.CustomerId, &item.Kind, &item.Name, &item.CreatedAt))
+		wishlistsSchema = append(wishlistsSchema, item)
+	}
+
+	return wishlistsSchema
+}
+
+func (w *WishlistDAO) DeletAll() {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(), "TRUNCATE TABLE wishlists CASCADE"))
+}
@@ -0,0 +1,78 @@
+package daos
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WishlistItemSchema struct {
+	Id         uuid.UUID
+	WishlistId uuid.UUID
+	ProductId  uuid.UUID
+	Quantity   int32
+	PriceAtAdd *int64
+	Currency   string
+	CreatedAt  time.Time
+}
+
+type WishlistItemDAO struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewWishlistItemDAO(pgxPool *pgxpool.Pool) WishlistItemDAO {
+	return WishlistItemDAO{pgxPool}
+}
+
+func (w *WishlistItemDAO) Create(wishlistItemSchema WishlistItemSchema) {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(),
+		`INSERT INTO wishlist_items (id, wishlist_id, product_id, quantity, price_at_add, currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		wishlistItemSchema.Id, wishlistItemSchema.WishlistId, wishlistItemSchema.ProductId, wishlistItemSchema.Quantity,
+		wishlistItemSchema.PriceAtAdd, wishlistItemSchema.Currency, wishlistItemSchema.CreatedAt))
+}
+
+func (w *WishlistItemDAO) FindOneByWishlistIdAndProductId(wishlistId uuid.UUID, productId uuid.UUID) *WishlistItemSchema {
+	var wishlistItemSchema WishlistItemSchema
+
+	err := w.pgxPool.QueryRow(context.Background(),
+		`SELECT id, wishlist_id, product_id, quantity, price_at_add, currency, created_at FROM wishlist_items
+		WHERE wishlist_id = $1 AND product_id = $2`, wishlistId, productId).
+		Scan(&wishlistItemSchema.Id, &wishlistItemSchema.WishlistId, &wishlistItemSchema.ProductId, &wishlistItemSchema.Quantity,
+			&wishlistItemSchema.PriceAtAdd, &wishlistItemSchema.Currency, &wishlistItemSchema.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &wishlistItemSchema
+}
+
+func (w *WishlistItemDAO) FindAllByWishlistId(wishlistId uuid.UUID) []WishlistItemSchema {
+	rows := utils.GetOrThrow(w.pgxPool.Query(context.Background(),
+		`SELECT id, wishlist_id, product_id, quantity, price_at_add, currency, created_at FROM wishlist_items
+		WHERE wishlist_id = $1 ORDER BY created_at, id`, wishlistId))
+
+	var wishlistItemsSchema []WishlistItemSchema
+	for rows.Next() {
+		var item WishlistItemSchema
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.WishlistId, &item.ProductId, &item.Quantity, &item.PriceAtAdd, &item.Currency,
+			&item.CreatedAt))
+		wishlistItemsSchema = append(wishlistItemsSchema, item)
+	}
+
+	return wishlistItemsSchema
+}
+
+func (w *WishlistItemDAO) DeletAll() {
+	_ = utils.GetOrThrow(w.pgxPool.Exec(context.Background(), "TRUNCATE TABLE wishlist_items"))
+}
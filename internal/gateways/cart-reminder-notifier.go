@@ -0,0 +1,28 @@
+package gateways
+
+import (
+	"github.com/google/uuid"
+)
+
+type CartReminderItem struct {
+	ProductId   uuid.UUID `json:"productId"`
+	ProductName string    `json:"productName"`
+	ProductSlug *string   `json:"productSlug"`
+	Quantity    int32     `json:"quantity"`
+}
+
+type CartReminder struct {
+	ReminderId    uuid.UUID          `json:"reminderId"`
+	CartId        uuid.UUID          `json:"cartId"`
+	CustomerId    uuid.UUID          `json:"customerId"`
+	CustomerName  string             `json:"customerName"`
+	CustomerEmail string             `json:"customerEmail"`
+	Sequence      int32              `json:"sequence"`
+	Items         []CartReminderItem `json:"items"`
+}
+
+// CartReminderNotifier reminds customers of the items left in their idle cart. Sequence counts the reminders
+// since the customer last ordered from the cart.
+type CartReminderNotifier interface {
+	NotifyCartReminder(reminder CartReminder) error
+}
@@ -0,0 +1,23 @@
+package gateways
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LowStockAlert struct {
+	AlertId       uuid.UUID `json:"alertId"`
+	InventoryId   uuid.UUID `json:"inventoryId"`
+	ProductId     uuid.UUID `json:"productId"`
+	ProductName   string    `json:"productName"`
+	WarehouseId   uuid.UUID `json:"warehouseId"`
+	WarehouseName string    `json:"warehouseName"`
+	StockQuantity int32     `json:"stockQuantity"`
+	ReorderPoint  int32     `json:"reorderPoint"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type LowStockNotifier interface {
+	NotifyLowStock(alert LowStockAlert) error
+}
@@ -0,0 +1,65 @@
+package gateways
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/mercadopago/sdk-go/pkg/config"
+	"github.com/mercadopago/sdk-go/pkg/preference"
+	"github.com/mercadopago/sdk-go/pkg/refund"
+	"github.com/mercadopago/sdk-go/pkg/requester"
+)
+
+type MercadoPagoPaymentGateway struct {
+	mercadoPagoConfig *config.Config
+	preferenceClient  preference.Client
+}
+
+func NewMercadoPagoPaymentGateway(mercadoPagoConfig *config.Config) MercadoPagoPaymentGateway {
+	return MercadoPagoPaymentGateway{mercadoPagoConfig, preference.NewClient(mercadoPagoConfig)}
+}
+
+func (m MercadoPagoPaymentGateway) CreatePreference(request preference.Request) (string, error) {
+	response, err := m.preferenceClient.Create(context.Background(), request)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ID, nil
+}
+
+func (m MercadoPagoPaymentGateway) Refund(refundId uuid.UUID, transactionId string, amount utils.Money) (PaymentRefund, error) {
+	paymentId, err := strconv.Atoi(transactionId)
+	if err != nil {
+		return PaymentRefund{}, fmt.Errorf("mercadoPagoRefundError, invalid payment id %q", transactionId)
+	}
+
+	// The SDK sends a random idempotency key on every request, so the refund client gets a requester that
+	// replaces it with the refund id.
+	refundConfig := *m.mercadoPagoConfig
+	refundConfig.Requester = idempotentRequester{refundConfig.Requester, refundId.String()}
+
+	response, err := refund.NewClient(&refundConfig).CreatePartialRefund(context.Background(), paymentId, amount.Decimal())
+	if err != nil {
+		return PaymentRefund{}, err
+	}
+
+	return PaymentRefund{
+		RefundId: strconv.Itoa(response.ID),
+		Status:   response.Status,
+	}, nil
+}
+
+type idempotentRequester struct {
+	requester      requester.Requester
+	idempotencyKey string
+}
+
+func (i idempotentRequester) Do(request *http.Request) (*http.Response, error) {
+	request.Header.Set("X-Idempotency-Key", i.idempotencyKey)
+	return i.requester.Do(request)
+}
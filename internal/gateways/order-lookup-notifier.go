@@ -0,0 +1,17 @@
+package gateways
+
+import (
+	"github.com/google/uuid"
+)
+
+type OrderLookupNotification struct {
+	OrderId       uuid.UUID `json:"orderId"`
+	CustomerId    uuid.UUID `json:"customerId"`
+	CustomerName  string    `json:"customerName"`
+	CustomerEmail string    `json:"customerEmail"`
+	LookupUrl     string    `json:"lookupUrl"`
+}
+
+type OrderLookupNotifier interface {
+	NotifyOrderLookup(notification OrderLookupNotification) error
+}
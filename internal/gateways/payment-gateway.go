@@ -0,0 +1,20 @@
+package gateways
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/mercadopago/sdk-go/pkg/preference"
+)
+
+type PaymentRefund struct {
+	RefundId string
+	Status   string
+}
+
+// PaymentGateway creates the preferences customers pay through at checkout and refunds the payments taken.
+// TransactionId is the id the gateway gave the payment, and amount may be less than what was charged for a
+// partial refund. RefundId is sent as the idempotency key, so retrying a refund never refunds twice.
+type PaymentGateway interface {
+	CreatePreference(request preference.Request) (string, error)
+	Refund(refundId uuid.UUID, transactionId string, amount utils.Money) (PaymentRefund, error)
+}
@@ -0,0 +1,19 @@
+package gateways
+
+import (
+	"github.com/rabbitmq/amqp091-go"
+)
+
+const CartRemindersQueue = "cart-reminders"
+
+type RabbitmqCartReminderNotifier struct {
+	rabbitmqConn *amqp091.Connection
+}
+
+func NewRabbitmqCartReminderNotifier(rabbitmqConn *amqp091.Connection) RabbitmqCartReminderNotifier {
+	return RabbitmqCartReminderNotifier{rabbitmqConn}
+}
+
+func (r RabbitmqCartReminderNotifier) NotifyCartReminder(reminder CartReminder) error {
+	return publishRabbitmqJSON(r.rabbitmqConn, CartRemindersQueue, reminder.ReminderId.String(), reminder)
+}
@@ -0,0 +1,19 @@
+package gateways
+
+import (
+	"github.com/rabbitmq/amqp091-go"
+)
+
+const LowStockAlertsQueue = "low-stock-alerts"
+
+type RabbitmqLowStockNotifier struct {
+	rabbitmqConn *amqp091.Connection
+}
+
+func NewRabbitmqLowStockNotifier(rabbitmqConn *amqp091.Connection) RabbitmqLowStockNotifier {
+	return RabbitmqLowStockNotifier{rabbitmqConn}
+}
+
+func (r RabbitmqLowStockNotifier) NotifyLowStock(alert LowStockAlert) error {
+	return publishRabbitmqJSON(r.rabbitmqConn, LowStockAlertsQueue, alert.AlertId.String(), alert)
+}
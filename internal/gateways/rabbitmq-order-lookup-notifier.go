@@ -0,0 +1,19 @@
+package gateways
+
+import (
+	"github.com/rabbitmq/amqp091-go"
+)
+
+const OrderLookupNotificationsQueue = "order-lookup-notifications"
+
+type RabbitmqOrderLookupNotifier struct {
+	rabbitmqConn *amqp091.Connection
+}
+
+func NewRabbitmqOrderLookupNotifier(rabbitmqConn *amqp091.Connection) RabbitmqOrderLookupNotifier {
+	return RabbitmqOrderLookupNotifier{rabbitmqConn}
+}
+
+func (r RabbitmqOrderLookupNotifier) NotifyOrderLookup(notification OrderLookupNotification) error {
+	return publishRabbitmqJSON(r.rabbitmqConn, OrderLookupNotificationsQueue, notification.OrderId.String(), notification)
+}
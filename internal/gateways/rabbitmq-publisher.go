@@ -0,0 +1,38 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// publishRabbitmqJSON publishes a persistent JSON message to a durable queue, declaring it if needed.
+// The message id lets consumers discard redeliveries.
+func publishRabbitmqJSON(rabbitmqConn *amqp091.Connection, queue string, messageId string, message any) error {
+	channel, err := rabbitmqConn.Channel()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = channel.Close()
+	}()
+
+	_, err = channel.QueueDeclare(queue, true, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return channel.PublishWithContext(context.Background(), "", queue, false, false, amqp091.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp091.Persistent,
+		MessageId:    messageId,
+		Body:         body,
+	})
+}
@@ -0,0 +1,19 @@
+package gateways
+
+import (
+	"github.com/rabbitmq/amqp091-go"
+)
+
+const RestockNotificationsQueue = "restock-notifications"
+
+type RabbitmqRestockNotifier struct {
+	rabbitmqConn *amqp091.Connection
+}
+
+func NewRabbitmqRestockNotifier(rabbitmqConn *amqp091.Connection) RabbitmqRestockNotifier {
+	return RabbitmqRestockNotifier{rabbitmqConn}
+}
+
+func (r RabbitmqRestockNotifier) NotifyRestock(notification RestockNotification) error {
+	return publishRabbitmqJSON(r.rabbitmqConn, RestockNotificationsQueue, notification.SubscriptionId.String(), notification)
+}
@@ -0,0 +1,20 @@
+package gateways
+
+import (
+	"github.com/google/uuid"
+)
+
+type RestockNotification struct {
+	SubscriptionId uuid.UUID `json:"subscriptionId"`
+	CustomerId     uuid.UUID `json:"customerId"`
+	CustomerName   string    `json:"customerName"`
+	CustomerEmail  string    `json:"customerEmail"`
+	ProductId      uuid.UUID `json:"productId"`
+	ProductName    string    `json:"productName"`
+	ProductSlug    *string   `json:"productSlug"`
+	UnsubscribeUrl string    `json:"unsubscribeUrl"`
+}
+
+type RestockNotifier interface {
+	NotifyRestock(notification RestockNotification) error
+}
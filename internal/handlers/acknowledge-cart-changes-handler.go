@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type AcknowledgeCartChangesHandler struct {
+	acknowledgeCartChangesUsecase usecases.AcknowledgeCartChangesUsecase
+}
+
+func NewAcknowledgeCartChangesHandler(acknowledgeCartChangesUsecase usecases.AcknowledgeCartChangesUsecase) AcknowledgeCartChangesHandler {
+	return AcknowledgeCartChangesHandler{acknowledgeCartChangesUsecase}
+}
+
+func (a *AcknowledgeCartChangesHandler) Handle(c echo.Context) error {
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := a.acknowledgeCartChangesUsecase.Execute(usecases.AcknowledgeCartChangesUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddBundleHandlerInput struct {
+	Name        any `validate:"required,string,notEmpty"`
+	Description any `validate:"omitempty,string,notEmpty"`
+	Price       any `validate:"required,integer,positive"`
+	Currency    any `validate:"omitempty,string"`
+	Components  any `validate:"required,notEmpty"`
+}
+
+type AddBundleComponentHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Quantity  any `validate:"required,integer,positive"`
+}
+
+type AddBundleHandler struct {
+	jsonBodyValidator webhttp.JSONBodyValidator
+	addBundleUsecase  usecases.AddBundleUsecase
+}
+
+func NewAddBundleHandler(jsonBodyValidator webhttp.JSONBodyValidator, addBundleUsecase usecases.AddBundleUsecase) AddBundleHandler {
+	return AddBundleHandler{jsonBodyValidator, addBundleUsecase}
+}
+
+func (a *AddBundleHandler) Handle(c echo.Context) error {
+	var input AddBundleHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	rawComponents, ok := input.Components.([]any)
+
+	if !ok {
+		return c.JSON(400, map[string]any{"message": []string{"components must be array"}})
+	}
+
+	components := []usecases.AddBundleComponent{}
+	messages := []string{}
+
+	for index, rawComponent := range rawComponents {
+		fields, ok := rawComponent.(map[string]any)
+
+		if !ok {
+			messages = append(messages, fmt.Sprintf("components[%d] must be object", index))
+			continue
+		}
+
+		component := AddBundleComponentHandlerInput{
+			ProductId: fields["productId"],
+			Quantity:  fields["quantity"],
+		}
+
+		if componentMessages := a.jsonBodyValidator.Validate(component); len(componentMessages) > 0 {
+			for _, message := range componentMessages {
+				messages = append(messages, fmt.Sprintf("components[%d].%s", index, message))
+			}
+
+			continue
+		}
+
+		components = append(components, usecases.AddBundleComponent{
+			ProductId: uuid.MustParse(component.ProductId.(string)),
+			Quantity:  int32(component.Quantity.(float64)),
+		})
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	var description *string = nil
+
+	if input.Description != nil {
+		s := input.Description.(string)
+		description = &s
+	}
+
+	currency := ""
+
+	if input.Currency != nil {
+		currency = input.Currency.(string)
+	}
+
+	output, err := a.addBundleUsecase.Execute(usecases.AddBundleUsecaseInput{
+		Name:        input.Name.(string),
+		Description: description,
+		Price:       int64(input.Price.(float64)),
+		Currency:    currency,
+		Components:  components,
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"productId": output.ProductId,
+			},
+		})
+	}
+
+	if err.Error() == "the product price cannot be zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "the product name cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "component quantity must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "bundle components must be distinct" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "component product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "bundle components cannot be bundles" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -10,6 +10,7 @@ type AddProductHandlerInput struct {
 	Name        any `validate:"required,string,notEmpty"`
 	Description any `validate:"omitempty,string,notEmpty"`
 	Price       any `validate:"required,integer,positive"`
+	Currency    any `validate:"omitempty,string"`
 }
 
 type AddProductHandler struct {
@@ -39,18 +40,38 @@ func (a *AddProductHandler) Handle(c echo.Context) error {
 		description = &s
 	}
 
-	err := a.addProductUsecase.Execute(usecases.AddProductUsecaseInput{
+	currency := ""
+
+	if input.Currency != nil {
+		currency = input.Currency.(string)
+	}
+
+	output, err := a.addProductUsecase.Execute(usecases.AddProductUsecaseInput{
 		Name:        input.Name.(string),
 		Description: description,
 		Price:       int64(input.Price.(float64)),
+		Currency:    currency,
 	})
 	if err == nil {
-		return c.NoContent(204)
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"productId":   output.ProductId,
+				"inventoryId": output.InventoryId,
+			},
+		})
 	}
 
 	if err.Error() == "the product price cannot be zero" {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
 
+	if err.Error() == "the product name cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	return err
 }
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddProductReviewHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Rating    any `validate:"required,integer,positive"`
+	Title     any `validate:"required,string,notEmpty"`
+	Body      any `validate:"required,string,notEmpty"`
+}
+
+type AddProductReviewHandler struct {
+	jsonBodyValidator       webhttp.JSONBodyValidator
+	addProductReviewUsecase usecases.AddProductReviewUsecase
+}
+
+func NewAddProductReviewHandler(jsonBodyValidator webhttp.JSONBodyValidator, addProductReviewUsecase usecases.AddProductReviewUsecase) AddProductReviewHandler {
+	return AddProductReviewHandler{jsonBodyValidator, addProductReviewUsecase}
+}
+
+func (a *AddProductReviewHandler) Handle(c echo.Context) error {
+	var input AddProductReviewHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := a.addProductReviewUsecase.Execute(usecases.AddProductReviewUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+		Rating:     int16(input.Rating.(float64)),
+		Title:      input.Title.(string),
+		Body:       input.Body.(string),
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"productReviewId": output.ProductReviewId,
+				"status":          "pending",
+			},
+		})
+	}
+
+	if err.Error() == "rating must be between 1 and 5" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "review title cannot exceed 100 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "only customers who purchased this product can review it" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "you have already reviewed this product" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddProductToGuestCartHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Quantity  any `validate:"required,integer,positive"`
+}
+
+type AddProductToGuestCartHandler struct {
+	jsonBodyValidator            webhttp.JSONBodyValidator
+	addProductToGuestCartUsecase usecases.AddProductToGuestCartUsecase
+}
+
+func NewAddProductToGuestCartHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	addProductToGuestCartUsecase usecases.AddProductToGuestCartUsecase) AddProductToGuestCartHandler {
+	return AddProductToGuestCartHandler{jsonBodyValidator, addProductToGuestCartUsecase}
+}
+
+func (a *AddProductToGuestCartHandler) Handle(c echo.Context) error {
+	var input AddProductToGuestCartHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	err := a.addProductToGuestCartUsecase.Execute(usecases.AddProductToGuestCartUsecaseInput{
+		GuestCartId: uuid.MustParse(claims.Subject),
+		ProductId:   uuid.MustParse(input.ProductId.(string)),
+		Quantity:    int32(input.Quantity.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity cannot be zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the stock available" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product is not priced in the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddProductToWishlistHandlerInput struct {
+	WishlistId any `validate:"required,uuid4"`
+	ProductId  any `validate:"required,uuid4"`
+}
+
+type AddProductToWishlistHandler struct {
+	jsonBodyValidator           webhttp.JSONBodyValidator
+	addProductToWishlistUsecase usecases.AddProductToWishlistUsecase
+}
+
+func NewAddProductToWishlistHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	addProductToWishlistUsecase usecases.AddProductToWishlistUsecase) AddProductToWishlistHandler {
+	return AddProductToWishlistHandler{jsonBodyValidator, addProductToWishlistUsecase}
+}
+
+func (a *AddProductToWishlistHandler) Handle(c echo.Context) error {
+	var input AddProductToWishlistHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := a.addProductToWishlistUsecase.Execute(usecases.AddProductToWishlistUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		WishlistId: uuid.MustParse(input.WishlistId.(string)),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "wishlist not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product is already in the wishlist" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddPromotionHandlerInput struct {
+	Code             any `validate:"required,string,notEmpty"`
+	Type             any `validate:"required,string,notEmpty"`
+	Percentage       any `validate:"omitempty,integer,positive"`
+	Amount           any `validate:"omitempty,integer,positive"`
+	Currency         any `validate:"omitempty,string,notEmpty"`
+	ProductId        any `validate:"omitempty,uuid4"`
+	BuyQuantity      any `validate:"omitempty,integer,positive"`
+	GetQuantity      any `validate:"omitempty,integer,positive"`
+	MinimumSubtotal  any `validate:"omitempty,integer,positive"`
+	UsageLimit       any `validate:"omitempty,integer,positive"`
+	PerCustomerLimit any `validate:"omitempty,integer,positive"`
+	StartsAt         any `validate:"omitempty,timeRFC3339"`
+	EndsAt           any `validate:"omitempty,timeRFC3339"`
+}
+
+type AddPromotionHandler struct {
+	jsonBodyValidator   webhttp.JSONBodyValidator
+	addPromotionUsecase usecases.AddPromotionUsecase
+}
+
+func NewAddPromotionHandler(jsonBodyValidator webhttp.JSONBodyValidator, addPromotionUsecase usecases.AddPromotionUsecase) AddPromotionHandler {
+	return AddPromotionHandler{jsonBodyValidator, addPromotionUsecase}
+}
+
+func (a *AddPromotionHandler) Handle(c echo.Context) error {
+	var input AddPromotionHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	usecaseInput := usecases.AddPromotionUsecaseInput{
+		Code:             strings.ToUpper(input.Code.(string)),
+		Type:             input.Type.(string),
+		Percentage:       optionalInt32(input.Percentage),
+		Amount:           optionalInt64(input.Amount),
+		ProductId:        optionalUUID(input.ProductId),
+		BuyQuantity:      optionalInt32(input.BuyQuantity),
+		GetQuantity:      optionalInt32(input.GetQuantity),
+		MinimumSubtotal:  optionalInt64(input.MinimumSubtotal),
+		UsageLimit:       optionalInt32(input.UsageLimit),
+		PerCustomerLimit: optionalInt32(input.PerCustomerLimit),
+		StartsAt:         optionalTime(input.StartsAt),
+		EndsAt:           optionalTime(input.EndsAt),
+	}
+
+	if input.Currency != nil {
+		usecaseInput.Currency = utils.NewPointer(strings.ToUpper(input.Currency.(string)))
+	}
+
+	output, err := a.addPromotionUsecase.Execute(usecaseInput)
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"promotionId": output.PromotionId,
+			},
+		})
+	}
+
+	if err.Error() == "coupon code cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "percentage must be between 1 and 100" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "amount must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "fixed amount coupons require a currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "buy and get quantities must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon type is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "minimum subtotal must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "a minimum subtotal requires a currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "usage limits must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon must end after it starts" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon code already exists" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
+
+func optionalInt32(value any) *int32 {
+	if value == nil {
+		return nil
+	}
+
+	return utils.NewPointer(int32(value.(float64)))
+}
+
+func optionalInt64(value any) *int64 {
+	if value == nil {
+		return nil
+	}
+
+	return utils.NewPointer(int64(value.(float64)))
+}
+
+func optionalUUID(value any) *uuid.UUID {
+	if value == nil {
+		return nil
+	}
+
+	return utils.NewPointer(uuid.MustParse(value.(string)))
+}
+
+func optionalTime(value any) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	return utils.NewPointer(utils.GetOrThrow(time.Parse(time.RFC3339, value.(string))).UTC())
+}
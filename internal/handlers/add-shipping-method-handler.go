@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddShippingMethodHandlerInput struct {
+	Name          any `validate:"required,string,notEmpty"`
+	Type          any `validate:"required,string,notEmpty"`
+	Currency      any `validate:"omitempty,string,notEmpty"`
+	Rate          any `validate:"omitempty,integer,positive"`
+	RatePerKg     any `validate:"omitempty,integer,positive"`
+	FreeThreshold any `validate:"omitempty,integer,positive"`
+	ZoneRates     any
+}
+
+type AddShippingMethodZoneRateHandlerInput struct {
+	ZipPrefix any `validate:"required,string,notEmpty"`
+	Rate      any `validate:"required,integer,positive"`
+}
+
+type AddShippingMethodHandler struct {
+	jsonBodyValidator        webhttp.JSONBodyValidator
+	addShippingMethodUsecase usecases.AddShippingMethodUsecase
+}
+
+func NewAddShippingMethodHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	addShippingMethodUsecase usecases.AddShippingMethodUsecase) AddShippingMethodHandler {
+	return AddShippingMethodHandler{jsonBodyValidator, addShippingMethodUsecase}
+}
+
+func (a *AddShippingMethodHandler) Handle(c echo.Context) error {
+	var input AddShippingMethodHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	zoneRates := []usecases.AddShippingMethodZoneRate{}
+
+	if input.ZoneRates != nil {
+		rawZoneRates, ok := input.ZoneRates.([]any)
+
+		if !ok {
+			return c.JSON(400, map[string]any{"message": []string{"zoneRates must be array"}})
+		}
+
+		messages := []string{}
+
+		for index, rawZoneRate := range rawZoneRates {
+			fields, ok := rawZoneRate.(map[string]any)
+
+			if !ok {
+				messages = append(messages, fmt.Sprintf("zoneRates[%d] must be object", index))
+				continue
+			}
+
+			zoneRate := AddShippingMethodZoneRateHandlerInput{
+				ZipPrefix: fields["zipPrefix"],
+				Rate:      fields["rate"],
+			}
+
+			if zoneRateMessages := a.jsonBodyValidator.Validate(zoneRate); len(zoneRateMessages) > 0 {
+				for _, message := range zoneRateMessages {
+					messages = append(messages, fmt.Sprintf("zoneRates[%d].%s", index, message))
+				}
+
+				continue
+			}
+
+			zoneRates = append(zoneRates, usecases.AddShippingMethodZoneRate{
+				ZipPrefix: zoneRate.ZipPrefix.(string),
+				Rate:      int64(zoneRate.Rate.(float64)),
+			})
+		}
+
+		if len(messages) > 0 {
+			return c.JSON(400, map[string]any{"message": messages})
+		}
+	}
+
+	currency := ""
+
+	if input.Currency != nil {
+		currency = strings.ToUpper(input.Currency.(string))
+	}
+
+	output, err := a.addShippingMethodUsecase.Execute(usecases.AddShippingMethodUsecaseInput{
+		Name:          input.Name.(string),
+		Type:          input.Type.(string),
+		Currency:      currency,
+		Rate:          optionalInt64(input.Rate),
+		RatePerKg:     optionalInt64(input.RatePerKg),
+		FreeThreshold: optionalInt64(input.FreeThreshold),
+		ZoneRates:     zoneRates,
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"shippingMethodId": output.ShippingMethodId,
+			},
+		})
+	}
+
+	if err.Error() == "shipping method name cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "flat shipping methods require a rate" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "weight-based shipping methods require a rate and a rate per kg" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "free-over-threshold shipping methods require a rate and a free threshold" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "free threshold must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "zone shipping methods require zone rates" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "zip prefix must have 1 to 5 digits" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "zip prefixes must be distinct" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method type is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method name already exists" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
 	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
@@ -8,8 +9,8 @@ import (
 )
 
 type AddStockHandlerInput struct {
-	InventoryId any `validate:"required,uuid4"`
-	Stock       any `validate:"required,integer,positive"`
+	InventoryLookupInput
+	Stock any `validate:"required,integer,positive"`
 }
 
 type AddStockHandler struct {
@@ -32,9 +33,17 @@ func (a *AddStockHandler) Handle(c echo.Context) error {
 		return c.JSON(400, map[string]any{"message": messages})
 	}
 
+	if messages := input.InventoryLookupInput.Validate(); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
 	err := a.addStockUsecase.Execute(usecases.AddStockUsecaseInput{
-		InventoryId: uuid.MustParse(input.InventoryId.(string)),
-		Stock:       int32(input.Stock.(float64)),
+		Inventory: input.ToInventoryLookup(),
+		Stock:     int32(input.Stock.(float64)),
+		ActorId:   uuid.MustParse(claims.Subject),
 	})
 	if err == nil {
 		return c.NoContent(204)
@@ -44,6 +53,18 @@ func (a *AddStockHandler) Handle(c echo.Context) error {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
 
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "bundles have no stock of their own" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "warehouse not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	if err.Error() == "stock quantity must be higher than zero" {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AddWarehouseHandlerInput struct {
+	Name    any `validate:"required,string,notEmpty"`
+	ZipCode any `validate:"omitempty,string,notEmpty"`
+}
+
+type AddWarehouseHandler struct {
+	jsonBodyValidator   webhttp.JSONBodyValidator
+	addWarehouseUsecase usecases.AddWarehouseUsecase
+}
+
+func NewAddWarehouseHandler(jsonBodyValidator webhttp.JSONBodyValidator, addWarehouseUsecase usecases.AddWarehouseUsecase) AddWarehouseHandler {
+	return AddWarehouseHandler{jsonBodyValidator, addWarehouseUsecase}
+}
+
+func (a *AddWarehouseHandler) Handle(c echo.Context) error {
+	var input AddWarehouseHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	usecaseInput := usecases.AddWarehouseUsecaseInput{
+		Name: input.Name.(string),
+	}
+
+	if input.ZipCode != nil {
+		zipCode := input.ZipCode.(string)
+		usecaseInput.ZipCode = &zipCode
+	}
+
+	output, err := a.addWarehouseUsecase.Execute(usecaseInput)
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"warehouseId": output.WarehouseId,
+			},
+		})
+	}
+
+	if err.Error() == "warehouse name cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "warehouse name already exists" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
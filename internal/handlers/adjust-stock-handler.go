@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AdjustStockHandlerInput struct {
+	InventoryLookupInput
+	Quantity any `validate:"required,integer"`
+	Reason   any `validate:"required,string,notEmpty"`
+	Note     any `validate:"required,string,notEmpty"`
+}
+
+type AdjustStockHandler struct {
+	jsonBodyValidator  webhttp.JSONBodyValidator
+	adjustStockUsecase usecases.AdjustStockUsecase
+}
+
+func NewAdjustStockHandler(jsonBodyValidator webhttp.JSONBodyValidator, adjustStockUsecase usecases.AdjustStockUsecase) AdjustStockHandler {
+	return AdjustStockHandler{jsonBodyValidator, adjustStockUsecase}
+}
+
+func (a *AdjustStockHandler) Handle(c echo.Context) error {
+	var input AdjustStockHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	if messages := input.InventoryLookupInput.Validate(); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := a.adjustStockUsecase.Execute(usecases.AdjustStockUsecaseInput{
+		Inventory: input.ToInventoryLookup(),
+		Quantity:  int32(input.Quantity.(float64)),
+		Reason:    input.Reason.(string),
+		Note:      input.Note.(string),
+		ActorId:   uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "adjustment quantity cannot be zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "reason must be adjustment or damage" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "damage can only decrease stock" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "note cannot exceed 500 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "inventory not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "stock cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
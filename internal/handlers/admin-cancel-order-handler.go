@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type AdminCancelOrderHandlerInput struct {
+	OrderId any `validate:"required,uuid4"`
+}
+
+type AdminCancelOrderHandler struct {
+	jsonBodyValidator  webhttp.JSONBodyValidator
+	cancelOrderUsecase usecases.CancelOrderUsecase
+}
+
+func NewAdminCancelOrderHandler(jsonBodyValidator webhttp.JSONBodyValidator, cancelOrderUsecase usecases.CancelOrderUsecase) AdminCancelOrderHandler {
+	return AdminCancelOrderHandler{jsonBodyValidator, cancelOrderUsecase}
+}
+
+func (a *AdminCancelOrderHandler) Handle(c echo.Context) error {
+	var input AdminCancelOrderHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := a.cancelOrderUsecase.Execute(usecases.CancelOrderUsecaseInput{
+		OrderId: uuid.MustParse(input.OrderId.(string)),
+		ActorId: uuid.MustParse(claims.Subject),
+		AsAdmin: true,
+	})
+	if err == nil {
+		return c.JSON(200, map[string]any{
+			"data": map[string]any{
+				"refundedAmount": output.RefundedAmount,
+			},
+		})
+	}
+
+	if err.Error() == "order not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order is already cancelled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order has return requests" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
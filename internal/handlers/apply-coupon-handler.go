@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ApplyCouponHandlerInput struct {
+	Code any `validate:"required,string,notEmpty"`
+}
+
+type ApplyCouponHandler struct {
+	jsonBodyValidator  webhttp.JSONBodyValidator
+	applyCouponUsecase usecases.ApplyCouponUsecase
+}
+
+func NewApplyCouponHandler(jsonBodyValidator webhttp.JSONBodyValidator, applyCouponUsecase usecases.ApplyCouponUsecase) ApplyCouponHandler {
+	return ApplyCouponHandler{jsonBodyValidator, applyCouponUsecase}
+}
+
+func (a *ApplyCouponHandler) Handle(c echo.Context) error {
+	var input ApplyCouponHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := a.applyCouponUsecase.Execute(usecases.ApplyCouponUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		Code:       strings.ToUpper(strings.TrimSpace(input.Code.(string))),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "coupon not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon is not active" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon does not apply to the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart subtotal is below the coupon minimum" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon usage limit was reached" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon was already used the maximum number of times" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "coupon does not apply to the cart items" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ApplyStockCountHandlerInput struct {
+	StockCountId any `validate:"required,uuid4"`
+}
+
+type ApplyStockCountHandler struct {
+	jsonBodyValidator      webhttp.JSONBodyValidator
+	applyStockCountUsecase usecases.ApplyStockCountUsecase
+}
+
+func NewApplyStockCountHandler(jsonBodyValidator webhttp.JSONBodyValidator, applyStockCountUsecase usecases.ApplyStockCountUsecase) ApplyStockCountHandler {
+	return ApplyStockCountHandler{jsonBodyValidator, applyStockCountUsecase}
+}
+
+func (a *ApplyStockCountHandler) Handle(c echo.Context) error {
+	var input ApplyStockCountHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := a.applyStockCountUsecase.Execute(usecases.ApplyStockCountUsecaseInput{
+		StockCountId: uuid.MustParse(input.StockCountId.(string)),
+		ActorId:      uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "stock count not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "stock count must be submitted before it is applied" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "inventory not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "stock cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CancelOrderHandlerInput struct {
+	OrderId any `validate:"required,uuid4"`
+}
+
+type CancelOrderHandler struct {
+	jsonBodyValidator  webhttp.JSONBodyValidator
+	cancelOrderUsecase usecases.CancelOrderUsecase
+}
+
+func NewCancelOrderHandler(jsonBodyValidator webhttp.JSONBodyValidator, cancelOrderUsecase usecases.CancelOrderUsecase) CancelOrderHandler {
+	return CancelOrderHandler{jsonBodyValidator, cancelOrderUsecase}
+}
+
+func (a *CancelOrderHandler) Handle(c echo.Context) error {
+	var input CancelOrderHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := a.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := a.cancelOrderUsecase.Execute(usecases.CancelOrderUsecaseInput{
+		OrderId: uuid.MustParse(input.OrderId.(string)),
+		ActorId: uuid.MustParse(claims.Subject),
+		AsAdmin: false,
+	})
+	if err == nil {
+		return c.JSON(200, map[string]any{
+			"data": map[string]any{
+				"refundedAmount": output.RefundedAmount,
+			},
+		})
+	}
+
+	if err.Error() == "order not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order is already cancelled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order cannot be cancelled after it is fulfilled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
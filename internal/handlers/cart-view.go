@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// cartView is what the cart and the guest cart responses share, built from the lines of the cart.
+type cartView struct {
+	Items           []item
+	PricingLines    []usecases.PricingLine
+	TotalItems      int
+	TotalQuantity   int32
+	SubtotalPrice   int64
+	RelatedProducts []relatedProduct
+}
+
+func newCartView(pgxPool *pgxpool.Pool, lines []usecases.CartLine) cartView {
+	view := cartView{
+		Items:        []item{},
+		PricingLines: []usecases.PricingLine{},
+	}
+
+	productIds := []uuid.UUID{}
+
+	for _, line := range lines {
+		productIds = append(productIds, line.ProductId)
+
+		// Products no longer priced in the cart currency cannot be bought, so they are left out of the totals.
+		if line.Price == nil {
+			continue
+		}
+
+		view.TotalItems++
+		view.TotalQuantity += line.Quantity
+		view.SubtotalPrice += *line.Price * int64(line.Quantity)
+		view.PricingLines = append(view.PricingLines, usecases.PricingLine{
+			ProductId:   line.ProductId,
+			TaxCategory: line.Product.TaxCategory,
+			Quantity:    line.Quantity,
+			UnitPrice:   *line.Price,
+		})
+		view.Items = append(view.Items, item{
+			Id:          line.ItemId,
+			ProductId:   line.ProductId,
+			Name:        line.Product.Name,
+			Description: line.Product.Description,
+			Quantity:    line.Quantity,
+			Price:       *line.Price,
+		})
+	}
+
+	view.RelatedProducts = findRelatedProducts(pgxPool, productIds)
+
+	return view
+}
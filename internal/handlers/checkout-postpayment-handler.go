@@ -30,5 +30,17 @@ func (a *CheckoutPostpaymentHandler) Handle(c echo.Context) error {
 		return c.NoContent(200)
 	}
 
+	if err.Error() == "address not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the stock available" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "some products in the cart are not priced in the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	return err
 }
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type ComputeProductRecommendationsHandlerOutput struct {
+	RecommendationsCount int64 `json:"recommendationsCount"`
+}
+
+type ComputeProductRecommendationsHandler struct {
+	computeProductRecommendationsUsecase usecases.ComputeProductRecommendationsUsecase
+}
+
+func NewComputeProductRecommendationsHandler(computeProductRecommendationsUsecase usecases.ComputeProductRecommendationsUsecase) ComputeProductRecommendationsHandler {
+	return ComputeProductRecommendationsHandler{computeProductRecommendationsUsecase}
+}
+
+func (c *ComputeProductRecommendationsHandler) Handle(ctx echo.Context) error {
+	output, err := c.computeProductRecommendationsUsecase.Execute()
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(200, map[string]any{
+		"data": ComputeProductRecommendationsHandlerOutput{
+			RecommendationsCount: output.RecommendationsCount,
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ConvertGuestCustomerHandlerInput struct {
+	Name     any `validate:"required,string,notEmpty"`
+	Password any `validate:"required,string,notEmpty"`
+}
+
+type ConvertGuestCustomerHandler struct {
+	jsonBodyValidator           webhttp.JSONBodyValidator
+	convertGuestCustomerUsecase usecases.ConvertGuestCustomerUsecase
+}
+
+func NewConvertGuestCustomerHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	convertGuestCustomerUsecase usecases.ConvertGuestCustomerUsecase) ConvertGuestCustomerHandler {
+	return ConvertGuestCustomerHandler{jsonBodyValidator, convertGuestCustomerUsecase}
+}
+
+func (g *ConvertGuestCustomerHandler) Handle(c echo.Context) error {
+	var input ConvertGuestCustomerHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := g.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("orderLookup").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtOrderLookupTokenClaims)
+
+	err := g.convertGuestCustomerUsecase.Execute(usecases.ConvertGuestCustomerUsecaseInput{
+		OrderId:  uuid.MustParse(claims.Subject),
+		Name:     input.Name.(string),
+		Password: input.Password.(string),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "name must be at least 2 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "password must be at least 6 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "customer already has an account" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
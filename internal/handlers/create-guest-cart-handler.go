@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateGuestCartHandler struct {
+	createGuestCartUsecase usecases.CreateGuestCartUsecase
+}
+
+func NewCreateGuestCartHandler(createGuestCartUsecase usecases.CreateGuestCartUsecase) CreateGuestCartHandler {
+	return CreateGuestCartHandler{createGuestCartUsecase}
+}
+
+func (g *CreateGuestCartHandler) Handle(c echo.Context) error {
+	createGuestCartUsecaseOutput, err := g.createGuestCartUsecase.Execute()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(201, map[string]any{
+		"data": map[string]any{
+			"cartToken": createGuestCartUsecaseOutput.CartToken,
+			"expiresAt": createGuestCartUsecaseOutput.ExpiresAt,
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type CreateWishlistHandlerInput struct {
+	Name any `validate:"required,string,notEmpty"`
+}
+
+type CreateWishlistHandler struct {
+	jsonBodyValidator     webhttp.JSONBodyValidator
+	createWishlistUsecase usecases.CreateWishlistUsecase
+}
+
+func NewCreateWishlistHandler(jsonBodyValidator webhttp.JSONBodyValidator, createWishlistUsecase usecases.CreateWishlistUsecase) CreateWishlistHandler {
+	return CreateWishlistHandler{jsonBodyValidator, createWishlistUsecase}
+}
+
+func (w *CreateWishlistHandler) Handle(c echo.Context) error {
+	var input CreateWishlistHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := w.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := w.createWishlistUsecase.Execute(usecases.CreateWishlistUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		Name:       input.Name.(string),
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"wishlistId": output.WishlistId,
+			},
+		})
+	}
+
+	if err.Error() == "wishlist name cannot exceed 100 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "wishlist name already exists" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
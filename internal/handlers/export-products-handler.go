@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type exportedProduct struct {
+	Id            uuid.UUID `json:"id"`
+	Sku           *string   `json:"sku"`
+	Status        string    `json:"status"`
+	Name          string    `json:"name"`
+	Description   *string   `json:"description"`
+	Price         int64     `json:"price"`
+	Currency      string    `json:"currency"`
+	StockQuantity int64     `json:"stockQuantity"`
+}
+
+type ExportProductsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewExportProductsHandler(pgxPool *pgxpool.Pool) ExportProductsHandler {
+	return ExportProductsHandler{pgxPool}
+}
+
+func (e *ExportProductsHandler) Handle(c echo.Context) error {
+	format := c.QueryParam("format")
+
+	if format == "" {
+		format = "csv"
+	}
+
+	if format != "csv" && format != "ndjson" {
+		return c.JSON(400, map[string]any{"message": []string{"format must be csv or ndjson"}})
+	}
+
+	rows := utils.GetOrThrow(e.pgxPool.Query(context.Background(),
+		`
+			SELECT
+				p.id,
+				p.sku,
+				p.status,
+				p.name,
+				p.description,
+				p.price,
+				p.currency,
+				COALESCE(SUM(i.stock_quantity), 0) AS stock_quantity
+			FROM products p
+			LEFT JOIN inventories i
+				ON i.product_id = p.id
+			GROUP BY p.id
+			ORDER BY p.created_at, p.id
+		`))
+	defer rows.Close()
+
+	response := c.Response()
+
+	if format == "csv" {
+		response.Header().Set("Content-Type", "text/csv")
+		response.Header().Set("Content-Disposition", `attachment; filename="products.csv"`)
+	} else {
+		response.Header().Set("Content-Type", "application/x-ndjson")
+		response.Header().Set("Content-Disposition", `attachment; filename="products.ndjson"`)
+	}
+
+	response.WriteHeader(200)
+
+	csvWriter := csv.NewWriter(response)
+	jsonEncoder := json.NewEncoder(response)
+
+	if format == "csv" {
+		utils.ThrowOnError(csvWriter.Write([]string{"id", "sku", "status", "name", "description", "price", "currency", "stock_quantity"}))
+	}
+
+	for rows.Next() {
+		var item exportedProduct
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.Sku, &item.Status, &item.Name, &item.Description, &item.Price, &item.Currency, &item.StockQuantity))
+
+		if format == "ndjson" {
+			utils.ThrowOnError(jsonEncoder.Encode(item))
+			response.Flush()
+			continue
+		}
+
+		sku := ""
+		if item.Sku != nil {
+			sku = *item.Sku
+		}
+
+		description := ""
+		if item.Description != nil {
+			description = *item.Description
+		}
+
+		utils.ThrowOnError(csvWriter.Write([]string{item.Id.String(), sku, item.Status, item.Name, description,
+			strconv.FormatInt(item.Price, 10), item.Currency, strconv.FormatInt(item.StockQuantity, 10)}))
+		csvWriter.Flush()
+		response.Flush()
+	}
+
+	utils.ThrowOnError(rows.Err())
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type FulfilOrderHandlerInput struct {
+	OrderId any `validate:"required,uuid4"`
+}
+
+type FulfilOrderHandler struct {
+	jsonBodyValidator  webhttp.JSONBodyValidator
+	fulfilOrderUsecase usecases.FulfilOrderUsecase
+}
+
+func NewFulfilOrderHandler(jsonBodyValidator webhttp.JSONBodyValidator, fulfilOrderUsecase usecases.FulfilOrderUsecase) FulfilOrderHandler {
+	return FulfilOrderHandler{jsonBodyValidator, fulfilOrderUsecase}
+}
+
+func (f *FulfilOrderHandler) Handle(c echo.Context) error {
+	var input FulfilOrderHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := f.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := f.fulfilOrderUsecase.Execute(usecases.FulfilOrderUsecaseInput{
+		OrderId: uuid.MustParse(input.OrderId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "order not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order is cancelled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order is already fulfilled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type recoveredRevenue struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+type GetAbandonedCartMetricsHandlerOutput struct {
+	RemindersSent    int64              `json:"remindersSent"`
+	RemindedCarts    int64              `json:"remindedCarts"`
+	RecoveredCarts   int64              `json:"recoveredCarts"`
+	RecoveryRate     float64            `json:"recoveryRate"`
+	RecoveredOrders  int64              `json:"recoveredOrders"`
+	RecoveredRevenue []recoveredRevenue `json:"recoveredRevenue"`
+}
+
+type GetAbandonedCartMetricsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetAbandonedCartMetricsHandler(pgxPool *pgxpool.Pool) GetAbandonedCartMetricsHandler {
+	return GetAbandonedCartMetricsHandler{pgxPool}
+}
+
+// Handle reports how the cart reminders sent in the range did. A reminded cart is recovered when the customer
+// placed an order after the reminder, and the revenue of those orders is totalled per currency.
+func (g *GetAbandonedCartMetricsHandler) Handle(c echo.Context) error {
+	messages := []string{}
+
+	// The range is inclusive on both ends and open when a bound is omitted.
+	var from, to *time.Time
+
+	if value := c.QueryParam("from"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			messages = append(messages, "from must follow format yyyy-mm-ddThh:mm:ssZ")
+		}
+
+		from = &parsed
+	}
+
+	if value := c.QueryParam("to"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			messages = append(messages, "to must follow format yyyy-mm-ddThh:mm:ssZ")
+		}
+
+		to = &parsed
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetAbandonedCartMetricsHandlerOutput{
+		RecoveredRevenue: []recoveredRevenue{},
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT
+				COUNT(*),
+				COUNT(DISTINCT cart_id),
+				COUNT(DISTINCT cart_id) FILTER (WHERE order_id IS NOT NULL),
+				COUNT(DISTINCT order_id)
+			FROM cart_reminders
+			WHERE ($1::TIMESTAMPTZ IS NULL OR sent_at >= $1)
+				AND ($2::TIMESTAMPTZ IS NULL OR sent_at <= $2)
+		`, from, to).Scan(&output.RemindersSent, &output.RemindedCarts, &output.RecoveredCarts, &output.RecoveredOrders))
+
+	if output.RemindedCarts > 0 {
+		output.RecoveryRate = float64(output.RecoveredCarts) / float64(output.RemindedCarts)
+	}
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT o.currency, SUM(o.total_price)::BIGINT
+			FROM orders o
+			WHERE o.id IN (
+				SELECT order_id
+				FROM cart_reminders
+				WHERE order_id IS NOT NULL
+					AND ($1::TIMESTAMPTZ IS NULL OR sent_at >= $1)
+					AND ($2::TIMESTAMPTZ IS NULL OR sent_at <= $2)
+			)
+			GROUP BY o.currency
+			ORDER BY o.currency
+		`, from, to))
+
+	for rows.Next() {
+		var item recoveredRevenue
+
+		utils.ThrowOnError(rows.Scan(&item.Currency, &item.Amount))
+		output.RecoveredRevenue = append(output.RecoveredRevenue, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
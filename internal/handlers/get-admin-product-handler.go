@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAdminProductHandler struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewGetAdminProductHandler(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) GetAdminProductHandler {
+	return GetAdminProductHandler{pgxPool, productDAO}
+}
+
+func (g *GetAdminProductHandler) Handle(c echo.Context) error {
+	productId := c.Param("productId")
+
+	if !utils.IsValidUUID(productId) {
+		return c.JSON(400, map[string]any{"message": []string{"productId must be uuidv4"}})
+	}
+
+	productSchema := g.productDAO.FindOneById(uuid.MustParse(productId))
+
+	if productSchema == nil {
+		return c.JSON(409, map[string]any{"message": "product not found"})
+	}
+
+	output := adminProduct{
+		Id:          productSchema.Id,
+		Sku:         productSchema.Sku,
+		Slug:        productSchema.Slug,
+		Status:      productSchema.Status,
+		Name:        productSchema.Name,
+		Price:       productSchema.Price,
+		Currency:    productSchema.Currency,
+		CreatedAt:   productSchema.CreatedAt,
+		Inventories: findAdminProductInventories(g.pgxPool, []uuid.UUID{productSchema.Id})[productSchema.Id],
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
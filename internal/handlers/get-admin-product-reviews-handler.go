@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type adminProductReview struct {
+	Id           uuid.UUID  `json:"id"`
+	ProductId    uuid.UUID  `json:"productId"`
+	CustomerId   uuid.UUID  `json:"customerId"`
+	Rating       int16      `json:"rating"`
+	Title        string     `json:"title"`
+	Body         string     `json:"body"`
+	Status       string     `json:"status"`
+	HelpfulCount int32      `json:"helpfulCount"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	ModeratedAt  *time.Time `json:"moderatedAt"`
+}
+
+type GetAdminProductReviewsHandlerOutput struct {
+	Items      []adminProductReview `json:"items"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"pageSize"`
+	TotalItems int64                `json:"totalItems"`
+}
+
+type GetAdminProductReviewsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetAdminProductReviewsHandler(pgxPool *pgxpool.Pool) GetAdminProductReviewsHandler {
+	return GetAdminProductReviewsHandler{pgxPool}
+}
+
+func (g *GetAdminProductReviewsHandler) Handle(c echo.Context) error {
+	status := c.QueryParam("status")
+
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if status == "" {
+		status = "pending"
+	}
+
+	if status != "pending" && status != "approved" && status != "rejected" {
+		messages = append(messages, "status must be pending, approved or rejected")
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetAdminProductReviewsHandlerOutput{
+		Items:    []adminProductReview{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(), "SELECT COUNT(*) FROM product_reviews WHERE status = $1", status).
+		Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT id, product_id, customer_id, rating, title, body, status, helpful_count, created_at, moderated_at
+			FROM product_reviews
+			WHERE status = $1
+			ORDER BY created_at, id
+			LIMIT $2 OFFSET $3
+		`, status, pagination.PageSize, pagination.Offset()))
+
+	for rows.Next() {
+		var item adminProductReview
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.ProductId, &item.CustomerId, &item.Rating, &item.Title, &item.Body,
+			&item.Status, &item.HelpfulCount, &item.CreatedAt, &item.ModeratedAt))
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
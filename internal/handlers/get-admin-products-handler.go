@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type adminProductInventory struct {
+	InventoryId   uuid.UUID `json:"inventoryId"`
+	WarehouseId   uuid.UUID `json:"warehouseId"`
+	WarehouseName string    `json:"warehouseName"`
+	StockQuantity int32     `json:"stockQuantity"`
+	ReorderPoint  int32     `json:"reorderPoint"`
+}
+
+type adminProduct struct {
+	Id          uuid.UUID               `json:"id"`
+	Sku         *string                 `json:"sku"`
+	Slug        *string                 `json:"slug"`
+	Status      string                  `json:"status"`
+	Name        string                  `json:"name"`
+	Price       int64                   `json:"price"`
+	Currency    string                  `json:"currency"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	Inventories []adminProductInventory `json:"inventories"`
+}
+
+type GetAdminProductsHandlerOutput struct {
+	Items      []adminProduct `json:"items"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"pageSize"`
+	TotalItems int64          `json:"totalItems"`
+}
+
+type GetAdminProductsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetAdminProductsHandler(pgxPool *pgxpool.Pool) GetAdminProductsHandler {
+	return GetAdminProductsHandler{pgxPool}
+}
+
+// Handle lists every product, published or not, with its inventory in each warehouse.
+func (g *GetAdminProductsHandler) Handle(c echo.Context) error {
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetAdminProductsHandlerOutput{
+		Items:    []adminProduct{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(), "SELECT COUNT(*) FROM products").Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT id, sku, slug, status, name, price, currency, created_at
+			FROM products
+			ORDER BY created_at DESC, id
+			LIMIT $1 OFFSET $2
+		`, pagination.PageSize, pagination.Offset()))
+
+	productIds := []uuid.UUID{}
+	for rows.Next() {
+		var item adminProduct
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.Sku, &item.Slug, &item.Status, &item.Name, &item.Price, &item.Currency, &item.CreatedAt))
+		output.Items = append(output.Items, item)
+		productIds = append(productIds, item.Id)
+	}
+
+	inventories := findAdminProductInventories(g.pgxPool, productIds)
+
+	for i := range output.Items {
+		output.Items[i].Inventories = inventories[output.Items[i].Id]
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
+
+// findAdminProductInventories returns the inventories of each product, default warehouse first.
+func findAdminProductInventories(pgxPool *pgxpool.Pool, productIds []uuid.UUID) map[uuid.UUID][]adminProductInventory {
+	inventories := map[uuid.UUID][]adminProductInventory{}
+
+	for _, productId := range productIds {
+		inventories[productId] = []adminProductInventory{}
+	}
+
+	rows := utils.GetOrThrow(pgxPool.Query(context.Background(),
+		`
+			SELECT i.product_id, i.id, w.id, w.name, i.stock_quantity, i.reorder_point
+			FROM inventories i
+			JOIN warehouses w
+				ON w.id = i.warehouse_id
+			WHERE i.product_id = ANY($1)
+			ORDER BY w.is_default DESC, w.name, i.id
+		`, productIds))
+
+	for rows.Next() {
+		var productId uuid.UUID
+		var item adminProductInventory
+
+		utils.ThrowOnError(rows.Scan(&productId, &item.InventoryId, &item.WarehouseId, &item.WarehouseName, &item.StockQuantity,
+			&item.ReorderPoint))
+		inventories[productId] = append(inventories[productId], item)
+	}
+
+	return inventories
+}
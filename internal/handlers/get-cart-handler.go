@@ -1,13 +1,10 @@
 package handlers
 
 import (
-	"context"
-
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
-	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 )
@@ -21,21 +18,66 @@ type item struct {
 	Price       int64     `json:"price"`
 }
 
+type cartWarning struct {
+	ProductId         uuid.UUID `json:"productId"`
+	Type              string    `json:"type"`
+	Quantity          int32     `json:"quantity"`
+	PriceAtAdd        *int64    `json:"priceAtAdd,omitempty"`
+	Price             *int64    `json:"price,omitempty"`
+	AvailableQuantity *int32    `json:"availableQuantity,omitempty"`
+}
+
+type cartDiscount struct {
+	Code   string `json:"code"`
+	Type   string `json:"type"`
+	Amount int64  `json:"amount"`
+}
+
+type cartShipping struct {
+	ShippingMethodId uuid.UUID `json:"shippingMethodId"`
+	Name             string    `json:"name"`
+	Type             string    `json:"type"`
+}
+
+type cartTax struct {
+	ProductId       uuid.UUID `json:"productId"`
+	Jurisdiction    string    `json:"jurisdiction"`
+	TaxCategory     string    `json:"taxCategory"`
+	RateBasisPoints int32     `json:"rateBasisPoints"`
+	Amount          int64     `json:"amount"`
+}
+
 type GetCartHandlerOutput struct {
-	CartId        uuid.UUID `json:"cartId"`
-	TotalItems    int       `json:"totalItems"`
-	TotalQuantity int32     `json:"totalQuantity"`
-	TotalPrice    int64     `json:"totalPrice"`
-	Items         []item    `json:"items"`
+	CartId          uuid.UUID        `json:"cartId"`
+	Version         int32            `json:"version"`
+	Currency        string           `json:"currency"`
+	TotalItems      int              `json:"totalItems"`
+	TotalQuantity   int32            `json:"totalQuantity"`
+	SubtotalPrice   int64            `json:"subtotalPrice"`
+	CouponCode      *string          `json:"couponCode"`
+	Discounts       []cartDiscount   `json:"discounts"`
+	FreeShipping    bool             `json:"freeShipping"`
+	ShippingMethod  *cartShipping    `json:"shippingMethod"`
+	ShippingPrice   int64            `json:"shippingPrice"`
+	TaxState        *string          `json:"taxState"`
+	TaxPrice        int64            `json:"taxPrice"`
+	Taxes           []cartTax        `json:"taxes"`
+	TotalPrice      int64            `json:"totalPrice"`
+	Items           []item           `json:"items"`
+	Warnings        []cartWarning    `json:"warnings"`
+	RelatedProducts []relatedProduct `json:"relatedProducts"`
 }
 
 type GetCartHandler struct {
-	pgxPool *pgxpool.Pool
-	cartDAO daos.CartDAO
+	pgxPool        *pgxpool.Pool
+	cartDAO        daos.CartDAO
+	addressDAO     daos.AddressDAO
+	pricingService usecases.PricingService
 }
 
-func NewGetCartHandler(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO) GetCartHandler {
-	return GetCartHandler{pgxPool, cartDAO}
+func NewGetCartHandler(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, addressDAO daos.AddressDAO,
+	pricingService usecases.PricingService) GetCartHandler {
+	return GetCartHandler{pgxPool, cartDAO, addressDAO, pricingService}
 }
 
 func (g *GetCartHandler) Handle(c echo.Context) error {
@@ -48,62 +90,81 @@ func (g *GetCartHandler) Handle(c echo.Context) error {
 		return c.JSON(409, map[string]any{"message": "cart not found"})
 	}
 
-	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
-		`
-			SELECT
-				c.id AS cart_id,
-				ci.id AS cart_item_id,
-				ci.quantity AS cart_item_quantity,
-				p.id AS product_id,
-				p.name AS product_name,
-				p.description AS product_description,
-				p.price AS product_price
-			FROM carts c
-			JOIN cart_items ci
-				ON ci.cart_id = c.id
-			JOIN products p
-				ON ci.product_id = p.id
-			WHERE c.customer_id = $1
-		`, claims.Subject))
-
-	type schema struct {
-		CartId             uuid.UUID
-		CartItemId         uuid.UUID
-		ProductId          uuid.UUID
-		CartItemQuantity   int32
-		ProductName        string
-		ProductDescription *string
-		ProductPrice       int64
+	view := newCartView(g.pgxPool, usecases.FindCartLines(g.pgxPool, cartSchema.Id))
+
+	output := GetCartHandlerOutput{
+		Items:           view.Items,
+		Discounts:       []cartDiscount{},
+		Warnings:        []cartWarning{},
+		Taxes:           []cartTax{},
+		RelatedProducts: view.RelatedProducts,
+	}
+	output.CartId = cartSchema.Id
+	output.Version = cartSchema.Version
+	output.Currency = cartSchema.Currency
+	output.TotalItems = view.TotalItems
+	output.TotalQuantity = view.TotalQuantity
+
+	// Shipping and tax are estimated for the default address until the customer picks where the order ships to at
+	// checkout.
+	var destination *usecases.TaxDestination
+
+	if addressSchema := g.addressDAO.FindOneDefaultByCustomerId(cartSchema.CustomerId); addressSchema != nil {
+		destination = &usecases.TaxDestination{State: addressSchema.State, ZipCode: addressSchema.ZipCode}
+		output.TaxState = &addressSchema.State
 	}
 
-	records := []schema{}
-	for rows.Next() {
-		var item schema
+	pricing, err := g.pricingService.Price(g.pgxPool, cartSchema.CustomerId, cartSchema.Currency, cartSchema.PromotionId,
+		cartSchema.ShippingMethodId, destination, view.PricingLines)
+	if err != nil {
+		return err
+	}
 
-		utils.ThrowOnError(rows.Scan(&item.CartId, &item.CartItemId, &item.CartItemQuantity,
-			&item.ProductId, &item.ProductName, &item.ProductDescription, &item.ProductPrice))
+	output.SubtotalPrice = pricing.Subtotal
+	output.CouponCode = pricing.CouponCode
+	output.FreeShipping = pricing.FreeShipping
+	output.ShippingPrice = pricing.Shipping
+	output.TaxPrice = pricing.Tax
+	output.TotalPrice = pricing.Total
+
+	if pricing.ShippingMethod != nil {
+		output.ShippingMethod = &cartShipping{
+			ShippingMethodId: pricing.ShippingMethod.ShippingMethodId,
+			Name:             pricing.ShippingMethod.Name,
+			Type:             pricing.ShippingMethod.Type,
+		}
+	}
 
-		records = append(records, item)
+	for _, tax := range pricing.Taxes {
+		output.Taxes = append(output.Taxes, cartTax{
+			ProductId:       tax.ProductId,
+			Jurisdiction:    tax.Jurisdiction,
+			TaxCategory:     tax.TaxCategory,
+			RateBasisPoints: tax.RateBasisPoints,
+			Amount:          tax.Amount,
+		})
 	}
 
-	output := GetCartHandlerOutput{
-		Items: []item{},
+	for _, discount := range pricing.Discounts {
+		output.Discounts = append(output.Discounts, cartDiscount{
+			Code:   discount.Code,
+			Type:   discount.Type,
+			Amount: discount.Amount,
+		})
 	}
-	output.CartId = cartSchema.Id
-	output.TotalItems = len(records)
-
-	for _, record := range records {
-		output.TotalQuantity += record.CartItemQuantity
-		output.TotalPrice += record.ProductPrice * int64(record.CartItemQuantity)
-		output.Items = append(output.Items, item{
-			Id:          record.CartItemId,
-			ProductId:   record.ProductId,
-			Name:        record.ProductName,
-			Description: record.ProductDescription,
-			Quantity:    record.CartItemQuantity,
-			Price:       record.ProductPrice,
+
+	for _, warning := range usecases.FindCartWarnings(g.pgxPool, cartSchema.Id) {
+		output.Warnings = append(output.Warnings, cartWarning{
+			ProductId:         warning.ProductId,
+			Type:              warning.Type,
+			Quantity:          warning.Quantity,
+			PriceAtAdd:        warning.PriceAtAdd,
+			Price:             warning.Price,
+			AvailableQuantity: warning.AvailableQuantity,
 		})
 	}
 
+	c.Response().Header().Set("ETag", cartETag(cartSchema.Version))
+
 	return c.JSON(200, map[string]any{"data": output})
 }
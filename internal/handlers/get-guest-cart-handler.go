@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetGuestCartHandlerOutput struct {
+	Currency        string           `json:"currency"`
+	ExpiresAt       time.Time        `json:"expiresAt"`
+	TotalItems      int              `json:"totalItems"`
+	TotalQuantity   int32            `json:"totalQuantity"`
+	TotalPrice      int64            `json:"totalPrice"`
+	Items           []item           `json:"items"`
+	RelatedProducts []relatedProduct `json:"relatedProducts"`
+}
+
+type GetGuestCartHandler struct {
+	pgxPool      *pgxpool.Pool
+	guestCartDAO daos.GuestCartDAO
+}
+
+func NewGetGuestCartHandler(pgxPool *pgxpool.Pool, guestCartDAO daos.GuestCartDAO) GetGuestCartHandler {
+	return GetGuestCartHandler{pgxPool, guestCartDAO}
+}
+
+func (g *GetGuestCartHandler) Handle(c echo.Context) error {
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	guestCartSchema := g.guestCartDAO.FindOneById(uuid.MustParse(claims.Subject))
+
+	if guestCartSchema == nil || !guestCartSchema.ExpiresAt.After(time.Now().UTC()) {
+		return c.JSON(409, map[string]any{"message": "cart not found"})
+	}
+
+	view := newCartView(g.pgxPool, usecases.FindGuestCartLines(g.pgxPool, guestCartSchema.Id))
+
+	output := GetGuestCartHandlerOutput{
+		Currency:        guestCartSchema.Currency,
+		ExpiresAt:       guestCartSchema.ExpiresAt,
+		TotalItems:      view.TotalItems,
+		TotalQuantity:   view.TotalQuantity,
+		TotalPrice:      view.SubtotalPrice,
+		Items:           view.Items,
+		RelatedProducts: view.RelatedProducts,
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type GetGuestShippingRatesHandler struct {
+	quoteGuestShippingRatesUsecase usecases.QuoteGuestShippingRatesUsecase
+}
+
+func NewGetGuestShippingRatesHandler(quoteGuestShippingRatesUsecase usecases.QuoteGuestShippingRatesUsecase) GetGuestShippingRatesHandler {
+	return GetGuestShippingRatesHandler{quoteGuestShippingRatesUsecase}
+}
+
+func (g *GetGuestShippingRatesHandler) Handle(c echo.Context) error {
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	output, err := g.quoteGuestShippingRatesUsecase.Execute(usecases.QuoteGuestShippingRatesUsecaseInput{
+		GuestCartId: uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		rates := []shippingRate{}
+
+		for _, quote := range output.Quotes {
+			rates = append(rates, shippingRate{
+				ShippingMethodId: quote.ShippingMethodId,
+				Name:             quote.Name,
+				Type:             quote.Type,
+				Price:            quote.Price,
+				Currency:         output.Currency,
+			})
+		}
+
+		return c.JSON(200, map[string]any{"data": rates})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "guest checkout is required" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "address not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart is empty" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type inventoryMovement struct {
+	Id          uuid.UUID  `json:"id"`
+	InventoryId uuid.UUID  `json:"inventoryId"`
+	Reason      string     `json:"reason"`
+	Quantity    int32      `json:"quantity"`
+	Balance     int32      `json:"balance"`
+	ActorId     *uuid.UUID `json:"actorId"`
+	ReferenceId *uuid.UUID `json:"referenceId"`
+	Note        *string    `json:"note"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type GetInventoryMovementsHandlerOutput struct {
+	Items      []inventoryMovement `json:"items"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"pageSize"`
+	TotalItems int64               `json:"totalItems"`
+}
+
+type GetInventoryMovementsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetInventoryMovementsHandler(pgxPool *pgxpool.Pool) GetInventoryMovementsHandler {
+	return GetInventoryMovementsHandler{pgxPool}
+}
+
+func (g *GetInventoryMovementsHandler) Handle(c echo.Context) error {
+	productId := c.QueryParam("productId")
+
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if !utils.IsValidUUID(productId) {
+		messages = append(messages, "productId must be uuidv4")
+	}
+
+	// The range is inclusive on both ends and open when a bound is omitted.
+	var from, to *time.Time
+
+	if value := c.QueryParam("from"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			messages = append(messages, "from must follow format yyyy-mm-ddThh:mm:ssZ")
+		}
+
+		from = &parsed
+	}
+
+	if value := c.QueryParam("to"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			messages = append(messages, "to must follow format yyyy-mm-ddThh:mm:ssZ")
+		}
+
+		to = &parsed
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetInventoryMovementsHandlerOutput{
+		Items:    []inventoryMovement{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT COUNT(*)
+			FROM inventory_movements
+			WHERE product_id = $1
+				AND ($2::TIMESTAMPTZ IS NULL OR created_at >= $2)
+				AND ($3::TIMESTAMPTZ IS NULL OR created_at <= $3)
+		`, productId, from, to).Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT id, inventory_id, reason, quantity, balance, actor_id, reference_id, note, created_at
+			FROM inventory_movements
+			WHERE product_id = $1
+				AND ($2::TIMESTAMPTZ IS NULL OR created_at >= $2)
+				AND ($3::TIMESTAMPTZ IS NULL OR created_at <= $3)
+			ORDER BY created_at, id
+			LIMIT $4 OFFSET $5
+		`, productId, from, to, pagination.PageSize, pagination.Offset()))
+
+	for rows.Next() {
+		var item inventoryMovement
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.InventoryId, &item.Reason, &item.Quantity, &item.Balance, &item.ActorId,
+			&item.ReferenceId, &item.Note, &item.CreatedAt))
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type lowStockItem struct {
+	InventoryId   uuid.UUID `json:"inventoryId"`
+	ProductId     uuid.UUID `json:"productId"`
+	ProductName   string    `json:"productName"`
+	WarehouseId   uuid.UUID `json:"warehouseId"`
+	WarehouseName string    `json:"warehouseName"`
+	StockQuantity int32     `json:"stockQuantity"`
+	ReorderPoint  int32     `json:"reorderPoint"`
+}
+
+type GetLowStockItemsHandlerOutput struct {
+	Items      []lowStockItem `json:"items"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"pageSize"`
+	TotalItems int64          `json:"totalItems"`
+}
+
+type GetLowStockItemsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetLowStockItemsHandler(pgxPool *pgxpool.Pool) GetLowStockItemsHandler {
+	return GetLowStockItemsHandler{pgxPool}
+}
+
+// Handle lists the inventories that are currently at or below their reorder point, the emptiest first.
+func (g *GetLowStockItemsHandler) Handle(c echo.Context) error {
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetLowStockItemsHandlerOutput{
+		Items:    []lowStockItem{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(),
+		"SELECT COUNT(*) FROM inventories WHERE reorder_point > 0 AND stock_quantity <= reorder_point").Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT i.id, p.id, p.name, w.id, w.name, i.stock_quantity, i.reorder_point
+			FROM inventories i
+			JOIN products p
+				ON p.id = i.product_id
+			JOIN warehouses w
+				ON w.id = i.warehouse_id
+			WHERE i.reorder_point > 0 AND i.stock_quantity <= i.reorder_point
+			ORDER BY i.stock_quantity - i.reorder_point, p.name, i.id
+			LIMIT $1 OFFSET $2
+		`, pagination.PageSize, pagination.Offset()))
+
+	for rows.Next() {
+		var item lowStockItem
+
+		utils.ThrowOnError(rows.Scan(&item.InventoryId, &item.ProductId, &item.ProductName, &item.WarehouseId, &item.WarehouseName,
+			&item.StockQuantity, &item.ReorderPoint))
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
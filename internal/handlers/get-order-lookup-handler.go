@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type orderLookupItem struct {
+	ProductId           uuid.UUID `json:"productId"`
+	Name                string    `json:"name"`
+	Quantity            int32     `json:"quantity"`
+	Price               int64     `json:"price"`
+	BackorderedQuantity int32     `json:"backorderedQuantity"`
+	ExpectedShipDate    *string   `json:"expectedShipDate"`
+}
+
+type GetOrderLookupHandlerOutput struct {
+	Id            uuid.UUID         `json:"id"`
+	CustomerName  string            `json:"customerName"`
+	CustomerEmail string            `json:"customerEmail"`
+	Currency      string            `json:"currency"`
+	TotalPrice    int64             `json:"totalPrice"`
+	TotalQuantity int32             `json:"totalQuantity"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	Items         []orderLookupItem `json:"items"`
+}
+
+type GetOrderLookupHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetOrderLookupHandler(pgxPool *pgxpool.Pool) GetOrderLookupHandler {
+	return GetOrderLookupHandler{pgxPool}
+}
+
+func (g *GetOrderLookupHandler) Handle(c echo.Context) error {
+	token := c.Get("orderLookup").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtOrderLookupTokenClaims)
+
+	output := GetOrderLookupHandlerOutput{
+		Items: []orderLookupItem{},
+	}
+
+	err := g.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT o.id, c.name, c.email, o.currency, o.total_price, o.total_quantity, o.created_at
+			FROM orders o
+			JOIN customers c
+				ON c.id = o.customer_id
+			WHERE o.id = $1
+		`, claims.Subject).
+		Scan(&output.Id, &output.CustomerName, &output.CustomerEmail, &output.Currency, &output.TotalPrice, &output.TotalQuantity,
+			&output.CreatedAt)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return c.JSON(409, map[string]any{"message": "order not found"})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Bundle components are shipped under their bundle line, so only the lines the customer bought are listed.
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT oi.product_id, p.name, oi.quantity, oi.price, oi.backordered_quantity, oi.expected_ship_date
+			FROM order_items oi
+			JOIN products p
+				ON p.id = oi.product_id
+			WHERE oi.order_id = $1 AND oi.parent_order_item_id IS NULL
+			ORDER BY oi.created_at, oi.id
+		`, output.Id))
+
+	for rows.Next() {
+		var item orderLookupItem
+		var expectedShipDate *time.Time
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.Name, &item.Quantity, &item.Price, &item.BackorderedQuantity, &expectedShipDate))
+
+		if expectedShipDate != nil {
+			item.ExpectedShipDate = utils.NewPointer(expectedShipDate.Format(time.DateOnly))
+		}
+
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
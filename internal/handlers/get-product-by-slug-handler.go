@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetProductBySlugHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetProductBySlugHandler(pgxPool *pgxpool.Pool) GetProductBySlugHandler {
+	return GetProductBySlugHandler{pgxPool}
+}
+
+func (g *GetProductBySlugHandler) Handle(c echo.Context) error {
+	slug := c.Param("slug")
+
+	output, err := scanCatalogProduct(g.pgxPool.QueryRow(context.Background(), catalogProductQuery+" AND p.slug = $1", slug))
+
+	if err == nil {
+		return c.JSON(200, map[string]any{"data": newProductDetail(g.pgxPool, output)})
+	}
+
+	if err != pgx.ErrNoRows {
+		return err
+	}
+
+	var currentSlug string
+
+	err = g.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT p.slug
+			FROM product_slug_redirects r
+			JOIN products p
+				ON p.id = r.product_id
+			WHERE r.slug = $1 AND p.status = 'published' AND p.slug IS NOT NULL
+		`, slug).Scan(&currentSlug)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return c.JSON(409, map[string]any{"message": "product not found"})
+	}
+
+	utils.ThrowOnError(err)
+
+	location := "/v1/products/by-slug/" + currentSlug
+	c.Response().Header().Set("Location", location)
+
+	return c.JSON(301, map[string]any{
+		"data": map[string]any{
+			"slug":     currentSlug,
+			"location": location,
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type productPrice struct {
+	Currency string `json:"currency"`
+	Price    int64  `json:"price"`
+}
+
+type productDetail struct {
+	catalogProduct
+	Prices          []productPrice   `json:"prices"`
+	RelatedProducts []relatedProduct `json:"relatedProducts"`
+}
+
+func newProductDetail(pgxPool *pgxpool.Pool, product catalogProduct) productDetail {
+	rows := utils.GetOrThrow(pgxPool.Query(context.Background(),
+		"SELECT currency, price FROM product_prices WHERE product_id = $1 ORDER BY currency", product.Id))
+
+	prices := []productPrice{}
+	for rows.Next() {
+		var item productPrice
+
+		utils.ThrowOnError(rows.Scan(&item.Currency, &item.Price))
+		prices = append(prices, item)
+	}
+
+	return productDetail{product, prices, findRelatedProducts(pgxPool, []uuid.UUID{product.Id})}
+}
+
+type GetProductHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetProductHandler(pgxPool *pgxpool.Pool) GetProductHandler {
+	return GetProductHandler{pgxPool}
+}
+
+func (g *GetProductHandler) Handle(c echo.Context) error {
+	productId := c.Param("productId")
+
+	if !utils.IsValidUUID(productId) {
+		return c.JSON(400, map[string]any{"message": []string{"productId must be uuidv4"}})
+	}
+
+	output, err := scanCatalogProduct(g.pgxPool.QueryRow(context.Background(), catalogProductQuery+" AND p.id = $1", productId))
+
+	if err != nil && err == pgx.ErrNoRows {
+		return c.JSON(409, map[string]any{"message": "product not found"})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]any{"data": newProductDetail(g.pgxPool, output)})
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type productImportJobError struct {
+	Row     int32  `json:"row"`
+	Message string `json:"message"`
+}
+
+type GetProductImportJobHandlerOutput struct {
+	JobId         uuid.UUID               `json:"jobId"`
+	Status        string                  `json:"status"`
+	Format        string                  `json:"format"`
+	TotalRows     int32                   `json:"totalRows"`
+	ProcessedRows int32                   `json:"processedRows"`
+	FailedRows    int32                   `json:"failedRows"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	FinishedAt    *time.Time              `json:"finishedAt"`
+	Errors        []productImportJobError `json:"errors"`
+}
+
+type GetProductImportJobHandler struct {
+	productImportJobDAO daos.ProductImportJobDAO
+}
+
+func NewGetProductImportJobHandler(productImportJobDAO daos.ProductImportJobDAO) GetProductImportJobHandler {
+	return GetProductImportJobHandler{productImportJobDAO}
+}
+
+func (g *GetProductImportJobHandler) Handle(c echo.Context) error {
+	jobId := c.Param("jobId")
+
+	if !utils.IsValidUUID(jobId) {
+		return c.JSON(400, map[string]any{"message": []string{"jobId must be uuidv4"}})
+	}
+
+	productImportJobSchema := g.productImportJobDAO.FindOneById(uuid.MustParse(jobId))
+
+	if productImportJobSchema == nil {
+		return c.JSON(409, map[string]any{"message": "product import job not found"})
+	}
+
+	output := GetProductImportJobHandlerOutput{
+		JobId:         productImportJobSchema.Id,
+		Status:        productImportJobSchema.Status,
+		Format:        productImportJobSchema.Format,
+		TotalRows:     productImportJobSchema.TotalRows,
+		ProcessedRows: productImportJobSchema.ProcessedRows,
+		FailedRows:    productImportJobSchema.FailedRows,
+		CreatedAt:     productImportJobSchema.CreatedAt,
+		FinishedAt:    productImportJobSchema.FinishedAt,
+		Errors:        []productImportJobError{},
+	}
+
+	for _, productImportJobErrorSchema := range g.productImportJobDAO.FindAllErrorsByJobId(productImportJobSchema.Id) {
+		output.Errors = append(output.Errors, productImportJobError{
+			Row:     productImportJobErrorSchema.RowNumber,
+			Message: productImportJobErrorSchema.Message,
+		})
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
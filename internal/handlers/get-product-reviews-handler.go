@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type productReview struct {
+	Id               uuid.UUID `json:"id"`
+	CustomerName     string    `json:"customerName"`
+	Rating           int16     `json:"rating"`
+	Title            string    `json:"title"`
+	Body             string    `json:"body"`
+	HelpfulCount     int32     `json:"helpfulCount"`
+	VerifiedPurchase bool      `json:"verifiedPurchase"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type GetProductReviewsHandlerOutput struct {
+	Items      []productReview `json:"items"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"pageSize"`
+	TotalItems int64           `json:"totalItems"`
+}
+
+type GetProductReviewsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetProductReviewsHandler(pgxPool *pgxpool.Pool) GetProductReviewsHandler {
+	return GetProductReviewsHandler{pgxPool}
+}
+
+func (g *GetProductReviewsHandler) Handle(c echo.Context) error {
+	productId := c.QueryParam("productId")
+	sort := c.QueryParam("sort")
+
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if !utils.IsValidUUID(productId) {
+		messages = append(messages, "productId must be uuidv4")
+	}
+
+	if sort == "" {
+		sort = "newest"
+	}
+
+	orderBy := ""
+	switch sort {
+	case "newest":
+		orderBy = "pr.created_at DESC, pr.id"
+	case "most_helpful":
+		orderBy = "pr.helpful_count DESC, pr.created_at DESC, pr.id"
+	default:
+		messages = append(messages, "sort must be newest or most_helpful")
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetProductReviewsHandlerOutput{
+		Items:    []productReview{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(),
+		"SELECT COUNT(*) FROM product_reviews WHERE product_id = $1 AND status = 'approved'", productId).Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT
+				pr.id,
+				c.name AS customer_name,
+				pr.rating,
+				pr.title,
+				pr.body,
+				pr.helpful_count,
+				pr.created_at
+			FROM product_reviews pr
+			JOIN customers c
+				ON c.id = pr.customer_id
+			WHERE pr.product_id = $1 AND pr.status = 'approved'
+			ORDER BY `+orderBy+`
+			LIMIT $2 OFFSET $3
+		`, productId, pagination.PageSize, pagination.Offset()))
+
+	for rows.Next() {
+		item := productReview{VerifiedPurchase: true}
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.CustomerName, &item.Rating, &item.Title, &item.Body, &item.HelpfulCount, &item.CreatedAt))
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
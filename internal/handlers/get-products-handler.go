@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+const catalogProductQuery = `
+	SELECT
+		p.id,
+		p.slug,
+		p.name,
+		p.description,
+		p.price,
+		p.currency,
+		COALESCE(s.stock_quantity, 0) > 0 AS in_stock,
+		COALESCE(r.average_rating, 0) AS average_rating,
+		COALESCE(r.review_count, 0) AS review_count
+	FROM products p
+	LEFT JOIN product_available_stock s
+		ON s.product_id = p.id
+	LEFT JOIN (
+		SELECT product_id, ROUND(AVG(rating), 2)::FLOAT8 AS average_rating, COUNT(*) AS review_count
+		FROM product_reviews
+		WHERE status = 'approved'
+		GROUP BY product_id
+	) r
+		ON r.product_id = p.id
+	WHERE p.status = 'published'
+`
+
+type catalogProduct struct {
+	Id            uuid.UUID `json:"id"`
+	Slug          *string   `json:"slug"`
+	Name          string    `json:"name"`
+	Description   *string   `json:"description"`
+	Price         int64     `json:"price"`
+	Currency      string    `json:"currency"`
+	InStock       bool      `json:"inStock"`
+	AverageRating float64   `json:"averageRating"`
+	ReviewCount   int64     `json:"reviewCount"`
+}
+
+func scanCatalogProduct(row pgx.Row) (catalogProduct, error) {
+	var item catalogProduct
+
+	err := row.Scan(&item.Id, &item.Slug, &item.Name, &item.Description, &item.Price, &item.Currency, &item.InStock, &item.AverageRating, &item.ReviewCount)
+
+	return item, err
+}
+
+type GetProductsHandlerOutput struct {
+	Items      []catalogProduct `json:"items"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"pageSize"`
+	TotalItems int64            `json:"totalItems"`
+}
+
+type GetProductsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetProductsHandler(pgxPool *pgxpool.Pool) GetProductsHandler {
+	return GetProductsHandler{pgxPool}
+}
+
+func (g *GetProductsHandler) Handle(c echo.Context) error {
+	pagination, messages := webhttp.ParsePagination(c.QueryParam("page"), c.QueryParam("pageSize"))
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output := GetProductsHandlerOutput{
+		Items:    []catalogProduct{},
+		Page:     pagination.Page,
+		PageSize: pagination.PageSize,
+	}
+
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(), "SELECT COUNT(*) FROM products WHERE status = 'published'").
+		Scan(&output.TotalItems))
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(), catalogProductQuery+" ORDER BY p.created_at DESC, p.id LIMIT $1 OFFSET $2",
+		pagination.PageSize, pagination.Offset()))
+
+	for rows.Next() {
+		output.Items = append(output.Items, utils.GetOrThrow(scanCatalogProduct(rows)))
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type GetRestockSubscriptionHandlerOutput struct {
+	ProductId    uuid.UUID `json:"productId"`
+	ProductName  string    `json:"productName"`
+	Unsubscribed bool      `json:"unsubscribed"`
+}
+
+type GetRestockSubscriptionHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetRestockSubscriptionHandler(pgxPool *pgxpool.Pool) GetRestockSubscriptionHandler {
+	return GetRestockSubscriptionHandler{pgxPool}
+}
+
+// Handle serves the unsubscribe link for the customer to confirm it, as link scanners follow it too. The
+// subscription is only cancelled by a POST to the same link.
+func (g *GetRestockSubscriptionHandler) Handle(c echo.Context) error {
+	unsubscribeToken := c.QueryParam("token")
+
+	if unsubscribeToken == "" {
+		return c.JSON(400, map[string]any{"message": []string{"token is required"}})
+	}
+
+	var output GetRestockSubscriptionHandlerOutput
+
+	err := g.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT p.id, p.name, s.unsubscribed_at IS NOT NULL
+			FROM restock_subscriptions s
+			JOIN products p
+				ON p.id = s.product_id
+			WHERE s.unsubscribe_token = $1
+		`, unsubscribeToken).
+		Scan(&output.ProductId, &output.ProductName, &output.Unsubscribed)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return c.JSON(409, map[string]any{"message": "subscription not found"})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type shippingRate struct {
+	ShippingMethodId uuid.UUID `json:"shippingMethodId"`
+	Name             string    `json:"name"`
+	Type             string    `json:"type"`
+	Price            int64     `json:"price"`
+	Currency         string    `json:"currency"`
+}
+
+type GetShippingRatesHandler struct {
+	quoteShippingRatesUsecase usecases.QuoteShippingRatesUsecase
+}
+
+func NewGetShippingRatesHandler(quoteShippingRatesUsecase usecases.QuoteShippingRatesUsecase) GetShippingRatesHandler {
+	return GetShippingRatesHandler{quoteShippingRatesUsecase}
+}
+
+func (g *GetShippingRatesHandler) Handle(c echo.Context) error {
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	var addressId *uuid.UUID
+
+	if c.QueryParam("address_id") != "" {
+		parsedAddressId, err := uuid.Parse(c.QueryParam("address_id"))
+		if err != nil {
+			return c.JSON(409, map[string]any{"message": "address not found"})
+		}
+
+		addressId = &parsedAddressId
+	}
+
+	output, err := g.quoteShippingRatesUsecase.Execute(usecases.QuoteShippingRatesUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		AddressId:  addressId,
+	})
+	if err == nil {
+		rates := []shippingRate{}
+
+		for _, quote := range output.Quotes {
+			rates = append(rates, shippingRate{
+				ShippingMethodId: quote.ShippingMethodId,
+				Name:             quote.Name,
+				Type:             quote.Type,
+				Price:            quote.Price,
+				Currency:         output.Currency,
+			})
+		}
+
+		return c.JSON(200, map[string]any{"data": rates})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "address not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart is empty" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+// stockCountItem is a line of the variance review. Variance is the counted minus the expected quantity,
+// and is null for inventories that were not counted.
+type stockCountItem struct {
+	InventoryId      uuid.UUID `json:"inventoryId"`
+	ProductId        uuid.UUID `json:"productId"`
+	ProductName      string    `json:"productName"`
+	ExpectedQuantity int32     `json:"expectedQuantity"`
+	CountedQuantity  *int32    `json:"countedQuantity"`
+	Variance         *int32    `json:"variance"`
+}
+
+type GetStockCountHandlerOutput struct {
+	Id            uuid.UUID        `json:"id"`
+	WarehouseId   uuid.UUID        `json:"warehouseId"`
+	Status        string           `json:"status"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	SubmittedAt   *time.Time       `json:"submittedAt"`
+	AppliedAt     *time.Time       `json:"appliedAt"`
+	TotalVariance int32            `json:"totalVariance"`
+	Items         []stockCountItem `json:"items"`
+}
+
+type GetStockCountHandler struct {
+	pgxPool       *pgxpool.Pool
+	stockCountDAO daos.StockCountDAO
+}
+
+func NewGetStockCountHandler(pgxPool *pgxpool.Pool, stockCountDAO daos.StockCountDAO) GetStockCountHandler {
+	return GetStockCountHandler{pgxPool, stockCountDAO}
+}
+
+func (g *GetStockCountHandler) Handle(c echo.Context) error {
+	stockCountId := c.Param("stockCountId")
+
+	if !utils.IsValidUUID(stockCountId) {
+		return c.JSON(400, map[string]any{"message": []string{"stockCountId must be uuidv4"}})
+	}
+
+	stockCountSchema := g.stockCountDAO.FindOneById(uuid.MustParse(stockCountId))
+
+	if stockCountSchema == nil {
+		return c.JSON(409, map[string]any{"message": "stock count not found"})
+	}
+
+	output := GetStockCountHandlerOutput{
+		Id:          stockCountSchema.Id,
+		WarehouseId: stockCountSchema.WarehouseId,
+		Status:      stockCountSchema.Status,
+		CreatedAt:   stockCountSchema.CreatedAt,
+		SubmittedAt: stockCountSchema.SubmittedAt,
+		AppliedAt:   stockCountSchema.AppliedAt,
+		Items:       []stockCountItem{},
+	}
+
+	rows := utils.GetOrThrow(g.pgxPool.Query(context.Background(),
+		`
+			SELECT sci.inventory_id, p.id, p.name, sci.expected_quantity, sci.counted_quantity
+			FROM stock_count_items sci
+			JOIN inventories i
+				ON i.id = sci.inventory_id
+			JOIN products p
+				ON p.id = i.product_id
+			WHERE sci.stock_count_id = $1
+			ORDER BY p.name, sci.inventory_id
+		`, stockCountSchema.Id))
+
+	for rows.Next() {
+		var item stockCountItem
+
+		utils.ThrowOnError(rows.Scan(&item.InventoryId, &item.ProductId, &item.ProductName, &item.ExpectedQuantity, &item.CountedQuantity))
+
+		if item.CountedQuantity != nil {
+			variance := *item.CountedQuantity - item.ExpectedQuantity
+			item.Variance = &variance
+			output.TotalVariance += variance
+		}
+
+		output.Items = append(output.Items, item)
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+type wishlistItem struct {
+	ProductId    uuid.UUID `json:"productId"`
+	Name         string    `json:"name"`
+	Quantity     int32     `json:"quantity"`
+	Currency     string    `json:"currency"`
+	PriceAtAdd   *int64    `json:"priceAtAdd"`
+	Price        *int64    `json:"price"`
+	PriceDropped bool      `json:"priceDropped"`
+	StockStatus  string    `json:"stockStatus"`
+}
+
+type wishlist struct {
+	Id    uuid.UUID      `json:"id"`
+	Kind  string         `json:"kind"`
+	Name  string         `json:"name"`
+	Items []wishlistItem `json:"items"`
+}
+
+type GetWishlistsHandler struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewGetWishlistsHandler(pgxPool *pgxpool.Pool) GetWishlistsHandler {
+	return GetWishlistsHandler{pgxPool}
+}
+
+func (g *GetWishlistsHandler) Handle(c echo.Context) error {
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output := []wishlist{}
+
+	for _, w := range usecases.FindWishlists(g.pgxPool, uuid.MustParse(claims.Subject)) {
+		items := []wishlistItem{}
+
+		for _, item := range w.Items {
+			items = append(items, wishlistItem{
+				ProductId:    item.ProductId,
+				Name:         item.Name,
+				Quantity:     item.Quantity,
+				Currency:     item.Currency,
+				PriceAtAdd:   item.PriceAtAdd,
+				Price:        item.Price,
+				PriceDropped: item.PriceDropped,
+				StockStatus:  item.StockStatus,
+			})
+		}
+
+		output = append(output, wishlist{
+			Id:    w.Id,
+			Kind:  w.Kind,
+			Name:  w.Name,
+			Items: items,
+		})
+	}
+
+	return c.JSON(200, map[string]any{"data": output})
+}
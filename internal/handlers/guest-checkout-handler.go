@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type GuestCheckoutHandlerInput struct {
+	Name             any `validate:"required,string,notEmpty"`
+	Email            any `validate:"required,string,notEmpty"`
+	City             any `validate:"required,string,notEmpty"`
+	State            any `validate:"required,string,notEmpty"`
+	ZipCode          any `validate:"required,string,notEmpty"`
+	StreetName       any `validate:"required,string,notEmpty"`
+	StreetNumber     any `validate:"required,string,notEmpty"`
+	OrderLookupToken any `validate:"omitempty,string,notEmpty"`
+}
+
+type GuestCheckoutHandler struct {
+	jsonBodyValidator    webhttp.JSONBodyValidator
+	guestCheckoutUsecase usecases.GuestCheckoutUsecase
+}
+
+func NewGuestCheckoutHandler(jsonBodyValidator webhttp.JSONBodyValidator, guestCheckoutUsecase usecases.GuestCheckoutUsecase) GuestCheckoutHandler {
+	return GuestCheckoutHandler{jsonBodyValidator, guestCheckoutUsecase}
+}
+
+func (g *GuestCheckoutHandler) Handle(c echo.Context) error {
+	var input GuestCheckoutHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := g.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	var orderLookupToken *string
+	if input.OrderLookupToken != nil {
+		value := input.OrderLookupToken.(string)
+		orderLookupToken = &value
+	}
+
+	guestCheckoutUsecaseOutput, err := g.guestCheckoutUsecase.Execute(usecases.GuestCheckoutUsecaseInput{
+		GuestCartId:      uuid.MustParse(claims.Subject),
+		Name:             input.Name.(string),
+		Email:            input.Email.(string),
+		City:             input.City.(string),
+		State:            input.State.(string),
+		ZipCode:          input.ZipCode.(string),
+		StreetName:       input.StreetName.(string),
+		StreetNumber:     input.StreetNumber.(string),
+		OrderLookupToken: orderLookupToken,
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"customerId": guestCheckoutUsecaseOutput.CustomerId,
+				"addressId":  guestCheckoutUsecaseOutput.AddressId,
+			},
+		})
+	}
+
+	if err.Error() == "name must be at least 2 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "email address is invalid" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart is empty" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "this email address belongs to an account, log in to check out" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "this email address was used to check out before, check out from your order link" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "ZIP code does not match any location" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "ZIP code location does not match with provided city and state" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "state must be a valid 2-letter U.S. abbreviation (e.g. NY, CA)" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "ZIP code is invalid. It must be 5 digits (e.g. 12345)" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "street number must contain only digits (0-9)" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
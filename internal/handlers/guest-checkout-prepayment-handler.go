@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type GuestCheckoutPrepaymentHandler struct {
+	guestCheckoutPrepaymentUsecase usecases.GuestCheckoutPrepaymentUsecase
+}
+
+func NewGuestCheckoutPrepaymentHandler(guestCheckoutPrepaymentUsecase usecases.GuestCheckoutPrepaymentUsecase) GuestCheckoutPrepaymentHandler {
+	return GuestCheckoutPrepaymentHandler{guestCheckoutPrepaymentUsecase}
+}
+
+func (g *GuestCheckoutPrepaymentHandler) Handle(c echo.Context) error {
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	output, err := g.guestCheckoutPrepaymentUsecase.Execute(usecases.GuestCheckoutPrepaymentUsecaseInput{
+		GuestCartId: uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.JSON(200, map[string]any{
+			"data": map[string]any{
+				"preferenceId": output.PreferenceId,
+			},
+		})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "guest checkout is required" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "address not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart is empty" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart has changes that must be acknowledged" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method is required" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method is not available for the address" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "some products in the cart are not priced in the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
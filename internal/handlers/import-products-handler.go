@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"io"
+	"mime"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type ImportProductsHandler struct {
+	importProductsUsecase usecases.ImportProductsUsecase
+}
+
+func NewImportProductsHandler(importProductsUsecase usecases.ImportProductsUsecase) ImportProductsHandler {
+	return ImportProductsHandler{importProductsUsecase}
+}
+
+func (i *ImportProductsHandler) Handle(c echo.Context) error {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get("Content-Type"))
+
+	format := ""
+	switch mediaType {
+	case "text/csv":
+		format = "csv"
+	case "application/x-ndjson", "application/ndjson":
+		format = "ndjson"
+	default:
+		return c.NoContent(415)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(c.Request().Body, 10<<20))
+	if err != nil {
+		return err
+	}
+
+	output, err := i.importProductsUsecase.Execute(usecases.ImportProductsUsecaseInput{
+		Format:  format,
+		Content: content,
+	})
+	if err == nil {
+		return c.JSON(202, map[string]any{
+			"data": map[string]any{
+				"jobId": output.JobId,
+			},
+		})
+	}
+
+	if err.Error() == "import file has no rows" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "import file is not a valid csv" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "csv header must contain name and price columns" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
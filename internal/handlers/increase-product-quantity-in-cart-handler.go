@@ -64,5 +64,13 @@ func (r *IncreaseProductQuantityInCartHandler) Handle(c echo.Context) error {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
 
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	return err
 }
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+// InventoryLookupInput lets the stock endpoints address an inventory by its id, or by the product id or SKU
+// and an optional warehouse, which defaults to the default warehouse.
+type InventoryLookupInput struct {
+	InventoryId any `validate:"required_without_all=ProductId Sku WarehouseId,omitempty,uuid4"`
+	ProductId   any `validate:"omitempty,uuid4"`
+	Sku         any `validate:"omitempty,string,notEmpty"`
+	WarehouseId any `validate:"omitempty,uuid4"`
+}
+
+// Validate checks the combination of fields, which the validator tags cannot express. It expects the
+// tags to have been validated already.
+func (i InventoryLookupInput) Validate() []string {
+	if i.InventoryId == nil && i.ProductId == nil && i.Sku == nil {
+		return []string{"productId or sku is required"}
+	}
+
+	given := 0
+	for _, field := range []any{i.InventoryId, i.ProductId, i.Sku} {
+		if field != nil {
+			given++
+		}
+	}
+
+	if given > 1 {
+		return []string{"only one of inventoryId, productId or sku can be given"}
+	}
+
+	if i.InventoryId != nil && i.WarehouseId != nil {
+		return []string{"warehouseId cannot be given with inventoryId"}
+	}
+
+	return []string{}
+}
+
+func (i InventoryLookupInput) ToInventoryLookup() usecases.InventoryLookup {
+	var inventoryLookup usecases.InventoryLookup
+
+	if i.InventoryId != nil {
+		inventoryLookup.InventoryId = utils.NewPointer(uuid.MustParse(i.InventoryId.(string)))
+	}
+
+	if i.ProductId != nil {
+		inventoryLookup.ProductId = utils.NewPointer(uuid.MustParse(i.ProductId.(string)))
+	}
+
+	if i.Sku != nil {
+		inventoryLookup.Sku = utils.NewPointer(i.Sku.(string))
+	}
+
+	if i.WarehouseId != nil {
+		inventoryLookup.WarehouseId = utils.NewPointer(uuid.MustParse(i.WarehouseId.(string)))
+	}
+
+	return inventoryLookup
+}
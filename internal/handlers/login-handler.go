@@ -7,8 +7,9 @@ import (
 )
 
 type LoginHandlerInput struct {
-	Email    any `validate:"required,string,notEmpty"`
-	Password any `validate:"required,string,notEmpty"`
+	Email     any `validate:"required,string,notEmpty"`
+	Password  any `validate:"required,string,notEmpty"`
+	CartToken any `validate:"omitempty,string,notEmpty"`
 }
 
 type LoginHandler struct {
@@ -31,9 +32,16 @@ func (l *LoginHandler) Handle(c echo.Context) error {
 		return c.JSON(400, map[string]any{"message": messages})
 	}
 
+	var cartToken *string
+	if input.CartToken != nil {
+		value := input.CartToken.(string)
+		cartToken = &value
+	}
+
 	loginUsecaseOutput, err := l.LoginUsecase.Execute(usecases.LoginUsecaseInput{
-		Email:    input.Email.(string),
-		Password: input.Password.(string),
+		Email:     input.Email.(string),
+		Password:  input.Password.(string),
+		CartToken: cartToken,
 	})
 	if err == nil {
 		return c.JSON(200, map[string]any{
@@ -52,5 +60,9 @@ func (l *LoginHandler) Handle(c echo.Context) error {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
 
+	if err.Error() == "cart token is invalid" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	return err
 }
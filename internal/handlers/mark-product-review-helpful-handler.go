@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type MarkProductReviewHelpfulHandlerInput struct {
+	ProductReviewId any `validate:"required,uuid4"`
+}
+
+type MarkProductReviewHelpfulHandler struct {
+	jsonBodyValidator               webhttp.JSONBodyValidator
+	markProductReviewHelpfulUsecase usecases.MarkProductReviewHelpfulUsecase
+}
+
+func NewMarkProductReviewHelpfulHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	markProductReviewHelpfulUsecase usecases.MarkProductReviewHelpfulUsecase) MarkProductReviewHelpfulHandler {
+	return MarkProductReviewHelpfulHandler{jsonBodyValidator, markProductReviewHelpfulUsecase}
+}
+
+func (m *MarkProductReviewHelpfulHandler) Handle(c echo.Context) error {
+	var input MarkProductReviewHelpfulHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := m.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := m.markProductReviewHelpfulUsecase.Execute(usecases.MarkProductReviewHelpfulUsecaseInput{
+		CustomerId:      uuid.MustParse(claims.Subject),
+		ProductReviewId: uuid.MustParse(input.ProductReviewId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "product review not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "you cannot mark your own review as helpful" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "you have already marked this review as helpful" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
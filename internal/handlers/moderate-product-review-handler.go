@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ModerateProductReviewHandlerInput struct {
+	ProductReviewId any `validate:"required,uuid4"`
+	Status          any `validate:"required,string,notEmpty"`
+}
+
+type ModerateProductReviewHandler struct {
+	jsonBodyValidator            webhttp.JSONBodyValidator
+	moderateProductReviewUsecase usecases.ModerateProductReviewUsecase
+}
+
+func NewModerateProductReviewHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	moderateProductReviewUsecase usecases.ModerateProductReviewUsecase) ModerateProductReviewHandler {
+	return ModerateProductReviewHandler{jsonBodyValidator, moderateProductReviewUsecase}
+}
+
+func (m *ModerateProductReviewHandler) Handle(c echo.Context) error {
+	var input ModerateProductReviewHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := m.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := m.moderateProductReviewUsecase.Execute(usecases.ModerateProductReviewUsecaseInput{
+		ProductReviewId: uuid.MustParse(input.ProductReviewId.(string)),
+		Status:          input.Status.(string),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "status must be approved or rejected" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product review not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
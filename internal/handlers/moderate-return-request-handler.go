@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ModerateReturnRequestHandlerInput struct {
+	ReturnRequestId any `validate:"required,uuid4"`
+	Status          any `validate:"required,string,notEmpty"`
+	Note            any `validate:"omitempty,string"`
+}
+
+type ModerateReturnRequestHandler struct {
+	jsonBodyValidator            webhttp.JSONBodyValidator
+	moderateReturnRequestUsecase usecases.ModerateReturnRequestUsecase
+}
+
+func NewModerateReturnRequestHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	moderateReturnRequestUsecase usecases.ModerateReturnRequestUsecase) ModerateReturnRequestHandler {
+	return ModerateReturnRequestHandler{jsonBodyValidator, moderateReturnRequestUsecase}
+}
+
+func (m *ModerateReturnRequestHandler) Handle(c echo.Context) error {
+	var input ModerateReturnRequestHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := m.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	var note *string
+
+	if input.Note != nil {
+		value := input.Note.(string)
+		note = &value
+	}
+
+	err := m.moderateReturnRequestUsecase.Execute(usecases.ModerateReturnRequestUsecaseInput{
+		ReturnRequestId: uuid.MustParse(input.ReturnRequestId.(string)),
+		Status:          input.Status.(string),
+		Note:            note,
+		ActorId:         uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "status must be approved or rejected" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "return request not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "return request was already reviewed" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
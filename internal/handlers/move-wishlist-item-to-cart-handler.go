@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type MoveWishlistItemToCartHandlerInput struct {
+	WishlistId any `validate:"required,uuid4"`
+	ProductId  any `validate:"required,uuid4"`
+}
+
+type MoveWishlistItemToCartHandler struct {
+	jsonBodyValidator             webhttp.JSONBodyValidator
+	moveWishlistItemToCartUsecase usecases.MoveWishlistItemToCartUsecase
+}
+
+func NewMoveWishlistItemToCartHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	moveWishlistItemToCartUsecase usecases.MoveWishlistItemToCartUsecase) MoveWishlistItemToCartHandler {
+	return MoveWishlistItemToCartHandler{jsonBodyValidator, moveWishlistItemToCartUsecase}
+}
+
+func (m *MoveWishlistItemToCartHandler) Handle(c echo.Context) error {
+	var input MoveWishlistItemToCartHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := m.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := m.moveWishlistItemToCartUsecase.Execute(usecases.MoveWishlistItemToCartUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		WishlistId: uuid.MustParse(input.WishlistId.(string)),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "wishlist not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found in wishlist" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the stock available" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product is not priced in the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
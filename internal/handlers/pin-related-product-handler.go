@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type PinRelatedProductHandlerInput struct {
+	ProductId        any `validate:"required,uuid4"`
+	RelatedProductId any `validate:"required,uuid4"`
+	Position         any `validate:"required,integer,positive"`
+}
+
+type PinRelatedProductHandler struct {
+	jsonBodyValidator        webhttp.JSONBodyValidator
+	pinRelatedProductUsecase usecases.PinRelatedProductUsecase
+}
+
+func NewPinRelatedProductHandler(jsonBodyValidator webhttp.JSONBodyValidator, pinRelatedProductUsecase usecases.PinRelatedProductUsecase) PinRelatedProductHandler {
+	return PinRelatedProductHandler{jsonBodyValidator, pinRelatedProductUsecase}
+}
+
+func (p *PinRelatedProductHandler) Handle(c echo.Context) error {
+	var input PinRelatedProductHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := p.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := p.pinRelatedProductUsecase.Execute(usecases.PinRelatedProductUsecaseInput{
+		ProductId:        uuid.MustParse(input.ProductId.(string)),
+		RelatedProductId: uuid.MustParse(input.RelatedProductId.(string)),
+		Position:         int32(input.Position.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "position must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "a product cannot be related to itself" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "related product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
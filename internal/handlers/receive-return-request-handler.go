@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type ReceiveReturnRequestHandlerInput struct {
+	ReturnRequestId any `validate:"required,uuid4"`
+	Restock         any `validate:"required,boolean"`
+}
+
+type ReceiveReturnRequestHandler struct {
+	jsonBodyValidator           webhttp.JSONBodyValidator
+	receiveReturnRequestUsecase usecases.ReceiveReturnRequestUsecase
+}
+
+func NewReceiveReturnRequestHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	receiveReturnRequestUsecase usecases.ReceiveReturnRequestUsecase) ReceiveReturnRequestHandler {
+	return ReceiveReturnRequestHandler{jsonBodyValidator, receiveReturnRequestUsecase}
+}
+
+func (r *ReceiveReturnRequestHandler) Handle(c echo.Context) error {
+	var input ReceiveReturnRequestHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := r.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := r.receiveReturnRequestUsecase.Execute(usecases.ReceiveReturnRequestUsecaseInput{
+		ReturnRequestId: uuid.MustParse(input.ReturnRequestId.(string)),
+		Restock:         input.Restock.(bool),
+		ActorId:         uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.JSON(200, map[string]any{
+			"data": map[string]any{
+				"refundedAmount": output.RefundedAmount,
+			},
+		})
+	}
+
+	if err.Error() == "return request not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "return request is not approved" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const relatedProductsLimit = 4
+
+type relatedProduct struct {
+	Id       uuid.UUID `json:"id"`
+	Slug     *string   `json:"slug"`
+	Name     string    `json:"name"`
+	Price    int64     `json:"price"`
+	Currency string    `json:"currency"`
+}
+
+// findRelatedProducts returns the products related to any of the given ones, pinned first by
+// position and then computed by score. The given products themselves, unpublished and
+// out-of-stock products are left out.
+func findRelatedProducts(pgxPool *pgxpool.Pool, productIds []uuid.UUID) []relatedProduct {
+	rows := utils.GetOrThrow(pgxPool.Query(context.Background(),
+		`
+			SELECT p.id, p.slug, p.name, p.price, p.currency
+			FROM (
+				SELECT related_product_id, 0 AS source, position, 0 AS score
+				FROM product_recommendation_pins
+				WHERE product_id = ANY($1)
+				UNION ALL
+				SELECT related_product_id, 1 AS source, NULL AS position, score
+				FROM product_recommendations
+				WHERE product_id = ANY($1)
+			) r
+			JOIN products p
+				ON p.id = r.related_product_id
+			WHERE p.status = 'published'
+				AND p.id <> ALL($1)
+				AND (SELECT COALESCE(SUM(a.stock_quantity), 0) FROM product_available_stock a WHERE a.product_id = p.id) > 0
+			GROUP BY p.id, p.slug, p.name, p.price, p.currency
+			ORDER BY MIN(r.source), MIN(r.position), SUM(r.score) DESC, p.id
+			LIMIT $2
+		`, productIds, relatedProductsLimit))
+
+	relatedProducts := []relatedProduct{}
+	for rows.Next() {
+		var item relatedProduct
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.Slug, &item.Name, &item.Price, &item.Currency))
+		relatedProducts = append(relatedProducts, item)
+	}
+
+	return relatedProducts
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveCouponHandler struct {
+	removeCouponUsecase usecases.RemoveCouponUsecase
+}
+
+func NewRemoveCouponHandler(removeCouponUsecase usecases.RemoveCouponUsecase) RemoveCouponHandler {
+	return RemoveCouponHandler{removeCouponUsecase}
+}
+
+func (r *RemoveCouponHandler) Handle(c echo.Context) error {
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := r.removeCouponUsecase.Execute(usecases.RemoveCouponUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart has no coupon" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
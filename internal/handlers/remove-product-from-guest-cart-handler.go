@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveProductFromGuestCartHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+}
+
+type RemoveProductFromGuestCartHandler struct {
+	jsonBodyValidator                 webhttp.JSONBodyValidator
+	removeProductFromGuestCartUsecase usecases.RemoveProductFromGuestCartUsecase
+}
+
+func NewRemoveProductFromGuestCartHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	removeProductFromGuestCartUsecase usecases.RemoveProductFromGuestCartUsecase) RemoveProductFromGuestCartHandler {
+	return RemoveProductFromGuestCartHandler{jsonBodyValidator, removeProductFromGuestCartUsecase}
+}
+
+func (r *RemoveProductFromGuestCartHandler) Handle(c echo.Context) error {
+	var input RemoveProductFromGuestCartHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := r.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("guestCart").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtGuestCartTokenClaims)
+
+	err := r.removeProductFromGuestCartUsecase.Execute(usecases.RemoveProductFromGuestCartUsecaseInput{
+		GuestCartId: uuid.MustParse(claims.Subject),
+		ProductId:   uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found in cart" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RemoveProductFromWishlistHandlerInput struct {
+	WishlistId any `validate:"required,uuid4"`
+	ProductId  any `validate:"required,uuid4"`
+}
+
+type RemoveProductFromWishlistHandler struct {
+	jsonBodyValidator                webhttp.JSONBodyValidator
+	removeProductFromWishlistUsecase usecases.RemoveProductFromWishlistUsecase
+}
+
+func NewRemoveProductFromWishlistHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	removeProductFromWishlistUsecase usecases.RemoveProductFromWishlistUsecase) RemoveProductFromWishlistHandler {
+	return RemoveProductFromWishlistHandler{jsonBodyValidator, removeProductFromWishlistUsecase}
+}
+
+func (r *RemoveProductFromWishlistHandler) Handle(c echo.Context) error {
+	var input RemoveProductFromWishlistHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := r.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := r.removeProductFromWishlistUsecase.Execute(usecases.RemoveProductFromWishlistUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		WishlistId: uuid.MustParse(input.WishlistId.(string)),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "wishlist not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found in wishlist" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RenameProductHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Name      any `validate:"required,string,notEmpty"`
+}
+
+type RenameProductHandler struct {
+	jsonBodyValidator    webhttp.JSONBodyValidator
+	renameProductUsecase usecases.RenameProductUsecase
+}
+
+func NewRenameProductHandler(jsonBodyValidator webhttp.JSONBodyValidator, renameProductUsecase usecases.RenameProductUsecase) RenameProductHandler {
+	return RenameProductHandler{jsonBodyValidator, renameProductUsecase}
+}
+
+func (r *RenameProductHandler) Handle(c echo.Context) error {
+	var input RenameProductHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := r.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	output, err := r.renameProductUsecase.Execute(usecases.RenameProductUsecaseInput{
+		ProductId: uuid.MustParse(input.ProductId.(string)),
+		Name:      input.Name.(string),
+	})
+	if err == nil {
+		return c.JSON(200, map[string]any{
+			"data": map[string]any{
+				"slug": output.Slug,
+			},
+		})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "the product name cannot exceed 50 characters" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type RequestReturnHandlerInput struct {
+	OrderItemId any `validate:"required,uuid4"`
+	Quantity    any `validate:"required,integer,positive"`
+	ReasonCode  any `validate:"required,string,notEmpty"`
+	Comment     any `validate:"omitempty,string"`
+}
+
+type RequestReturnHandler struct {
+	jsonBodyValidator    webhttp.JSONBodyValidator
+	requestReturnUsecase usecases.RequestReturnUsecase
+}
+
+func NewRequestReturnHandler(jsonBodyValidator webhttp.JSONBodyValidator, requestReturnUsecase usecases.RequestReturnUsecase) RequestReturnHandler {
+	return RequestReturnHandler{jsonBodyValidator, requestReturnUsecase}
+}
+
+func (r *RequestReturnHandler) Handle(c echo.Context) error {
+	var input RequestReturnHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := r.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	var comment *string
+
+	if input.Comment != nil {
+		value := input.Comment.(string)
+		comment = &value
+	}
+
+	output, err := r.requestReturnUsecase.Execute(usecases.RequestReturnUsecaseInput{
+		CustomerId:  uuid.MustParse(claims.Subject),
+		OrderItemId: uuid.MustParse(input.OrderItemId.(string)),
+		Quantity:    int32(input.Quantity.(float64)),
+		ReasonCode:  input.ReasonCode.(string),
+		Comment:     comment,
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"returnRequestId": output.ReturnRequestId,
+			},
+		})
+	}
+
+	if err.Error() == "quantity must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "reason code is not valid" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order item not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order item is not returnable" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "order has not been fulfilled" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "return window has expired" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "return quantity exceeds the quantity shipped" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
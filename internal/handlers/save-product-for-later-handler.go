@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SaveProductForLaterHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+}
+
+type SaveProductForLaterHandler struct {
+	jsonBodyValidator          webhttp.JSONBodyValidator
+	saveProductForLaterUsecase usecases.SaveProductForLaterUsecase
+}
+
+func NewSaveProductForLaterHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	saveProductForLaterUsecase usecases.SaveProductForLaterUsecase) SaveProductForLaterHandler {
+	return SaveProductForLaterHandler{jsonBodyValidator, saveProductForLaterUsecase}
+}
+
+func (s *SaveProductForLaterHandler) Handle(c echo.Context) error {
+	var input SaveProductForLaterHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := s.saveProductForLaterUsecase.Execute(usecases.SaveProductForLaterUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "product not found in cart" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
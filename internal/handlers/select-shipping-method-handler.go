@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SelectShippingMethodHandlerInput struct {
+	ShippingMethodId any `validate:"required,uuid4"`
+}
+
+type SelectShippingMethodHandler struct {
+	jsonBodyValidator           webhttp.JSONBodyValidator
+	selectShippingMethodUsecase usecases.SelectShippingMethodUsecase
+}
+
+func NewSelectShippingMethodHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	selectShippingMethodUsecase usecases.SelectShippingMethodUsecase) SelectShippingMethodHandler {
+	return SelectShippingMethodHandler{jsonBodyValidator, selectShippingMethodUsecase}
+}
+
+func (s *SelectShippingMethodHandler) Handle(c echo.Context) error {
+	var input SelectShippingMethodHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := s.selectShippingMethodUsecase.Execute(usecases.SelectShippingMethodUsecaseInput{
+		CustomerId:       uuid.MustParse(claims.Subject),
+		ShippingMethodId: uuid.MustParse(input.ShippingMethodId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "shipping method does not apply to the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
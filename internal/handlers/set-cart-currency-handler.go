@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetCartCurrencyHandlerInput struct {
+	Currency any `validate:"required,string,notEmpty"`
+}
+
+type SetCartCurrencyHandler struct {
+	jsonBodyValidator      webhttp.JSONBodyValidator
+	setCartCurrencyUsecase usecases.SetCartCurrencyUsecase
+}
+
+func NewSetCartCurrencyHandler(jsonBodyValidator webhttp.JSONBodyValidator, setCartCurrencyUsecase usecases.SetCartCurrencyUsecase) SetCartCurrencyHandler {
+	return SetCartCurrencyHandler{jsonBodyValidator, setCartCurrencyUsecase}
+}
+
+func (s *SetCartCurrencyHandler) Handle(c echo.Context) error {
+	var input SetCartCurrencyHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := s.setCartCurrencyUsecase.Execute(usecases.SetCartCurrencyUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		Currency:   strings.ToUpper(input.Currency.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "some products in the cart are not priced in this currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
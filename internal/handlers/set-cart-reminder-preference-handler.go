@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetCartReminderPreferenceHandlerInput struct {
+	OptedOut any `validate:"required,boolean"`
+}
+
+type SetCartReminderPreferenceHandler struct {
+	jsonBodyValidator                webhttp.JSONBodyValidator
+	setCartReminderPreferenceUsecase usecases.SetCartReminderPreferenceUsecase
+}
+
+func NewSetCartReminderPreferenceHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setCartReminderPreferenceUsecase usecases.SetCartReminderPreferenceUsecase) SetCartReminderPreferenceHandler {
+	return SetCartReminderPreferenceHandler{jsonBodyValidator, setCartReminderPreferenceUsecase}
+}
+
+func (s *SetCartReminderPreferenceHandler) Handle(c echo.Context) error {
+	var input SetCartReminderPreferenceHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := s.setCartReminderPreferenceUsecase.Execute(usecases.SetCartReminderPreferenceUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		OptedOut:   input.OptedOut.(bool),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	return err
+}
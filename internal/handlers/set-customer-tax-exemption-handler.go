@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetCustomerTaxExemptionHandlerInput struct {
+	CustomerId any `validate:"required,uuid4"`
+	TaxExempt  any `validate:"required,boolean"`
+}
+
+type SetCustomerTaxExemptionHandler struct {
+	jsonBodyValidator              webhttp.JSONBodyValidator
+	setCustomerTaxExemptionUsecase usecases.SetCustomerTaxExemptionUsecase
+}
+
+func NewSetCustomerTaxExemptionHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setCustomerTaxExemptionUsecase usecases.SetCustomerTaxExemptionUsecase) SetCustomerTaxExemptionHandler {
+	return SetCustomerTaxExemptionHandler{jsonBodyValidator, setCustomerTaxExemptionUsecase}
+}
+
+func (s *SetCustomerTaxExemptionHandler) Handle(c echo.Context) error {
+	var input SetCustomerTaxExemptionHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setCustomerTaxExemptionUsecase.Execute(usecases.SetCustomerTaxExemptionUsecaseInput{
+		CustomerId: uuid.MustParse(input.CustomerId.(string)),
+		TaxExempt:  input.TaxExempt.(bool),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "customer not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
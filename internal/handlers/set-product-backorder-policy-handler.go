@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductBackorderPolicyHandlerInput struct {
+	ProductId        any `validate:"required,uuid4"`
+	AllowsBackorder  any `validate:"required,boolean"`
+	AllowsPreorder   any `validate:"required,boolean"`
+	BackorderLimit   any `validate:"required,integer,positive"`
+	ExpectedShipDate any `validate:"omitempty,date"`
+}
+
+type SetProductBackorderPolicyHandler struct {
+	jsonBodyValidator                webhttp.JSONBodyValidator
+	setProductBackorderPolicyUsecase usecases.SetProductBackorderPolicyUsecase
+}
+
+func NewSetProductBackorderPolicyHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setProductBackorderPolicyUsecase usecases.SetProductBackorderPolicyUsecase) SetProductBackorderPolicyHandler {
+	return SetProductBackorderPolicyHandler{jsonBodyValidator, setProductBackorderPolicyUsecase}
+}
+
+func (s *SetProductBackorderPolicyHandler) Handle(c echo.Context) error {
+	var input SetProductBackorderPolicyHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	var expectedShipDate *time.Time
+
+	if input.ExpectedShipDate != nil {
+		expectedShipDate = utils.NewPointer(utils.GetOrThrow(time.Parse(time.DateOnly, input.ExpectedShipDate.(string))))
+	}
+
+	err := s.setProductBackorderPolicyUsecase.Execute(usecases.SetProductBackorderPolicyUsecaseInput{
+		ProductId:        uuid.MustParse(input.ProductId.(string)),
+		AllowsBackorder:  input.AllowsBackorder.(bool),
+		AllowsPreorder:   input.AllowsPreorder.(bool),
+		BackorderLimit:   int32(input.BackorderLimit.(float64)),
+		ExpectedShipDate: expectedShipDate,
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "product cannot allow both backorders and pre-orders" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "backorder limit cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "backorder limit must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "pre-orders require an expected ship date" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "bundles cannot be sold beyond their component stock" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
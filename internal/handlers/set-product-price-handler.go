@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductPriceHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Currency  any `validate:"required,string,notEmpty"`
+	Price     any `validate:"required,integer,positive"`
+}
+
+type SetProductPriceHandler struct {
+	jsonBodyValidator      webhttp.JSONBodyValidator
+	setProductPriceUsecase usecases.SetProductPriceUsecase
+}
+
+func NewSetProductPriceHandler(jsonBodyValidator webhttp.JSONBodyValidator, setProductPriceUsecase usecases.SetProductPriceUsecase) SetProductPriceHandler {
+	return SetProductPriceHandler{jsonBodyValidator, setProductPriceUsecase}
+}
+
+func (s *SetProductPriceHandler) Handle(c echo.Context) error {
+	var input SetProductPriceHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setProductPriceUsecase.Execute(usecases.SetProductPriceUsecaseInput{
+		ProductId: uuid.MustParse(input.ProductId.(string)),
+		Currency:  strings.ToUpper(input.Currency.(string)),
+		Price:     int64(input.Price.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "currency is not supported" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "the product price cannot be zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductPurchaseLimitsHandlerInput struct {
+	ProductId      any `validate:"required,uuid4"`
+	MaxPerOrder    any `validate:"omitempty,integer"`
+	MaxPerCustomer any `validate:"omitempty,integer"`
+	PeriodDays     any `validate:"omitempty,integer"`
+}
+
+type SetProductPurchaseLimitsHandler struct {
+	jsonBodyValidator               webhttp.JSONBodyValidator
+	setProductPurchaseLimitsUsecase usecases.SetProductPurchaseLimitsUsecase
+}
+
+func NewSetProductPurchaseLimitsHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setProductPurchaseLimitsUsecase usecases.SetProductPurchaseLimitsUsecase) SetProductPurchaseLimitsHandler {
+	return SetProductPurchaseLimitsHandler{jsonBodyValidator, setProductPurchaseLimitsUsecase}
+}
+
+func (s *SetProductPurchaseLimitsHandler) Handle(c echo.Context) error {
+	var input SetProductPurchaseLimitsHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	var maxPerOrder, maxPerCustomer, periodDays *int32
+
+	if input.MaxPerOrder != nil {
+		maxPerOrder = utils.NewPointer(int32(input.MaxPerOrder.(float64)))
+	}
+
+	if input.MaxPerCustomer != nil {
+		maxPerCustomer = utils.NewPointer(int32(input.MaxPerCustomer.(float64)))
+	}
+
+	if input.PeriodDays != nil {
+		periodDays = utils.NewPointer(int32(input.PeriodDays.(float64)))
+	}
+
+	err := s.setProductPurchaseLimitsUsecase.Execute(usecases.SetProductPurchaseLimitsUsecaseInput{
+		ProductId:      uuid.MustParse(input.ProductId.(string)),
+		MaxPerOrder:    maxPerOrder,
+		MaxPerCustomer: maxPerCustomer,
+		PeriodDays:     periodDays,
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "maximum per order must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "maximum per customer must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "maximum per customer and period days must be set together" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "period days must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
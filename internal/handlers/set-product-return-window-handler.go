@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductReturnWindowHandlerInput struct {
+	ProductId        any `validate:"required,uuid4"`
+	ReturnWindowDays any `validate:"required,integer"`
+}
+
+type SetProductReturnWindowHandler struct {
+	jsonBodyValidator             webhttp.JSONBodyValidator
+	setProductReturnWindowUsecase usecases.SetProductReturnWindowUsecase
+}
+
+func NewSetProductReturnWindowHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setProductReturnWindowUsecase usecases.SetProductReturnWindowUsecase) SetProductReturnWindowHandler {
+	return SetProductReturnWindowHandler{jsonBodyValidator, setProductReturnWindowUsecase}
+}
+
+func (s *SetProductReturnWindowHandler) Handle(c echo.Context) error {
+	var input SetProductReturnWindowHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setProductReturnWindowUsecase.Execute(usecases.SetProductReturnWindowUsecaseInput{
+		ProductId:        uuid.MustParse(input.ProductId.(string)),
+		ReturnWindowDays: int32(input.ReturnWindowDays.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "return window days cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
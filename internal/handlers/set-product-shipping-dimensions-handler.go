@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductShippingDimensionsHandlerInput struct {
+	ProductId   any `validate:"required,uuid4"`
+	WeightGrams any `validate:"required,integer,positive"`
+	LengthCm    any `validate:"omitempty,integer"`
+	WidthCm     any `validate:"omitempty,integer"`
+	HeightCm    any `validate:"omitempty,integer"`
+}
+
+type SetProductShippingDimensionsHandler struct {
+	jsonBodyValidator                   webhttp.JSONBodyValidator
+	setProductShippingDimensionsUsecase usecases.SetProductShippingDimensionsUsecase
+}
+
+func NewSetProductShippingDimensionsHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setProductShippingDimensionsUsecase usecases.SetProductShippingDimensionsUsecase) SetProductShippingDimensionsHandler {
+	return SetProductShippingDimensionsHandler{jsonBodyValidator, setProductShippingDimensionsUsecase}
+}
+
+func (s *SetProductShippingDimensionsHandler) Handle(c echo.Context) error {
+	var input SetProductShippingDimensionsHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setProductShippingDimensionsUsecase.Execute(usecases.SetProductShippingDimensionsUsecaseInput{
+		ProductId:   uuid.MustParse(input.ProductId.(string)),
+		WeightGrams: int32(input.WeightGrams.(float64)),
+		LengthCm:    optionalInt32(input.LengthCm),
+		WidthCm:     optionalInt32(input.WidthCm),
+		HeightCm:    optionalInt32(input.HeightCm),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "weight cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "dimensions must be higher than zero" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "length, width and height must be set together" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetProductTaxCategoryHandlerInput struct {
+	ProductId   any `validate:"required,uuid4"`
+	TaxCategory any `validate:"required,string,notEmpty"`
+}
+
+type SetProductTaxCategoryHandler struct {
+	jsonBodyValidator            webhttp.JSONBodyValidator
+	setProductTaxCategoryUsecase usecases.SetProductTaxCategoryUsecase
+}
+
+func NewSetProductTaxCategoryHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	setProductTaxCategoryUsecase usecases.SetProductTaxCategoryUsecase) SetProductTaxCategoryHandler {
+	return SetProductTaxCategoryHandler{jsonBodyValidator, setProductTaxCategoryUsecase}
+}
+
+func (s *SetProductTaxCategoryHandler) Handle(c echo.Context) error {
+	var input SetProductTaxCategoryHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setProductTaxCategoryUsecase.Execute(usecases.SetProductTaxCategoryUsecaseInput{
+		ProductId:   uuid.MustParse(input.ProductId.(string)),
+		TaxCategory: input.TaxCategory.(string),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "tax category must have up to 50 letters, digits or underscores" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetReorderPointHandlerInput struct {
+	InventoryLookupInput
+	ReorderPoint any `validate:"required,integer,positive"`
+}
+
+type SetReorderPointHandler struct {
+	jsonBodyValidator      webhttp.JSONBodyValidator
+	setReorderPointUsecase usecases.SetReorderPointUsecase
+}
+
+func NewSetReorderPointHandler(jsonBodyValidator webhttp.JSONBodyValidator, setReorderPointUsecase usecases.SetReorderPointUsecase) SetReorderPointHandler {
+	return SetReorderPointHandler{jsonBodyValidator, setReorderPointUsecase}
+}
+
+func (s *SetReorderPointHandler) Handle(c echo.Context) error {
+	var input SetReorderPointHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	if messages := input.InventoryLookupInput.Validate(); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setReorderPointUsecase.Execute(usecases.SetReorderPointUsecaseInput{
+		Inventory:    input.ToInventoryLookup(),
+		ReorderPoint: int32(input.ReorderPoint.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "reorder point cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "inventory not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
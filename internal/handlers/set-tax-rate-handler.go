@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SetTaxRateHandlerInput struct {
+	State           any `validate:"required,string,notEmpty"`
+	TaxCategory     any `validate:"required,string,notEmpty"`
+	RateBasisPoints any `validate:"required,integer,positive"`
+}
+
+type SetTaxRateHandler struct {
+	jsonBodyValidator webhttp.JSONBodyValidator
+	setTaxRateUsecase usecases.SetTaxRateUsecase
+}
+
+func NewSetTaxRateHandler(jsonBodyValidator webhttp.JSONBodyValidator, setTaxRateUsecase usecases.SetTaxRateUsecase) SetTaxRateHandler {
+	return SetTaxRateHandler{jsonBodyValidator, setTaxRateUsecase}
+}
+
+func (s *SetTaxRateHandler) Handle(c echo.Context) error {
+	var input SetTaxRateHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.setTaxRateUsecase.Execute(usecases.SetTaxRateUsecaseInput{
+		State:           input.State.(string),
+		TaxCategory:     input.TaxCategory.(string),
+		RateBasisPoints: int32(input.RateBasisPoints.(float64)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "state must be a valid 2-letter U.S. abbreviation (e.g. NY, CA)" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "tax category must have up to 50 letters, digits or underscores" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "tax rate cannot be higher than 10000 basis points" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -7,9 +7,10 @@ import (
 )
 
 type SignUpHandlerInput struct {
-	Name     any `validate:"required,string,notEmpty"`
-	Email    any `validate:"required,string,notEmpty"`
-	Password any `validate:"required,string,notEmpty"`
+	Name      any `validate:"required,string,notEmpty"`
+	Email     any `validate:"required,string,notEmpty"`
+	Password  any `validate:"required,string,notEmpty"`
+	CartToken any `validate:"omitempty,string,notEmpty"`
 }
 
 type SignUpHandler struct {
@@ -32,10 +33,17 @@ func (r SignUpHandler) Handle(c echo.Context) error {
 		return c.JSON(400, map[string]any{"message": messages})
 	}
 
+	var cartToken *string
+	if input.CartToken != nil {
+		value := input.CartToken.(string)
+		cartToken = &value
+	}
+
 	err := r.signUpUsecase.Execute(usecases.SignUpUsecaseInput{
-		Name:     input.Name.(string),
-		Email:    input.Email.(string),
-		Password: input.Password.(string),
+		Name:      input.Name.(string),
+		Email:     input.Email.(string),
+		Password:  input.Password.(string),
+		CartToken: cartToken,
 	})
 	if err == nil {
 		return c.NoContent(204)
@@ -57,5 +65,9 @@ func (r SignUpHandler) Handle(c echo.Context) error {
 		return c.JSON(409, map[string]any{"message": err.Error()})
 	}
 
+	if err.Error() == "cart token is invalid" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
 	return err
 }
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type StartStockCountHandlerInput struct {
+	WarehouseId any `validate:"required,uuid4"`
+}
+
+type StartStockCountHandler struct {
+	jsonBodyValidator      webhttp.JSONBodyValidator
+	startStockCountUsecase usecases.StartStockCountUsecase
+}
+
+func NewStartStockCountHandler(jsonBodyValidator webhttp.JSONBodyValidator, startStockCountUsecase usecases.StartStockCountUsecase) StartStockCountHandler {
+	return StartStockCountHandler{jsonBodyValidator, startStockCountUsecase}
+}
+
+func (s *StartStockCountHandler) Handle(c echo.Context) error {
+	var input StartStockCountHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := s.startStockCountUsecase.Execute(usecases.StartStockCountUsecaseInput{
+		WarehouseId: uuid.MustParse(input.WarehouseId.(string)),
+		ActorId:     uuid.MustParse(claims.Subject),
+	})
+	if err == nil {
+		return c.JSON(201, map[string]any{
+			"data": map[string]any{
+				"stockCountId": output.StockCountId,
+			},
+		})
+	}
+
+	if err.Error() == "warehouse not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "a stock count is already in progress for this warehouse" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
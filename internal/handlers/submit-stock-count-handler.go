@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SubmitStockCountHandlerInput struct {
+	StockCountId any `validate:"required,uuid4"`
+	Items        any `validate:"required,notEmpty"`
+}
+
+type SubmitStockCountItemHandlerInput struct {
+	InventoryId     any `validate:"required,uuid4"`
+	CountedQuantity any `validate:"required,integer,positive"`
+}
+
+type SubmitStockCountHandler struct {
+	jsonBodyValidator       webhttp.JSONBodyValidator
+	submitStockCountUsecase usecases.SubmitStockCountUsecase
+}
+
+func NewSubmitStockCountHandler(jsonBodyValidator webhttp.JSONBodyValidator, submitStockCountUsecase usecases.SubmitStockCountUsecase) SubmitStockCountHandler {
+	return SubmitStockCountHandler{jsonBodyValidator, submitStockCountUsecase}
+}
+
+func (s *SubmitStockCountHandler) Handle(c echo.Context) error {
+	var input SubmitStockCountHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	rawItems, ok := input.Items.([]any)
+
+	if !ok {
+		return c.JSON(400, map[string]any{"message": []string{"items must be array"}})
+	}
+
+	items := []usecases.SubmitStockCountItem{}
+	messages := []string{}
+
+	for index, rawItem := range rawItems {
+		fields, ok := rawItem.(map[string]any)
+
+		if !ok {
+			messages = append(messages, fmt.Sprintf("items[%d] must be object", index))
+			continue
+		}
+
+		item := SubmitStockCountItemHandlerInput{
+			InventoryId:     fields["inventoryId"],
+			CountedQuantity: fields["countedQuantity"],
+		}
+
+		if itemMessages := s.jsonBodyValidator.Validate(item); len(itemMessages) > 0 {
+			for _, message := range itemMessages {
+				messages = append(messages, fmt.Sprintf("items[%d].%s", index, message))
+			}
+
+			continue
+		}
+
+		items = append(items, usecases.SubmitStockCountItem{
+			InventoryId:     uuid.MustParse(item.InventoryId.(string)),
+			CountedQuantity: int32(item.CountedQuantity.(float64)),
+		})
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := s.submitStockCountUsecase.Execute(usecases.SubmitStockCountUsecaseInput{
+		StockCountId: uuid.MustParse(input.StockCountId.(string)),
+		Items:        items,
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "stock count not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "stock count is not open" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "counted quantity cannot be negative" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "inventory is not part of the stock count" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type SubscribeToRestockHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+}
+
+type SubscribeToRestockHandler struct {
+	jsonBodyValidator         webhttp.JSONBodyValidator
+	subscribeToRestockUsecase usecases.SubscribeToRestockUsecase
+}
+
+func NewSubscribeToRestockHandler(jsonBodyValidator webhttp.JSONBodyValidator,
+	subscribeToRestockUsecase usecases.SubscribeToRestockUsecase) SubscribeToRestockHandler {
+	return SubscribeToRestockHandler{jsonBodyValidator, subscribeToRestockUsecase}
+}
+
+func (s *SubscribeToRestockHandler) Handle(c echo.Context) error {
+	var input SubscribeToRestockHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := s.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	err := s.subscribeToRestockUsecase.Execute(usecases.SubscribeToRestockUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		ProductId:  uuid.MustParse(input.ProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product is in stock" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
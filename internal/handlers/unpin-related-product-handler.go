@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type UnpinRelatedProductHandlerInput struct {
+	ProductId        any `validate:"required,uuid4"`
+	RelatedProductId any `validate:"required,uuid4"`
+}
+
+type UnpinRelatedProductHandler struct {
+	jsonBodyValidator          webhttp.JSONBodyValidator
+	unpinRelatedProductUsecase usecases.UnpinRelatedProductUsecase
+}
+
+func NewUnpinRelatedProductHandler(jsonBodyValidator webhttp.JSONBodyValidator, unpinRelatedProductUsecase usecases.UnpinRelatedProductUsecase) UnpinRelatedProductHandler {
+	return UnpinRelatedProductHandler{jsonBodyValidator, unpinRelatedProductUsecase}
+}
+
+func (u *UnpinRelatedProductHandler) Handle(c echo.Context) error {
+	var input UnpinRelatedProductHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := u.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	err := u.unpinRelatedProductUsecase.Execute(usecases.UnpinRelatedProductUsecaseInput{
+		ProductId:        uuid.MustParse(input.ProductId.(string)),
+		RelatedProductId: uuid.MustParse(input.RelatedProductId.(string)),
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "related product is not pinned" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
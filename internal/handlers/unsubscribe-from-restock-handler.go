@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+type UnsubscribeFromRestockHandler struct {
+	unsubscribeFromRestockUsecase usecases.UnsubscribeFromRestockUsecase
+}
+
+func NewUnsubscribeFromRestockHandler(unsubscribeFromRestockUsecase usecases.UnsubscribeFromRestockUsecase) UnsubscribeFromRestockHandler {
+	return UnsubscribeFromRestockHandler{unsubscribeFromRestockUsecase}
+}
+
+// Handle takes the POST to the unsubscribe link sent with restock notifications, also used for one-click unsubscribe
+// (RFC 8058), so it is authenticated by the token alone.
+func (u *UnsubscribeFromRestockHandler) Handle(c echo.Context) error {
+	unsubscribeToken := c.QueryParam("token")
+
+	if unsubscribeToken == "" {
+		return c.JSON(400, map[string]any{"message": []string{"token is required"}})
+	}
+
+	err := u.unsubscribeFromRestockUsecase.Execute(usecases.UnsubscribeFromRestockUsecaseInput{
+		UnsubscribeToken: unsubscribeToken,
+	})
+	if err == nil {
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "subscription not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
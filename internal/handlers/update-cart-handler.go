@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateCartHandlerInput struct {
+	Mode  any `validate:"required,string,notEmpty"`
+	Items any `validate:"required"`
+}
+
+type UpdateCartItemHandlerInput struct {
+	ProductId any `validate:"required,uuid4"`
+	Quantity  any `validate:"required,integer,positive"`
+}
+
+type UpdateCartHandler struct {
+	jsonBodyValidator webhttp.JSONBodyValidator
+	updateCartUsecase usecases.UpdateCartUsecase
+}
+
+func NewUpdateCartHandler(jsonBodyValidator webhttp.JSONBodyValidator, updateCartUsecase usecases.UpdateCartUsecase) UpdateCartHandler {
+	return UpdateCartHandler{jsonBodyValidator, updateCartUsecase}
+}
+
+// cartETag formats a cart version as the entity tag sent in the ETag header and expected back in If-Match.
+func cartETag(version int32) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+func parseCartETag(etag string) (int32, bool) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+
+	if len(etag) < 2 || !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+		return 0, false
+	}
+
+	version, err := strconv.ParseInt(etag[1:len(etag)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return int32(version), true
+}
+
+func (u *UpdateCartHandler) Handle(c echo.Context) error {
+	ifMatch := c.Request().Header.Get("If-Match")
+
+	if ifMatch == "" {
+		return c.JSON(428, map[string]any{"message": "If-Match header is required"})
+	}
+
+	version, ok := parseCartETag(ifMatch)
+
+	if !ok {
+		return c.JSON(412, map[string]any{"message": "cart has been modified"})
+	}
+
+	var input UpdateCartHandlerInput
+
+	if err := c.Bind(&input); err != nil {
+		return c.NoContent(415)
+	}
+
+	if messages := u.jsonBodyValidator.Validate(input); len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	if input.Mode != "replace" && input.Mode != "patch" {
+		return c.JSON(400, map[string]any{"message": []string{"mode must be replace or patch"}})
+	}
+
+	rawItems, ok := input.Items.([]any)
+
+	if !ok {
+		return c.JSON(400, map[string]any{"message": []string{"items must be array"}})
+	}
+
+	items := []usecases.UpdateCartItem{}
+	messages := []string{}
+
+	for index, rawItem := range rawItems {
+		fields, ok := rawItem.(map[string]any)
+
+		if !ok {
+			messages = append(messages, fmt.Sprintf("items[%d] must be object", index))
+			continue
+		}
+
+		item := UpdateCartItemHandlerInput{
+			ProductId: fields["productId"],
+			Quantity:  fields["quantity"],
+		}
+
+		if itemMessages := u.jsonBodyValidator.Validate(item); len(itemMessages) > 0 {
+			for _, message := range itemMessages {
+				messages = append(messages, fmt.Sprintf("items[%d].%s", index, message))
+			}
+
+			continue
+		}
+
+		items = append(items, usecases.UpdateCartItem{
+			ProductId: uuid.MustParse(item.ProductId.(string)),
+			Quantity:  int32(item.Quantity.(float64)),
+		})
+	}
+
+	if len(messages) > 0 {
+		return c.JSON(400, map[string]any{"message": messages})
+	}
+
+	token := c.Get("customer").(*jwt.Token)
+	claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+	output, err := u.updateCartUsecase.Execute(usecases.UpdateCartUsecaseInput{
+		CustomerId: uuid.MustParse(claims.Subject),
+		Version:    version,
+		Replace:    input.Mode == "replace",
+		Items:      items,
+	})
+	if err == nil {
+		c.Response().Header().Set("ETag", cartETag(output.Version))
+		return c.NoContent(204)
+	}
+
+	if err.Error() == "cart has been modified" {
+		return c.JSON(412, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "cart items must be distinct" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product not found" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the stock available" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per order" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product quantity exceeds the maximum per customer" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	if err.Error() == "product is not priced in the cart currency" {
+		return c.JSON(409, map[string]any{"message": err.Error()})
+	}
+
+	return err
+}
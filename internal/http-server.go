@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -13,8 +14,10 @@ import (
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/middlewares"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
 	webhttp "github.com/gsaaraujo/ecommerce-api-scenario-1/internal/web-http"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/workers"
 	"github.com/jackc/pgx/v5/pgxpool"
 	mercadopagoconfig "github.com/mercadopago/sdk-go/pkg/config"
+	"github.com/rabbitmq/amqp091-go"
 	"github.com/redis/go-redis/v9"
 
 	"github.com/labstack/echo/v4"
@@ -22,8 +25,17 @@ import (
 )
 
 type HttpServer struct {
-	echo   *echo.Echo
-	logger *slog.Logger
+	echo                          *echo.Echo
+	logger                        *slog.Logger
+	productRecommendationsWorker  workers.ProductRecommendationsWorker
+	lowStockAlertsWorker          workers.OutboxWorker
+	restockNotificationsWorker    workers.OutboxWorker
+	guestOrderNotificationsWorker workers.OutboxWorker
+	abandonedCartRemindersWorker  workers.OutboxWorker
+	pendingRefundsWorker          workers.OutboxWorker
+	productImportsWorker          workers.OutboxWorker
+	productSlugsWorker            workers.OutboxWorker
+	paymentGateway                gateways.PaymentGateway
 }
 
 func NewHttpServer() *HttpServer {
@@ -33,6 +45,11 @@ func NewHttpServer() *HttpServer {
 	}
 }
 
+// UsePaymentGateway replaces the Mercado Pago gateway before the server gets ready, for tests.
+func (h *HttpServer) UsePaymentGateway(paymentGateway gateways.PaymentGateway) {
+	h.paymentGateway = paymentGateway
+}
+
 func (h *HttpServer) Ready() {
 	h.logger.Info("http server getting ready")
 
@@ -70,6 +87,18 @@ func (h *HttpServer) Ready() {
 		os.Exit(1)
 	}
 
+	cartTokenSigningKey, err := awsSecretsGateway.Get("CART_TOKEN_SIGNING_KEY")
+	if err != nil {
+		h.logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	orderLookupTokenSigningKey, err := awsSecretsGateway.Get("ORDER_LOOKUP_TOKEN_SIGNING_KEY")
+	if err != nil {
+		h.logger.Error(err.Error())
+		os.Exit(1)
+	}
+
 	mercadoPagoAccessKey, err := awsSecretsGateway.Get("MERCADO_PAGO_ACCESS_KEY")
 	if err != nil {
 		h.logger.Error(err.Error())
@@ -99,6 +128,30 @@ func (h *HttpServer) Ready() {
 		Password: redisParsedUrl.Password,
 	})
 
+	rabbitmqUrl, err := awsSecretsGateway.Get("RABBITMQ_URL")
+	if err != nil {
+		h.logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	rabbitmqConn, err := amqp091.Dial(rabbitmqUrl)
+	if err != nil {
+		h.logger.Error(err.Error())
+		os.Exit(1)
+	}
+
+	publicUrl := os.Getenv("PUBLIC_URL")
+	if publicUrl == "" {
+		h.logger.Error("PUBLIC_URL environment variable not found")
+		os.Exit(1)
+	}
+
+	cartReminderThresholds, err := usecases.ParseCartReminderThresholds(os.Getenv("CART_REMINDER_THRESHOLDS"))
+	if err != nil {
+		h.logger.Error(err.Error())
+		os.Exit(1)
+	}
+
 	jsonBodyValidator, err := webhttp.NewJSONBodyValidator()
 	if err != nil {
 		h.logger.Error(err.Error())
@@ -111,33 +164,211 @@ func (h *HttpServer) Ready() {
 	cartItemDAO := daos.NewCartItemDAO(pgxPool)
 	productDAO := daos.NewProductDAO(pgxPool)
 	addressDAO := daos.NewAddressDAO(pgxPool)
+	productImportJobDAO := daos.NewProductImportJobDAO(pgxPool)
+	orderItemDAO := daos.NewOrderItemDAO(pgxPool)
+	productReviewDAO := daos.NewProductReviewDAO(pgxPool)
+	productPriceDAO := daos.NewProductPriceDAO(pgxPool)
+	warehouseDAO := daos.NewWarehouseDAO(pgxPool)
+	stockCountDAO := daos.NewStockCountDAO(pgxPool)
+	restockSubscriptionDAO := daos.NewRestockSubscriptionDAO(pgxPool)
+	guestCartDAO := daos.NewGuestCartDAO(pgxPool)
+	guestCartItemDAO := daos.NewGuestCartItemDAO(pgxPool)
+	orderDAO := daos.NewOrderDAO(pgxPool)
+	promotionDAO := daos.NewPromotionDAO(pgxPool)
+	wishlistDAO := daos.NewWishlistDAO(pgxPool)
+	wishlistItemDAO := daos.NewWishlistItemDAO(pgxPool)
+	taxRateDAO := daos.NewTaxRateDAO(pgxPool)
+	shippingMethodDAO := daos.NewShippingMethodDAO(pgxPool)
+	shippingZoneRateDAO := daos.NewShippingZoneRateDAO(pgxPool)
+	returnRequestDAO := daos.NewReturnRequestDAO(pgxPool)
 
 	httpZipCodeGateway := gateways.NewHttpZipCodeGateway(awsSecretsGateway)
+	rabbitmqLowStockNotifier := gateways.NewRabbitmqLowStockNotifier(rabbitmqConn)
+	rabbitmqRestockNotifier := gateways.NewRabbitmqRestockNotifier(rabbitmqConn)
+	rabbitmqOrderLookupNotifier := gateways.NewRabbitmqOrderLookupNotifier(rabbitmqConn)
+	rabbitmqCartReminderNotifier := gateways.NewRabbitmqCartReminderNotifier(rabbitmqConn)
+	paymentGateway := h.paymentGateway
+	if paymentGateway == nil {
+		paymentGateway = gateways.NewMercadoPagoPaymentGateway(mercadoPagoConfig)
+	}
+
+	warehouseAllocationStrategy := usecases.NewWarehouseAllocationStrategy(os.Getenv("WAREHOUSE_ALLOCATION_STRATEGY"))
+	taxProvider := usecases.NewRateTableTaxProvider(taxRateDAO)
+	pricingService := usecases.NewPricingService(promotionDAO, shippingMethodDAO, shippingZoneRateDAO, taxProvider)
 
-	loginUsecase := usecases.NewLoginUsecase(customerDAO, awsSecretsGateway)
-	signUpUsecase := usecases.NewSignUpUsecase(pgxPool, customerDAO)
+	loginUsecase := usecases.NewLoginUsecase(pgxPool, customerDAO, cartDAO, productDAO, productPriceDAO, awsSecretsGateway)
+	signUpUsecase := usecases.NewSignUpUsecase(pgxPool, customerDAO, productDAO, productPriceDAO, awsSecretsGateway)
 	addProductUsecase := usecases.NewAddProductUsecase(pgxPool)
-	addStockUsecase := usecases.NewAddStockUsecase(pgxPool, inventoryDAO)
+	addBundleUsecase := usecases.NewAddBundleUsecase(pgxPool, productDAO)
+	addStockUsecase := usecases.NewAddStockUsecase(pgxPool, inventoryDAO, productDAO, warehouseDAO)
 	publishProductUsecase := usecases.NewPublishProductUsecase(pgxPool, productDAO)
-	addProductToCartUsecase := usecases.NewAddProductToCartUsecase(pgxPool, cartDAO, cartItemDAO, productDAO, inventoryDAO)
+	addProductToCartUsecase := usecases.NewAddProductToCartUsecase(pgxPool, cartDAO, cartItemDAO, productDAO, inventoryDAO, productPriceDAO,
+		orderItemDAO)
 	removeProductFromCartUsecase := usecases.NewRemoveProductFromCartUsecase(pgxPool, cartDAO, cartItemDAO)
-	increaseProductQuantityInCartUsecase := usecases.NewIncreaseProductQuantityInCartUsecase(pgxPool, cartDAO, cartItemDAO, inventoryDAO)
+	increaseProductQuantityInCartUsecase := usecases.NewIncreaseProductQuantityInCartUsecase(pgxPool, cartDAO, cartItemDAO, inventoryDAO,
+		productDAO, orderItemDAO)
 	decreaseProductQuantityInCartUsecase := usecases.NewDecreaseProductQuantityInCartUsecase(pgxPool, cartDAO, cartItemDAO)
 	addAddressUsecase := usecases.NewAddAddressUsecase(redisClient, addressDAO, httpZipCodeGateway)
-	checkoutPostpaymentUsecase := usecases.NewCheckoutPostpaymentUsecase(mercadoPagoConfig, pgxPool, cartDAO, cartItemDAO, inventoryDAO)
+	checkoutPostpaymentUsecase := usecases.NewCheckoutPostpaymentUsecase(mercadoPagoConfig, pgxPool, cartDAO, cartItemDAO, inventoryDAO,
+		addressDAO, warehouseAllocationStrategy, pricingService)
+	importProductsUsecase := usecases.NewImportProductsUsecase(productImportJobDAO)
+	processProductImportsUsecase := usecases.NewProcessProductImportsUsecase(pgxPool)
+	regenerateProductSlugsUsecase := usecases.NewRegenerateProductSlugsUsecase(pgxPool)
+	addProductReviewUsecase := usecases.NewAddProductReviewUsecase(pgxPool, productDAO, orderItemDAO)
+	moderateProductReviewUsecase := usecases.NewModerateProductReviewUsecase(pgxPool, productReviewDAO)
+	markProductReviewHelpfulUsecase := usecases.NewMarkProductReviewHelpfulUsecase(pgxPool, productReviewDAO)
+	renameProductUsecase := usecases.NewRenameProductUsecase(pgxPool, productDAO)
+	computeProductRecommendationsUsecase := usecases.NewComputeProductRecommendationsUsecase(pgxPool)
+	pinRelatedProductUsecase := usecases.NewPinRelatedProductUsecase(pgxPool, productDAO)
+	unpinRelatedProductUsecase := usecases.NewUnpinRelatedProductUsecase(pgxPool)
+	setProductPriceUsecase := usecases.NewSetProductPriceUsecase(pgxPool, productDAO)
+	setCartCurrencyUsecase := usecases.NewSetCartCurrencyUsecase(pgxPool, cartDAO)
+	updateCartUsecase := usecases.NewUpdateCartUsecase(pgxPool, productDAO, inventoryDAO, productPriceDAO, orderItemDAO)
+	acknowledgeCartChangesUsecase := usecases.NewAcknowledgeCartChangesUsecase(pgxPool)
+	checkoutPrepayment := usecases.NewCheckoutPrepayment(pgxPool, paymentGateway, cartDAO, addressDAO,
+		pricingService)
+	addPromotionUsecase := usecases.NewAddPromotionUsecase(promotionDAO, productDAO)
+	applyCouponUsecase := usecases.NewApplyCouponUsecase(pgxPool, cartDAO, promotionDAO, pricingService)
+	removeCouponUsecase := usecases.NewRemoveCouponUsecase(pgxPool, cartDAO)
+	createWishlistUsecase := usecases.NewCreateWishlistUsecase(wishlistDAO)
+	addProductToWishlistUsecase := usecases.NewAddProductToWishlistUsecase(wishlistDAO, wishlistItemDAO, productDAO, cartDAO, productPriceDAO)
+	removeProductFromWishlistUsecase := usecases.NewRemoveProductFromWishlistUsecase(pgxPool, wishlistDAO, wishlistItemDAO)
+	saveProductForLaterUsecase := usecases.NewSaveProductForLaterUsecase(pgxPool, cartDAO, cartItemDAO)
+	moveWishlistItemToCartUsecase := usecases.NewMoveWishlistItemToCartUsecase(pgxPool, wishlistDAO, wishlistItemDAO, addProductToCartUsecase)
+	addWarehouseUsecase := usecases.NewAddWarehouseUsecase(warehouseDAO)
+	adjustStockUsecase := usecases.NewAdjustStockUsecase(pgxPool, inventoryDAO, productDAO)
+	startStockCountUsecase := usecases.NewStartStockCountUsecase(pgxPool, warehouseDAO, stockCountDAO)
+	submitStockCountUsecase := usecases.NewSubmitStockCountUsecase(pgxPool, stockCountDAO)
+	applyStockCountUsecase := usecases.NewApplyStockCountUsecase(pgxPool, stockCountDAO)
+	setReorderPointUsecase := usecases.NewSetReorderPointUsecase(pgxPool, inventoryDAO, productDAO)
+	setProductBackorderPolicyUsecase := usecases.NewSetProductBackorderPolicyUsecase(pgxPool, productDAO)
+	setProductPurchaseLimitsUsecase := usecases.NewSetProductPurchaseLimitsUsecase(pgxPool, productDAO)
+	setTaxRateUsecase := usecases.NewSetTaxRateUsecase(pgxPool)
+	setProductTaxCategoryUsecase := usecases.NewSetProductTaxCategoryUsecase(pgxPool, productDAO)
+	setCustomerTaxExemptionUsecase := usecases.NewSetCustomerTaxExemptionUsecase(pgxPool, customerDAO)
+	addShippingMethodUsecase := usecases.NewAddShippingMethodUsecase(pgxPool, shippingMethodDAO)
+	setProductShippingDimensionsUsecase := usecases.NewSetProductShippingDimensionsUsecase(pgxPool, productDAO)
+	cancelOrderUsecase := usecases.NewCancelOrderUsecase(pgxPool)
+	fulfilOrderUsecase := usecases.NewFulfilOrderUsecase(pgxPool)
+	requestReturnUsecase := usecases.NewRequestReturnUsecase(pgxPool)
+	moderateReturnRequestUsecase := usecases.NewModerateReturnRequestUsecase(pgxPool, returnRequestDAO)
+	receiveReturnRequestUsecase := usecases.NewReceiveReturnRequestUsecase(pgxPool)
+	setProductReturnWindowUsecase := usecases.NewSetProductReturnWindowUsecase(pgxPool, productDAO)
+	selectShippingMethodUsecase := usecases.NewSelectShippingMethodUsecase(pgxPool, cartDAO, shippingMethodDAO)
+	quoteShippingRatesUsecase := usecases.NewQuoteShippingRatesUsecase(pgxPool, cartDAO, addressDAO, pricingService)
+	notifyLowStockAlertsUsecase := usecases.NewNotifyLowStockAlertsUsecase(pgxPool, rabbitmqLowStockNotifier)
+	subscribeToRestockUsecase := usecases.NewSubscribeToRestockUsecase(pgxPool, productDAO, inventoryDAO)
+	unsubscribeFromRestockUsecase := usecases.NewUnsubscribeFromRestockUsecase(pgxPool, restockSubscriptionDAO)
+	createGuestCartUsecase := usecases.NewCreateGuestCartUsecase(pgxPool, guestCartDAO, awsSecretsGateway)
+	addProductToGuestCartUsecase := usecases.NewAddProductToGuestCartUsecase(pgxPool, guestCartDAO, guestCartItemDAO, productDAO,
+		inventoryDAO, productPriceDAO, orderItemDAO)
+	removeProductFromGuestCartUsecase := usecases.NewRemoveProductFromGuestCartUsecase(pgxPool, guestCartDAO, guestCartItemDAO)
+	guestCheckoutUsecase := usecases.NewGuestCheckoutUsecase(pgxPool, redisClient, customerDAO, guestCartDAO, guestCartItemDAO, productDAO,
+		httpZipCodeGateway, awsSecretsGateway)
+	guestCheckoutPrepaymentUsecase := usecases.NewGuestCheckoutPrepaymentUsecase(pgxPool, guestCartDAO, cartDAO, checkoutPrepayment)
+	selectGuestShippingMethodUsecase := usecases.NewSelectGuestShippingMethodUsecase(guestCartDAO, selectShippingMethodUsecase)
+	quoteGuestShippingRatesUsecase := usecases.NewQuoteGuestShippingRatesUsecase(guestCartDAO, quoteShippingRatesUsecase)
+	convertGuestCustomerUsecase := usecases.NewConvertGuestCustomerUsecase(pgxPool, orderDAO, customerDAO)
+	notifyGuestOrdersUsecase := usecases.NewNotifyGuestOrdersUsecase(pgxPool, rabbitmqOrderLookupNotifier, awsSecretsGateway,
+		publicUrl)
+	notifyRestockSubscribersUsecase := usecases.NewNotifyRestockSubscribersUsecase(pgxPool, rabbitmqRestockNotifier, publicUrl)
+	notifyAbandonedCartsUsecase := usecases.NewNotifyAbandonedCartsUsecase(pgxPool, rabbitmqCartReminderNotifier, cartReminderThresholds)
+	sendPendingRefundsUsecase := usecases.NewSendPendingRefundsUsecase(pgxPool, paymentGateway)
+	setCartReminderPreferenceUsecase := usecases.NewSetCartReminderPreferenceUsecase(pgxPool)
 
 	loginHandler := handlers.NewLoginHandler(jsonBodyValidator, loginUsecase)
 	signUpHandler := handlers.NewSignUpHandler(jsonBodyValidator, signUpUsecase)
 	addProductHandler := handlers.NewAddProductHandler(jsonBodyValidator, addProductUsecase)
+	addBundleHandler := handlers.NewAddBundleHandler(jsonBodyValidator, addBundleUsecase)
 	addStockHandler := handlers.NewAddStockHandler(jsonBodyValidator, addStockUsecase)
 	publishProductHandler := handlers.NewPublishProductHandler(jsonBodyValidator, publishProductUsecase)
 	addProductToCartHandler := handlers.NewAddProductToCartHandler(jsonBodyValidator, addProductToCartUsecase)
 	removeProductFromCartHandler := handlers.NewRemoveProductFromCartHandler(jsonBodyValidator, removeProductFromCartUsecase)
 	increaseProductQuantityInCartHandler := handlers.NewIncreaseProductQuantityInCartHandler(jsonBodyValidator, increaseProductQuantityInCartUsecase)
 	decreaseProductQuantityInCartHandler := handlers.NewDecreaseProductQuantityInCartHandler(jsonBodyValidator, decreaseProductQuantityInCartUsecase)
-	getCartHandler := handlers.NewGetCartHandler(pgxPool, cartDAO)
+	getCartHandler := handlers.NewGetCartHandler(pgxPool, cartDAO, addressDAO, pricingService)
 	addAddressHandler := handlers.NewAddAddressHandler(jsonBodyValidator, addAddressUsecase)
 	checkoutPostpaymentHandler := handlers.NewCheckoutPostpaymentHandler(jsonBodyValidator, checkoutPostpaymentUsecase)
+	importProductsHandler := handlers.NewImportProductsHandler(importProductsUsecase)
+	getProductImportJobHandler := handlers.NewGetProductImportJobHandler(productImportJobDAO)
+	exportProductsHandler := handlers.NewExportProductsHandler(pgxPool)
+	getProductsHandler := handlers.NewGetProductsHandler(pgxPool)
+	getProductHandler := handlers.NewGetProductHandler(pgxPool)
+	getProductBySlugHandler := handlers.NewGetProductBySlugHandler(pgxPool)
+	renameProductHandler := handlers.NewRenameProductHandler(jsonBodyValidator, renameProductUsecase)
+	getProductReviewsHandler := handlers.NewGetProductReviewsHandler(pgxPool)
+	getAdminProductReviewsHandler := handlers.NewGetAdminProductReviewsHandler(pgxPool)
+	addProductReviewHandler := handlers.NewAddProductReviewHandler(jsonBodyValidator, addProductReviewUsecase)
+	moderateProductReviewHandler := handlers.NewModerateProductReviewHandler(jsonBodyValidator, moderateProductReviewUsecase)
+	markProductReviewHelpfulHandler := handlers.NewMarkProductReviewHelpfulHandler(jsonBodyValidator, markProductReviewHelpfulUsecase)
+	computeProductRecommendationsHandler := handlers.NewComputeProductRecommendationsHandler(computeProductRecommendationsUsecase)
+	pinRelatedProductHandler := handlers.NewPinRelatedProductHandler(jsonBodyValidator, pinRelatedProductUsecase)
+	unpinRelatedProductHandler := handlers.NewUnpinRelatedProductHandler(jsonBodyValidator, unpinRelatedProductUsecase)
+	setProductPriceHandler := handlers.NewSetProductPriceHandler(jsonBodyValidator, setProductPriceUsecase)
+	setCartCurrencyHandler := handlers.NewSetCartCurrencyHandler(jsonBodyValidator, setCartCurrencyUsecase)
+	updateCartHandler := handlers.NewUpdateCartHandler(jsonBodyValidator, updateCartUsecase)
+	acknowledgeCartChangesHandler := handlers.NewAcknowledgeCartChangesHandler(acknowledgeCartChangesUsecase)
+	checkoutPrepaymentHandler := handlers.NewCheckoutPrepaymentHandler(checkoutPrepayment)
+	addPromotionHandler := handlers.NewAddPromotionHandler(jsonBodyValidator, addPromotionUsecase)
+	applyCouponHandler := handlers.NewApplyCouponHandler(jsonBodyValidator, applyCouponUsecase)
+	removeCouponHandler := handlers.NewRemoveCouponHandler(removeCouponUsecase)
+	createWishlistHandler := handlers.NewCreateWishlistHandler(jsonBodyValidator, createWishlistUsecase)
+	addProductToWishlistHandler := handlers.NewAddProductToWishlistHandler(jsonBodyValidator, addProductToWishlistUsecase)
+	removeProductFromWishlistHandler := handlers.NewRemoveProductFromWishlistHandler(jsonBodyValidator, removeProductFromWishlistUsecase)
+	saveProductForLaterHandler := handlers.NewSaveProductForLaterHandler(jsonBodyValidator, saveProductForLaterUsecase)
+	moveWishlistItemToCartHandler := handlers.NewMoveWishlistItemToCartHandler(jsonBodyValidator, moveWishlistItemToCartUsecase)
+	getWishlistsHandler := handlers.NewGetWishlistsHandler(pgxPool)
+	getInventoryMovementsHandler := handlers.NewGetInventoryMovementsHandler(pgxPool)
+	addWarehouseHandler := handlers.NewAddWarehouseHandler(jsonBodyValidator, addWarehouseUsecase)
+	adjustStockHandler := handlers.NewAdjustStockHandler(jsonBodyValidator, adjustStockUsecase)
+	startStockCountHandler := handlers.NewStartStockCountHandler(jsonBodyValidator, startStockCountUsecase)
+	submitStockCountHandler := handlers.NewSubmitStockCountHandler(jsonBodyValidator, submitStockCountUsecase)
+	getStockCountHandler := handlers.NewGetStockCountHandler(pgxPool, stockCountDAO)
+	applyStockCountHandler := handlers.NewApplyStockCountHandler(jsonBodyValidator, applyStockCountUsecase)
+	setReorderPointHandler := handlers.NewSetReorderPointHandler(jsonBodyValidator, setReorderPointUsecase)
+	setProductBackorderPolicyHandler := handlers.NewSetProductBackorderPolicyHandler(jsonBodyValidator, setProductBackorderPolicyUsecase)
+	setProductPurchaseLimitsHandler := handlers.NewSetProductPurchaseLimitsHandler(jsonBodyValidator, setProductPurchaseLimitsUsecase)
+	setTaxRateHandler := handlers.NewSetTaxRateHandler(jsonBodyValidator, setTaxRateUsecase)
+	setProductTaxCategoryHandler := handlers.NewSetProductTaxCategoryHandler(jsonBodyValidator, setProductTaxCategoryUsecase)
+	setCustomerTaxExemptionHandler := handlers.NewSetCustomerTaxExemptionHandler(jsonBodyValidator, setCustomerTaxExemptionUsecase)
+	addShippingMethodHandler := handlers.NewAddShippingMethodHandler(jsonBodyValidator, addShippingMethodUsecase)
+	setProductShippingDimensionsHandler := handlers.NewSetProductShippingDimensionsHandler(jsonBodyValidator, setProductShippingDimensionsUsecase)
+	cancelOrderHandler := handlers.NewCancelOrderHandler(jsonBodyValidator, cancelOrderUsecase)
+	adminCancelOrderHandler := handlers.NewAdminCancelOrderHandler(jsonBodyValidator, cancelOrderUsecase)
+	fulfilOrderHandler := handlers.NewFulfilOrderHandler(jsonBodyValidator, fulfilOrderUsecase)
+	requestReturnHandler := handlers.NewRequestReturnHandler(jsonBodyValidator, requestReturnUsecase)
+	moderateReturnRequestHandler := handlers.NewModerateReturnRequestHandler(jsonBodyValidator, moderateReturnRequestUsecase)
+	receiveReturnRequestHandler := handlers.NewReceiveReturnRequestHandler(jsonBodyValidator, receiveReturnRequestUsecase)
+	setProductReturnWindowHandler := handlers.NewSetProductReturnWindowHandler(jsonBodyValidator, setProductReturnWindowUsecase)
+	selectShippingMethodHandler := handlers.NewSelectShippingMethodHandler(jsonBodyValidator, selectShippingMethodUsecase)
+	getShippingRatesHandler := handlers.NewGetShippingRatesHandler(quoteShippingRatesUsecase)
+	getLowStockItemsHandler := handlers.NewGetLowStockItemsHandler(pgxPool)
+	getAdminProductsHandler := handlers.NewGetAdminProductsHandler(pgxPool)
+	getAdminProductHandler := handlers.NewGetAdminProductHandler(pgxPool, productDAO)
+	subscribeToRestockHandler := handlers.NewSubscribeToRestockHandler(jsonBodyValidator, subscribeToRestockUsecase)
+	getRestockSubscriptionHandler := handlers.NewGetRestockSubscriptionHandler(pgxPool)
+	unsubscribeFromRestockHandler := handlers.NewUnsubscribeFromRestockHandler(unsubscribeFromRestockUsecase)
+	createGuestCartHandler := handlers.NewCreateGuestCartHandler(createGuestCartUsecase)
+	addProductToGuestCartHandler := handlers.NewAddProductToGuestCartHandler(jsonBodyValidator, addProductToGuestCartUsecase)
+	removeProductFromGuestCartHandler := handlers.NewRemoveProductFromGuestCartHandler(jsonBodyValidator, removeProductFromGuestCartUsecase)
+	getGuestCartHandler := handlers.NewGetGuestCartHandler(pgxPool, guestCartDAO)
+	guestCheckoutHandler := handlers.NewGuestCheckoutHandler(jsonBodyValidator, guestCheckoutUsecase)
+	guestCheckoutPrepaymentHandler := handlers.NewGuestCheckoutPrepaymentHandler(guestCheckoutPrepaymentUsecase)
+	selectGuestShippingMethodHandler := handlers.NewSelectGuestShippingMethodHandler(jsonBodyValidator, selectGuestShippingMethodUsecase)
+	getGuestShippingRatesHandler := handlers.NewGetGuestShippingRatesHandler(quoteGuestShippingRatesUsecase)
+	getOrderLookupHandler := handlers.NewGetOrderLookupHandler(pgxPool)
+	convertGuestCustomerHandler := handlers.NewConvertGuestCustomerHandler(jsonBodyValidator, convertGuestCustomerUsecase)
+	setCartReminderPreferenceHandler := handlers.NewSetCartReminderPreferenceHandler(jsonBodyValidator, setCartReminderPreferenceUsecase)
+	getAbandonedCartMetricsHandler := handlers.NewGetAbandonedCartMetricsHandler(pgxPool)
+
+	h.productRecommendationsWorker = workers.NewProductRecommendationsWorker(h.logger, time.Hour, computeProductRecommendationsUsecase)
+	h.lowStockAlertsWorker = workers.NewOutboxWorker(h.logger, "low stock alerts", time.Minute, &notifyLowStockAlertsUsecase)
+	h.restockNotificationsWorker = workers.NewOutboxWorker(h.logger, "restock subscribers", time.Minute, &notifyRestockSubscribersUsecase)
+	h.guestOrderNotificationsWorker = workers.NewOutboxWorker(h.logger, "guest order notifications", time.Minute, &notifyGuestOrdersUsecase)
+	h.abandonedCartRemindersWorker = workers.NewOutboxWorker(h.logger, "abandoned cart reminders", 5*time.Minute, &notifyAbandonedCartsUsecase)
+	h.pendingRefundsWorker = workers.NewOutboxWorker(h.logger, "pending refunds", time.Minute, &sendPendingRefundsUsecase)
+	h.productImportsWorker = workers.NewOutboxWorker(h.logger, "product imports", 5*time.Second, &processProductImportsUsecase)
+	h.productSlugsWorker = workers.NewOutboxWorker(h.logger, "product slugs", time.Hour, &regenerateProductSlugsUsecase)
 
 	h.echo.GET("/health", func(c echo.Context) error {
 		return c.NoContent(204)
@@ -147,26 +378,111 @@ func (h *HttpServer) Ready() {
 
 	v1.POST("/login", loginHandler.Handle)
 	v1.POST("/sign-up", signUpHandler.Handle)
+	v1.GET("/products", getProductsHandler.Handle)
+	v1.GET("/products/:productId", getProductHandler.Handle)
+	v1.GET("/products/by-slug/:slug", getProductBySlugHandler.Handle)
+	v1.GET("/product-reviews", getProductReviewsHandler.Handle)
 
 	echoJWTMiddleware := middlewares.NewEchoJWTMiddleware(accessTokenSigningKey)
+	echoAdminMiddleware := middlewares.NewEchoAdminMiddleware()
 	v1.POST("/admin/add-product", addProductHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/add-bundle", addBundleHandler.Handle, echoJWTMiddleware)
 	v1.POST("/admin/add-stock", addStockHandler.Handle, echoJWTMiddleware)
 	v1.POST("/admin/publish-product", publishProductHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/rename-product", renameProductHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/import-products", importProductsHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/products", getAdminProductsHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/products/:productId", getAdminProductHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/import-products/:jobId", getProductImportJobHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/export-products", exportProductsHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/product-reviews", getAdminProductReviewsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/moderate-product-review", moderateProductReviewHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/compute-product-recommendations", computeProductRecommendationsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/pin-related-product", pinRelatedProductHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/unpin-related-product", unpinRelatedProductHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-product-price", setProductPriceHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/inventory-movements", getInventoryMovementsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/add-warehouse", addWarehouseHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/adjust-stock", adjustStockHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/start-stock-count", startStockCountHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/submit-stock-count", submitStockCountHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/stock-counts/:stockCountId", getStockCountHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/apply-stock-count", applyStockCountHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-reorder-point", setReorderPointHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-product-backorder-policy", setProductBackorderPolicyHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-product-purchase-limits", setProductPurchaseLimitsHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/low-stock-items", getLowStockItemsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/add-promotion", addPromotionHandler.Handle, echoJWTMiddleware)
+	v1.GET("/admin/abandoned-cart-metrics", getAbandonedCartMetricsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-tax-rate", setTaxRateHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-product-tax-category", setProductTaxCategoryHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-customer-tax-exemption", setCustomerTaxExemptionHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/add-shipping-method", addShippingMethodHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/set-product-shipping-dimensions", setProductShippingDimensionsHandler.Handle, echoJWTMiddleware)
+	v1.POST("/admin/cancel-order", adminCancelOrderHandler.Handle, echoJWTMiddleware, echoAdminMiddleware)
+	v1.POST("/admin/fulfil-order", fulfilOrderHandler.Handle, echoJWTMiddleware, echoAdminMiddleware)
+	v1.POST("/admin/moderate-return-request", moderateReturnRequestHandler.Handle, echoJWTMiddleware, echoAdminMiddleware)
+	v1.POST("/admin/receive-return-request", receiveReturnRequestHandler.Handle, echoJWTMiddleware, echoAdminMiddleware)
+	v1.POST("/admin/set-product-return-window", setProductReturnWindowHandler.Handle, echoJWTMiddleware, echoAdminMiddleware)
 
 	v1.POST("/add-product-to-cart", addProductToCartHandler.Handle, echoJWTMiddleware)
 	v1.POST("/remove-product-from-cart", removeProductFromCartHandler.Handle, echoJWTMiddleware)
 	v1.POST("/increase-product-quantity-in-cart", increaseProductQuantityInCartHandler.Handle, echoJWTMiddleware)
 	v1.POST("/decrease-product-quantity-in-cart", decreaseProductQuantityInCartHandler.Handle, echoJWTMiddleware)
 	v1.POST("/add-address", addAddressHandler.Handle, echoJWTMiddleware)
+	v1.POST("/add-product-review", addProductReviewHandler.Handle, echoJWTMiddleware)
+	v1.POST("/mark-product-review-helpful", markProductReviewHelpfulHandler.Handle, echoJWTMiddleware)
+	v1.POST("/set-cart-currency", setCartCurrencyHandler.Handle, echoJWTMiddleware)
+	v1.POST("/subscribe-to-restock", subscribeToRestockHandler.Handle, echoJWTMiddleware)
+	v1.GET("/unsubscribe-from-restock", getRestockSubscriptionHandler.Handle)
+	v1.POST("/unsubscribe-from-restock", unsubscribeFromRestockHandler.Handle)
+	v1.POST("/acknowledge-cart-changes", acknowledgeCartChangesHandler.Handle, echoJWTMiddleware)
+	v1.POST("/apply-coupon", applyCouponHandler.Handle, echoJWTMiddleware)
+	v1.POST("/remove-coupon", removeCouponHandler.Handle, echoJWTMiddleware)
+	v1.GET("/shipping-rates", getShippingRatesHandler.Handle, echoJWTMiddleware)
+	v1.POST("/select-shipping-method", selectShippingMethodHandler.Handle, echoJWTMiddleware)
+	v1.POST("/cancel-order", cancelOrderHandler.Handle, echoJWTMiddleware)
+	v1.POST("/request-return", requestReturnHandler.Handle, echoJWTMiddleware)
+	v1.POST("/create-wishlist", createWishlistHandler.Handle, echoJWTMiddleware)
+	v1.POST("/add-product-to-wishlist", addProductToWishlistHandler.Handle, echoJWTMiddleware)
+	v1.POST("/remove-product-from-wishlist", removeProductFromWishlistHandler.Handle, echoJWTMiddleware)
+	v1.POST("/save-product-for-later", saveProductForLaterHandler.Handle, echoJWTMiddleware)
+	v1.POST("/move-wishlist-item-to-cart", moveWishlistItemToCartHandler.Handle, echoJWTMiddleware)
+	v1.POST("/set-cart-reminder-preference", setCartReminderPreferenceHandler.Handle, echoJWTMiddleware)
+	v1.POST("/checkout-prepayment", checkoutPrepaymentHandler.Handle, echoJWTMiddleware)
 	v1.POST("/checkout-postpayment", checkoutPostpaymentHandler.Handle)
 
 	v1.GET("/cart", getCartHandler.Handle, echoJWTMiddleware)
+	v1.PUT("/cart", updateCartHandler.Handle, echoJWTMiddleware)
+	v1.GET("/wishlists", getWishlistsHandler.Handle, echoJWTMiddleware)
+
+	echoGuestCartMiddleware := middlewares.NewEchoGuestCartMiddleware(cartTokenSigningKey)
+	v1.POST("/guest-carts", createGuestCartHandler.Handle)
+	v1.POST("/add-product-to-guest-cart", addProductToGuestCartHandler.Handle, echoGuestCartMiddleware)
+	v1.POST("/remove-product-from-guest-cart", removeProductFromGuestCartHandler.Handle, echoGuestCartMiddleware)
+	v1.GET("/guest-cart", getGuestCartHandler.Handle, echoGuestCartMiddleware)
+	v1.POST("/guest-checkout", guestCheckoutHandler.Handle, echoGuestCartMiddleware)
+	v1.GET("/guest-shipping-rates", getGuestShippingRatesHandler.Handle, echoGuestCartMiddleware)
+	v1.POST("/select-guest-shipping-method", selectGuestShippingMethodHandler.Handle, echoGuestCartMiddleware)
+	v1.POST("/guest-checkout-prepayment", guestCheckoutPrepaymentHandler.Handle, echoGuestCartMiddleware)
+
+	echoOrderLookupMiddleware := middlewares.NewEchoOrderLookupMiddleware(orderLookupTokenSigningKey)
+	v1.GET("/order-lookup", getOrderLookupHandler.Handle, echoOrderLookupMiddleware)
+	v1.POST("/convert-guest-customer", convertGuestCustomerHandler.Handle, echoOrderLookupMiddleware)
 
 	h.logger.Info("http server is now ready")
 }
 
 func (h *HttpServer) Start() {
 	h.Ready()
+	h.productRecommendationsWorker.Start()
+	h.lowStockAlertsWorker.Start()
+	h.restockNotificationsWorker.Start()
+	h.guestOrderNotificationsWorker.Start()
+	h.abandonedCartRemindersWorker.Start()
+	h.pendingRefundsWorker.Start()
+	h.productImportsWorker.Start()
+	h.productSlugsWorker.Start()
 	h.logger.Info("http server successfully started")
 	err := h.echo.Start(":3333")
 
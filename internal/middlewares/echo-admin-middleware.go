@@ -0,0 +1,25 @@
+package middlewares
+
+import (
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+// NewEchoAdminMiddleware only lets access tokens with the admin role through. It goes after the JWT middleware.
+func NewEchoAdminMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := c.Get("customer").(*jwt.Token)
+			claims := token.Claims.(*usecases.JwtAccessTokenClaims)
+
+			if !slices.Contains(claims.Roles, "admin") {
+				return c.JSON(403, map[string]any{"message": "admin role is required"})
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package testhelpers
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/mercadopago/sdk-go/pkg/preference"
+)
+
+// StubPaymentGateway creates preferences and approves every refund without calling out.
+type StubPaymentGateway struct{}
+
+func (s StubPaymentGateway) CreatePreference(request preference.Request) (string, error) {
+	return "stub-" + uuid.NewString(), nil
+}
+
+func (s StubPaymentGateway) Refund(refundId uuid.UUID, transactionId string, amount utils.Money) (gateways.PaymentRefund, error) {
+	return gateways.PaymentRefund{
+		RefundId: "stub-" + uuid.NewString(),
+		Status:   "approved",
+	}, nil
+}
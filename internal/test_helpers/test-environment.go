@@ -9,11 +9,13 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rabbitmq/amqp091-go"
@@ -52,6 +54,7 @@ func (t *TestEnvironment) Start() {
 	_ = os.Setenv("AWS_SECRET_MANAGER_NAME", "secret-us-east-1-local-app")
 	_ = os.Setenv("TERN_MIGRATIONS_PATH", "../migrations")
 	_ = os.Setenv("ZIPCODE_URL", t.wiremockContainerUrl)
+	_ = os.Setenv("PUBLIC_URL", "https://shop.example.com")
 
 	t.awsConfig = utils.GetOrThrow(config.LoadDefaultConfig(context.TODO()))
 
@@ -83,7 +86,9 @@ func (t *TestEnvironment) createSecrets() {
 				"RABBITMQ_URL": "%s",
 				"MERCADO_PAGO_ACCESS_KEY": "",
 				"ZIPCODE_TOKEN": "a7416146283d464294cebea38d5cb5ff",
-				"ACCESS_TOKEN_SIGNING_KEY": "81c4a8d5b2554de4ba736e93255ba633"
+				"ACCESS_TOKEN_SIGNING_KEY": "81c4a8d5b2554de4ba736e93255ba633",
+				"CART_TOKEN_SIGNING_KEY": "3f9e2b7c1d6a4e8f9b0c5d2a7e1f4b6c",
+				"ORDER_LOOKUP_TOKEN_SIGNING_KEY": "c8d1e4f7a2b5460e9d3c6f1a8b4e7d20"
 			}
 		`, t.redisContainerUrl, t.postgresContainerUrl, t.rabbitmqContainerUrl)),
 	}))
@@ -116,6 +121,7 @@ func (t *TestEnvironment) runMigrations() error {
 
 func (t *TestEnvironment) startHttpServer() {
 	httpServer := internal.NewHttpServer()
+	httpServer.UsePaymentGateway(StubPaymentGateway{})
 	httpServer.Ready()
 	server := httptest.NewServer(httpServer.Echo())
 
@@ -127,6 +133,23 @@ func (s *TestEnvironment) BaseUrl() string {
 	return s.baseUrl
 }
 
+// Request sends a JSON request to the server, authorized with the access token unless it is empty. headers are
+// extra header names and values, in pairs.
+func (s *TestEnvironment) Request(accessToken string, method string, path string, body string, headers ...string) *http.Response {
+	request := utils.GetOrThrow(http.NewRequest(method, s.baseUrl+path, strings.NewReader(body)))
+	request.Header.Set("Content-Type", "application/json")
+
+	if accessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	for i := 0; i+1 < len(headers); i += 2 {
+		request.Header.Set(headers[i], headers[i+1])
+	}
+
+	return utils.GetOrThrow(s.client.Do(request))
+}
+
 func (s *TestEnvironment) WiremockContainerUrl() string {
 	return s.wiremockContainerUrl
 }
@@ -146,3 +169,7 @@ func (s *TestEnvironment) RedisClient() *redis.Client {
 func (s *TestEnvironment) RabbitmqConn() *amqp091.Connection {
 	return s.rabbitmqConn
 }
+
+func (s *TestEnvironment) AwsSecretsGateway() gateways.AwsSecretsGateway {
+	return gateways.NewAwsSecretsGateway(secretsmanager.NewFromConfig(s.awsConfig))
+}
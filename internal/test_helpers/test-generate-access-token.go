@@ -5,6 +5,7 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
 )
 
@@ -18,3 +19,16 @@ func TestGenerateAccessToken(customerId uuid.UUID) string {
 	acessTokenSigned := utils.GetOrThrow(accessToken.SignedString([]byte("81c4a8d5b2554de4ba736e93255ba633")))
 	return acessTokenSigned
 }
+
+func TestGenerateAdminAccessToken(adminId uuid.UUID) string {
+	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, usecases.JwtAccessTokenClaims{
+		Roles: []string{"admin"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   adminId.String(),
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(30 * time.Minute)),
+		},
+	})
+
+	return utils.GetOrThrow(accessToken.SignedString([]byte("81c4a8d5b2554de4ba736e93255ba633")))
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AcknowledgeCartChangesUsecaseInput struct {
+	CustomerId uuid.UUID
+}
+
+type AcknowledgeCartChangesUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewAcknowledgeCartChangesUsecase(pgxPool *pgxpool.Pool) AcknowledgeCartChangesUsecase {
+	return AcknowledgeCartChangesUsecase{pgxPool}
+}
+
+// Execute accepts the cart as it stands now: changed prices become the prices the customer saw, lines short
+// of stock are lowered to what is available and lines that can no longer be bought are removed.
+func (a *AcknowledgeCartChangesUsecase) Execute(input AcknowledgeCartChangesUsecaseInput) error {
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var cartId uuid.UUID
+
+	err := tx.QueryRow(context.Background(), "SELECT id FROM carts WHERE customer_id = $1 FOR UPDATE", input.CustomerId).Scan(&cartId)
+	if err == pgx.ErrNoRows {
+		return errors.New("cart not found")
+	}
+	utils.ThrowOnError(err)
+
+	changed := false
+
+	for _, line := range FindCartLines(tx, cartId) {
+		if len(line.warnings()) == 0 {
+			continue
+		}
+
+		changed = true
+
+		quantity := min(line.Quantity, sellableQuantity(line.Product, line.StockQuantity, line.BackorderedQuantity))
+
+		if !line.available() || quantity <= 0 {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE cart_id = $1 AND product_id = $2",
+				cartId, line.ProductId))
+			continue
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE cart_items SET quantity = $1, price_at_add = $2 WHERE cart_id = $3 AND product_id = $4",
+			quantity, *line.Price, cartId, line.ProductId))
+	}
+
+	if !changed {
+		return nil
+	}
+
+	touchCart(tx, cartId)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AddBundleComponent struct {
+	ProductId uuid.UUID
+	Quantity  int32
+}
+
+type AddBundleUsecaseInput struct {
+	Name        string
+	Description *string
+	Price       int64
+	Currency    string
+	Components  []AddBundleComponent
+}
+
+type AddBundleUsecaseOutput struct {
+	ProductId uuid.UUID
+}
+
+type AddBundleUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewAddBundleUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) AddBundleUsecase {
+	return AddBundleUsecase{pgxPool, productDAO}
+}
+
+// Execute creates an unpublished bundle product made of the given components. Bundles have no inventory:
+// their availability is derived from the stock of their components.
+func (a *AddBundleUsecase) Execute(input AddBundleUsecaseInput) (AddBundleUsecaseOutput, error) {
+	if err := validateProduct(input.Name, input.Price); err != nil {
+		return AddBundleUsecaseOutput{}, err
+	}
+
+	currency := input.Currency
+
+	if currency == "" {
+		currency = utils.DefaultCurrency
+	}
+
+	if !utils.IsSupportedCurrency(currency) {
+		return AddBundleUsecaseOutput{}, errors.New("currency is not supported")
+	}
+
+	if len(input.Components) == 0 {
+		return AddBundleUsecaseOutput{}, errors.New("bundle must have at least one component")
+	}
+
+	componentProductIds := map[uuid.UUID]bool{}
+
+	for _, component := range input.Components {
+		if component.Quantity <= 0 {
+			return AddBundleUsecaseOutput{}, errors.New("component quantity must be higher than zero")
+		}
+
+		if componentProductIds[component.ProductId] {
+			return AddBundleUsecaseOutput{}, errors.New("bundle components must be distinct")
+		}
+
+		componentProductIds[component.ProductId] = true
+
+		productSchema := a.productDAO.FindOneById(component.ProductId)
+
+		if productSchema == nil {
+			return AddBundleUsecaseOutput{}, errors.New("component product not found")
+		}
+
+		if productSchema.IsBundle {
+			return AddBundleUsecaseOutput{}, errors.New("bundle components cannot be bundles")
+		}
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	productId := uuid.New()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		"INSERT INTO products (id, status, name, description, price, currency, is_bundle, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		productId, "unpublished", input.Name, input.Description, input.Price, currency, true, time.Now().UTC()))
+
+	for _, component := range input.Components {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO bundle_components (id, bundle_product_id, component_product_id, quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
+			uuid.New(), productId, component.ProductId, component.Quantity, time.Now().UTC()))
+	}
+
+	assignProductSlug(tx, productId, input.Name)
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return AddBundleUsecaseOutput{ProductId: productId}, nil
+}
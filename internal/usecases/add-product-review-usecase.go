@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AddProductReviewUsecaseInput struct {
+	CustomerId uuid.UUID
+	ProductId  uuid.UUID
+	Rating     int16
+	Title      string
+	Body       string
+}
+
+type AddProductReviewUsecaseOutput struct {
+	ProductReviewId uuid.UUID
+}
+
+type AddProductReviewUsecase struct {
+	pgxPool      *pgxpool.Pool
+	productDAO   daos.ProductDAO
+	orderItemDAO daos.OrderItemDAO
+}
+
+func NewAddProductReviewUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO, orderItemDAO daos.OrderItemDAO) AddProductReviewUsecase {
+	return AddProductReviewUsecase{pgxPool, productDAO, orderItemDAO}
+}
+
+func (a *AddProductReviewUsecase) Execute(input AddProductReviewUsecaseInput) (AddProductReviewUsecaseOutput, error) {
+	if input.Rating < 1 || input.Rating > 5 {
+		return AddProductReviewUsecaseOutput{}, errors.New("rating must be between 1 and 5")
+	}
+
+	if utf8.RuneCountInString(strings.TrimSpace(input.Title)) > 100 {
+		return AddProductReviewUsecaseOutput{}, errors.New("review title cannot exceed 100 characters")
+	}
+
+	productExists := a.productDAO.ExistsById(input.ProductId)
+
+	if !productExists {
+		return AddProductReviewUsecaseOutput{}, errors.New("product not found")
+	}
+
+	hasPurchased := a.orderItemDAO.ExistsByCustomerIdAndProductId(input.CustomerId, input.ProductId)
+
+	if !hasPurchased {
+		return AddProductReviewUsecaseOutput{}, errors.New("only customers who purchased this product can review it")
+	}
+
+	productReviewId := uuid.New()
+
+	commandTag := utils.GetOrThrow(a.pgxPool.Exec(context.Background(),
+		`INSERT INTO product_reviews (id, product_id, customer_id, rating, title, body, status, helpful_count, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (product_id, customer_id) DO NOTHING`,
+		productReviewId, input.ProductId, input.CustomerId, input.Rating, strings.TrimSpace(input.Title), strings.TrimSpace(input.Body),
+		"pending", 0, time.Now().UTC()))
+
+	if commandTag.RowsAffected() == 0 {
+		return AddProductReviewUsecaseOutput{}, errors.New("you have already reviewed this product")
+	}
+
+	return AddProductReviewUsecaseOutput{
+		ProductReviewId: productReviewId,
+	}, nil
+}
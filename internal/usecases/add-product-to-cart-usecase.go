@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,19 +19,40 @@ type AddProductToCartUsecaseInput struct {
 }
 
 type AddProductToCartUsecase struct {
-	pgxPool      *pgxpool.Pool
-	cartDAO      daos.CartDAO
-	cartItemDAO  daos.CartItemDAO
-	productDAO   daos.ProductDAO
-	inventoryDAO daos.InventoryDAO
+	pgxPool         *pgxpool.Pool
+	cartDAO         daos.CartDAO
+	cartItemDAO     daos.CartItemDAO
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	productPriceDAO daos.ProductPriceDAO
+	orderItemDAO    daos.OrderItemDAO
 }
 
 func NewAddProductToCartUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, cartItemDAO daos.CartItemDAO,
-	productDAO daos.ProductDAO, inventoryDAO daos.InventoryDAO) AddProductToCartUsecase {
-	return AddProductToCartUsecase{pgxPool, cartDAO, cartItemDAO, productDAO, inventoryDAO}
+	productDAO daos.ProductDAO, inventoryDAO daos.InventoryDAO, productPriceDAO daos.ProductPriceDAO,
+	orderItemDAO daos.OrderItemDAO) AddProductToCartUsecase {
+	return AddProductToCartUsecase{pgxPool, cartDAO, cartItemDAO, productDAO, inventoryDAO, productPriceDAO, orderItemDAO}
 }
 
 func (a *AddProductToCartUsecase) Execute(input AddProductToCartUsecaseInput) error {
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	if err := a.addToCart(tx, input); err != nil {
+		return err
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
+
+// addToCart checks the product can be bought and adds it to the cart within tx, so moving products from
+// other lists into the cart goes through the same checks.
+func (a *AddProductToCartUsecase) addToCart(tx pgx.Tx, input AddProductToCartUsecaseInput) error {
 	if input.Quantity == 0 {
 		return errors.New("product quantity cannot be zero")
 	}
@@ -41,24 +63,43 @@ func (a *AddProductToCartUsecase) Execute(input AddProductToCartUsecaseInput) er
 		return errors.New("product not found")
 	}
 
-	inventorySchema := a.inventoryDAO.FindOneByProductId(input.ProductId)
+	cartSchema := a.cartDAO.FindOneByCustomerId(input.CustomerId)
+	cartItemSchema := a.cartItemDAO.FindOneByCartIdAndProductId(cartSchema.Id, input.ProductId)
 
-	if input.Quantity > inventorySchema.StockQuantity {
+	// Limits apply to the whole cart line, so units already in the cart count too.
+	quantity := input.Quantity
+	if cartItemSchema != nil {
+		quantity += cartItemSchema.Quantity
+	}
+
+	stockQuantity := a.inventoryDAO.SumStockQuantityByProductId(input.ProductId)
+	backorderedQuantity := a.orderItemDAO.SumBackorderedQuantityByProductId(input.ProductId)
+
+	if quantity > sellableQuantity(*productSchema, stockQuantity, backorderedQuantity) {
 		return errors.New("product quantity exceeds the stock available")
 	}
 
-	cartSchema := a.cartDAO.FindOneByCustomerId(input.CustomerId)
-	cartItemSchema := a.cartItemDAO.FindOneByCartIdAndProductId(cartSchema.Id, input.ProductId)
+	if err := checkPurchaseLimits(tx, *productSchema, &input.CustomerId, quantity); err != nil {
+		return err
+	}
 
-	if cartItemSchema != nil {
-		_ = utils.GetOrThrow(a.pgxPool.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity + $1 WHERE id = $2",
-			input.Quantity, cartItemSchema.Id))
+	price, ok := findProductPrice(a.productPriceDAO, *productSchema, cartSchema.Currency)
 
-		return nil
+	if !ok {
+		return errors.New("product is not priced in the cart currency")
+	}
+
+	if cartItemSchema != nil {
+		// Adding more of a product is done at the price shown now, so it becomes the price the customer saw.
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity + $1, price_at_add = $2 WHERE id = $3",
+			input.Quantity, price.Amount, cartItemSchema.Id))
+	} else {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO cart_items (id, cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			uuid.New(), cartSchema.Id, input.ProductId, input.Quantity, price.Amount, time.Now().UTC()))
 	}
 
-	_ = utils.GetOrThrow(a.pgxPool.Exec(context.Background(), "INSERT INTO cart_items (id, cart_id, product_id, quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
-		uuid.New(), cartSchema.Id, input.ProductId, input.Quantity, time.Now().UTC()))
+	touchCart(tx, cartSchema.Id)
 
 	return nil
 }
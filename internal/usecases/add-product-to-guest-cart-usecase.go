@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AddProductToGuestCartUsecaseInput struct {
+	GuestCartId uuid.UUID
+	ProductId   uuid.UUID
+	Quantity    int32
+}
+
+type AddProductToGuestCartUsecase struct {
+	pgxPool          *pgxpool.Pool
+	guestCartDAO     daos.GuestCartDAO
+	guestCartItemDAO daos.GuestCartItemDAO
+	productDAO       daos.ProductDAO
+	inventoryDAO     daos.InventoryDAO
+	productPriceDAO  daos.ProductPriceDAO
+	orderItemDAO     daos.OrderItemDAO
+}
+
+func NewAddProductToGuestCartUsecase(pgxPool *pgxpool.Pool, guestCartDAO daos.GuestCartDAO, guestCartItemDAO daos.GuestCartItemDAO,
+	productDAO daos.ProductDAO, inventoryDAO daos.InventoryDAO, productPriceDAO daos.ProductPriceDAO,
+	orderItemDAO daos.OrderItemDAO) AddProductToGuestCartUsecase {
+	return AddProductToGuestCartUsecase{pgxPool, guestCartDAO, guestCartItemDAO, productDAO, inventoryDAO, productPriceDAO, orderItemDAO}
+}
+
+func (a *AddProductToGuestCartUsecase) Execute(input AddProductToGuestCartUsecaseInput) error {
+	if input.Quantity == 0 {
+		return errors.New("product quantity cannot be zero")
+	}
+
+	guestCartSchema := findGuestCart(a.guestCartDAO, input.GuestCartId)
+
+	if guestCartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	productSchema := a.productDAO.FindOneById(input.ProductId)
+
+	if productSchema == nil {
+		return errors.New("product not found")
+	}
+
+	guestCartItemSchema := a.guestCartItemDAO.FindOneByGuestCartIdAndProductId(guestCartSchema.Id, input.ProductId)
+
+	quantity := input.Quantity
+	if guestCartItemSchema != nil {
+		quantity += guestCartItemSchema.Quantity
+	}
+
+	stockQuantity := a.inventoryDAO.SumStockQuantityByProductId(input.ProductId)
+	backorderedQuantity := a.orderItemDAO.SumBackorderedQuantityByProductId(input.ProductId)
+
+	if quantity > sellableQuantity(*productSchema, stockQuantity, backorderedQuantity) {
+		return errors.New("product quantity exceeds the stock available")
+	}
+
+	if err := checkPurchaseLimits(a.pgxPool, *productSchema, nil, quantity); err != nil {
+		return err
+	}
+
+	price, ok := findProductPrice(a.productPriceDAO, *productSchema, guestCartSchema.Currency)
+
+	if !ok {
+		return errors.New("product is not priced in the cart currency")
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	if guestCartItemSchema != nil {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE guest_cart_items SET quantity = quantity + $1, price_at_add = $2 WHERE id = $3",
+			input.Quantity, price.Amount, guestCartItemSchema.Id))
+	} else {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO guest_cart_items (id, guest_cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			uuid.New(), guestCartSchema.Id, input.ProductId, input.Quantity, price.Amount, time.Now().UTC()))
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE guest_carts SET expires_at = $1 WHERE id = $2",
+		time.Now().UTC().Add(GuestCartTTL), guestCartSchema.Id))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
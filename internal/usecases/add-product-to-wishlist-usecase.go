@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+type AddProductToWishlistUsecaseInput struct {
+	CustomerId uuid.UUID
+	WishlistId uuid.UUID
+	ProductId  uuid.UUID
+}
+
+type AddProductToWishlistUsecase struct {
+	wishlistDAO     daos.WishlistDAO
+	wishlistItemDAO daos.WishlistItemDAO
+	productDAO      daos.ProductDAO
+	cartDAO         daos.CartDAO
+	productPriceDAO daos.ProductPriceDAO
+}
+
+func NewAddProductToWishlistUsecase(wishlistDAO daos.WishlistDAO, wishlistItemDAO daos.WishlistItemDAO, productDAO daos.ProductDAO,
+	cartDAO daos.CartDAO, productPriceDAO daos.ProductPriceDAO) AddProductToWishlistUsecase {
+	return AddProductToWishlistUsecase{wishlistDAO, wishlistItemDAO, productDAO, cartDAO, productPriceDAO}
+}
+
+// Execute adds the product at its price in the cart currency, which later price drops are measured against.
+// Products out of stock or not priced in that currency can still be wished for.
+func (a *AddProductToWishlistUsecase) Execute(input AddProductToWishlistUsecaseInput) error {
+	wishlistSchema := a.wishlistDAO.FindOneById(input.WishlistId)
+
+	if wishlistSchema == nil || wishlistSchema.CustomerId != input.CustomerId {
+		return errors.New("wishlist not found")
+	}
+
+	productSchema := a.productDAO.FindOneById(input.ProductId)
+
+	if productSchema == nil {
+		return errors.New("product not found")
+	}
+
+	if a.wishlistItemDAO.FindOneByWishlistIdAndProductId(input.WishlistId, input.ProductId) != nil {
+		return errors.New("product is already in the wishlist")
+	}
+
+	cartSchema := a.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	wishlistItemSchema := daos.WishlistItemSchema{
+		Id:         uuid.New(),
+		WishlistId: input.WishlistId,
+		ProductId:  input.ProductId,
+		Quantity:   1,
+		Currency:   cartSchema.Currency,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	if price, ok := findProductPrice(a.productPriceDAO, *productSchema, cartSchema.Currency); ok {
+		wishlistItemSchema.PriceAtAdd = &price.Amount
+	}
+
+	a.wishlistItemDAO.Create(wishlistItemSchema)
+
+	return nil
+}
@@ -3,7 +3,9 @@ package usecases
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
@@ -14,6 +16,12 @@ type AddProductUsecaseInput struct {
 	Name        string
 	Description *string
 	Price       int64
+	Currency    string
+}
+
+type AddProductUsecaseOutput struct {
+	ProductId   uuid.UUID
+	InventoryId uuid.UUID
 }
 
 type AddProductUsecase struct {
@@ -24,9 +32,21 @@ func NewAddProductUsecase(pgxPool *pgxpool.Pool) AddProductUsecase {
 	return AddProductUsecase{pgxPool}
 }
 
-func (a *AddProductUsecase) Execute(input AddProductUsecaseInput) error {
-	if input.Price == 0 {
-		return errors.New("the product price cannot be zero")
+// Execute creates the product along with its inventory in the default warehouse, and returns both ids so
+// the caller can stock the product right away.
+func (a *AddProductUsecase) Execute(input AddProductUsecaseInput) (AddProductUsecaseOutput, error) {
+	if err := validateProduct(input.Name, input.Price); err != nil {
+		return AddProductUsecaseOutput{}, err
+	}
+
+	currency := input.Currency
+
+	if currency == "" {
+		currency = utils.DefaultCurrency
+	}
+
+	if !utils.IsSupportedCurrency(currency) {
+		return AddProductUsecaseOutput{}, errors.New("currency is not supported")
 	}
 
 	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
@@ -36,14 +56,37 @@ func (a *AddProductUsecase) Execute(input AddProductUsecaseInput) error {
 	}()
 
 	productId := uuid.New()
+	inventoryId := uuid.New()
 
-	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO products (id, status, name, description, price, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
-		productId, "unpublished", input.Name, input.Description, input.Price, time.Now().UTC()))
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO products (id, status, name, description, price, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		productId, "unpublished", input.Name, input.Description, input.Price, currency, time.Now().UTC()))
 
 	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO inventories (id, product_id, stock_quantity, created_at) VALUES ($1, $2, $3, $4)",
-		uuid.New(), productId, 0, time.Now().UTC()))
+		inventoryId, productId, 0, time.Now().UTC()))
+
+	assignProductSlug(tx, productId, input.Name)
 
 	utils.ThrowOnError(tx.Commit(context.Background()))
 
+	return AddProductUsecaseOutput{ProductId: productId, InventoryId: inventoryId}, nil
+}
+
+func validateProduct(name string, price int64) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("the product name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(name) > 50 {
+		return errors.New("the product name cannot exceed 50 characters")
+	}
+
+	if price == 0 {
+		return errors.New("the product price cannot be zero")
+	}
+
+	if price < 0 {
+		return errors.New("the product price cannot be negative")
+	}
+
 	return nil
 }
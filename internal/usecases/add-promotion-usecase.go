@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+type AddPromotionUsecaseInput struct {
+	Code             string
+	Type             string
+	Percentage       *int32
+	Amount           *int64
+	Currency         *string
+	ProductId        *uuid.UUID
+	BuyQuantity      *int32
+	GetQuantity      *int32
+	MinimumSubtotal  *int64
+	UsageLimit       *int32
+	PerCustomerLimit *int32
+	StartsAt         *time.Time
+	EndsAt           *time.Time
+}
+
+type AddPromotionUsecaseOutput struct {
+	PromotionId uuid.UUID
+}
+
+type AddPromotionUsecase struct {
+	promotionDAO daos.PromotionDAO
+	productDAO   daos.ProductDAO
+}
+
+func NewAddPromotionUsecase(promotionDAO daos.PromotionDAO, productDAO daos.ProductDAO) AddPromotionUsecase {
+	return AddPromotionUsecase{promotionDAO, productDAO}
+}
+
+func (a *AddPromotionUsecase) Execute(input AddPromotionUsecaseInput) (AddPromotionUsecaseOutput, error) {
+	if utf8.RuneCountInString(input.Code) > 50 {
+		return AddPromotionUsecaseOutput{}, errors.New("coupon code cannot exceed 50 characters")
+	}
+
+	switch input.Type {
+	case PromotionTypePercentage:
+		if input.Percentage == nil || *input.Percentage < 1 || *input.Percentage > 100 {
+			return AddPromotionUsecaseOutput{}, errors.New("percentage must be between 1 and 100")
+		}
+	case PromotionTypeFixedAmount:
+		if input.Amount == nil || *input.Amount == 0 {
+			return AddPromotionUsecaseOutput{}, errors.New("amount must be higher than zero")
+		}
+
+		if input.Currency == nil {
+			return AddPromotionUsecaseOutput{}, errors.New("fixed amount coupons require a currency")
+		}
+	case PromotionTypeBuyXGetY:
+		if input.ProductId == nil || !a.productDAO.ExistsById(*input.ProductId) {
+			return AddPromotionUsecaseOutput{}, errors.New("product not found")
+		}
+
+		if input.BuyQuantity == nil || input.GetQuantity == nil || *input.BuyQuantity == 0 || *input.GetQuantity == 0 {
+			return AddPromotionUsecaseOutput{}, errors.New("buy and get quantities must be higher than zero")
+		}
+	case PromotionTypeFreeShipping:
+	default:
+		return AddPromotionUsecaseOutput{}, errors.New("coupon type is not supported")
+	}
+
+	if input.MinimumSubtotal != nil && *input.MinimumSubtotal == 0 {
+		return AddPromotionUsecaseOutput{}, errors.New("minimum subtotal must be higher than zero")
+	}
+
+	if input.MinimumSubtotal != nil && input.Currency == nil {
+		return AddPromotionUsecaseOutput{}, errors.New("a minimum subtotal requires a currency")
+	}
+
+	if input.Currency != nil && !utils.IsSupportedCurrency(*input.Currency) {
+		return AddPromotionUsecaseOutput{}, errors.New("currency is not supported")
+	}
+
+	if (input.UsageLimit != nil && *input.UsageLimit == 0) || (input.PerCustomerLimit != nil && *input.PerCustomerLimit == 0) {
+		return AddPromotionUsecaseOutput{}, errors.New("usage limits must be higher than zero")
+	}
+
+	if input.StartsAt != nil && input.EndsAt != nil && !input.EndsAt.After(*input.StartsAt) {
+		return AddPromotionUsecaseOutput{}, errors.New("coupon must end after it starts")
+	}
+
+	if a.promotionDAO.FindOneByCode(input.Code) != nil {
+		return AddPromotionUsecaseOutput{}, errors.New("coupon code already exists")
+	}
+
+	promotionId := uuid.New()
+
+	// Only the fields of the coupon type are kept, so a coupon never carries settings that do not apply to it.
+	promotionSchema := daos.PromotionSchema{
+		Id:               promotionId,
+		Code:             input.Code,
+		Type:             input.Type,
+		Currency:         input.Currency,
+		MinimumSubtotal:  input.MinimumSubtotal,
+		UsageLimit:       input.UsageLimit,
+		PerCustomerLimit: input.PerCustomerLimit,
+		StartsAt:         input.StartsAt,
+		EndsAt:           input.EndsAt,
+		CreatedAt:        time.Now().UTC(),
+	}
+
+	switch input.Type {
+	case PromotionTypePercentage:
+		promotionSchema.Percentage = input.Percentage
+	case PromotionTypeFixedAmount:
+		promotionSchema.Amount = input.Amount
+	case PromotionTypeBuyXGetY:
+		promotionSchema.ProductId = input.ProductId
+		promotionSchema.BuyQuantity = input.BuyQuantity
+		promotionSchema.GetQuantity = input.GetQuantity
+	}
+
+	a.promotionDAO.Create(promotionSchema)
+
+	return AddPromotionUsecaseOutput{
+		PromotionId: promotionId,
+	}, nil
+}
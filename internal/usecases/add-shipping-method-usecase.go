@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var zipPrefixRegex = regexp.MustCompile(`^[0-9]{1,5}$`)
+
+type AddShippingMethodZoneRate struct {
+	ZipPrefix string
+	Rate      int64
+}
+
+type AddShippingMethodUsecaseInput struct {
+	Name          string
+	Type          string
+	Currency      string
+	Rate          *int64
+	RatePerKg     *int64
+	FreeThreshold *int64
+	ZoneRates     []AddShippingMethodZoneRate
+}
+
+type AddShippingMethodUsecaseOutput struct {
+	ShippingMethodId uuid.UUID
+}
+
+type AddShippingMethodUsecase struct {
+	pgxPool           *pgxpool.Pool
+	shippingMethodDAO daos.ShippingMethodDAO
+}
+
+func NewAddShippingMethodUsecase(pgxPool *pgxpool.Pool, shippingMethodDAO daos.ShippingMethodDAO) AddShippingMethodUsecase {
+	return AddShippingMethodUsecase{pgxPool, shippingMethodDAO}
+}
+
+func (a *AddShippingMethodUsecase) Execute(input AddShippingMethodUsecaseInput) (AddShippingMethodUsecaseOutput, error) {
+	if utf8.RuneCountInString(input.Name) > 50 {
+		return AddShippingMethodUsecaseOutput{}, errors.New("shipping method name cannot exceed 50 characters")
+	}
+
+	currency := input.Currency
+
+	if currency == "" {
+		currency = utils.DefaultCurrency
+	}
+
+	if !utils.IsSupportedCurrency(currency) {
+		return AddShippingMethodUsecaseOutput{}, errors.New("currency is not supported")
+	}
+
+	switch input.Type {
+	case ShippingMethodTypeFlat:
+		if input.Rate == nil {
+			return AddShippingMethodUsecaseOutput{}, errors.New("flat shipping methods require a rate")
+		}
+	case ShippingMethodTypeWeightBased:
+		if input.Rate == nil || input.RatePerKg == nil {
+			return AddShippingMethodUsecaseOutput{}, errors.New("weight-based shipping methods require a rate and a rate per kg")
+		}
+	case ShippingMethodTypeFreeOverThreshold:
+		if input.Rate == nil || input.FreeThreshold == nil {
+			return AddShippingMethodUsecaseOutput{}, errors.New("free-over-threshold shipping methods require a rate and a free threshold")
+		}
+
+		if *input.FreeThreshold == 0 {
+			return AddShippingMethodUsecaseOutput{}, errors.New("free threshold must be higher than zero")
+		}
+	case ShippingMethodTypeZone:
+		if len(input.ZoneRates) == 0 {
+			return AddShippingMethodUsecaseOutput{}, errors.New("zone shipping methods require zone rates")
+		}
+
+		zipPrefixes := map[string]bool{}
+
+		for _, zoneRate := range input.ZoneRates {
+			if !zipPrefixRegex.MatchString(zoneRate.ZipPrefix) {
+				return AddShippingMethodUsecaseOutput{}, errors.New("zip prefix must have 1 to 5 digits")
+			}
+
+			if zipPrefixes[zoneRate.ZipPrefix] {
+				return AddShippingMethodUsecaseOutput{}, errors.New("zip prefixes must be distinct")
+			}
+
+			zipPrefixes[zoneRate.ZipPrefix] = true
+		}
+	default:
+		return AddShippingMethodUsecaseOutput{}, errors.New("shipping method type is not supported")
+	}
+
+	if a.shippingMethodDAO.FindOneByName(input.Name) != nil {
+		return AddShippingMethodUsecaseOutput{}, errors.New("shipping method name already exists")
+	}
+
+	// Only the fields of the method type are kept, so a method never carries rates that do not apply to it.
+	shippingMethodSchema := daos.ShippingMethodSchema{
+		Id:        uuid.New(),
+		Name:      input.Name,
+		Type:      input.Type,
+		Currency:  currency,
+		IsActive:  true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	switch input.Type {
+	case ShippingMethodTypeFlat:
+		shippingMethodSchema.Rate = input.Rate
+	case ShippingMethodTypeWeightBased:
+		shippingMethodSchema.Rate = input.Rate
+		shippingMethodSchema.RatePerKg = input.RatePerKg
+	case ShippingMethodTypeFreeOverThreshold:
+		shippingMethodSchema.Rate = input.Rate
+		shippingMethodSchema.FreeThreshold = input.FreeThreshold
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO shipping_methods (id, name, type, currency, rate, rate_per_kg, free_threshold, is_active, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		shippingMethodSchema.Id, shippingMethodSchema.Name, shippingMethodSchema.Type, shippingMethodSchema.Currency,
+		shippingMethodSchema.Rate, shippingMethodSchema.RatePerKg, shippingMethodSchema.FreeThreshold, shippingMethodSchema.IsActive,
+		shippingMethodSchema.CreatedAt))
+
+	if input.Type == ShippingMethodTypeZone {
+		for _, zoneRate := range input.ZoneRates {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(),
+				"INSERT INTO shipping_zone_rates (id, shipping_method_id, zip_prefix, rate, created_at) VALUES ($1, $2, $3, $4, $5)",
+				uuid.New(), shippingMethodSchema.Id, zoneRate.ZipPrefix, zoneRate.Rate, time.Now().UTC()))
+		}
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return AddShippingMethodUsecaseOutput{
+		ShippingMethodId: shippingMethodSchema.Id,
+	}, nil
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
@@ -10,33 +11,96 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// AddStockUsecaseInput targets either an existing inventory or, when looked up by product, the inventory
+// of the product in the warehouse, which is created on the first restock of that location.
 type AddStockUsecaseInput struct {
-	InventoryId uuid.UUID
-	Stock       int32
+	Inventory InventoryLookup
+	Stock     int32
+	ActorId   uuid.UUID
 }
 
 type AddStockUsecase struct {
 	pgxPool      *pgxpool.Pool
 	inventoryDAO daos.InventoryDAO
+	productDAO   daos.ProductDAO
+	warehouseDAO daos.WarehouseDAO
 }
 
-func NewAddStockUsecase(pgxPool *pgxpool.Pool, inventoryDAO daos.InventoryDAO) AddStockUsecase {
-	return AddStockUsecase{pgxPool, inventoryDAO}
+func NewAddStockUsecase(pgxPool *pgxpool.Pool, inventoryDAO daos.InventoryDAO, productDAO daos.ProductDAO,
+	warehouseDAO daos.WarehouseDAO) AddStockUsecase {
+	return AddStockUsecase{pgxPool, inventoryDAO, productDAO, warehouseDAO}
 }
 
+// Execute restocks the inventory and hands the new stock to pending backorders of the product before it
+// becomes available to new checkouts. Subscribers of products it brings back in stock are queued to be notified.
 func (a *AddStockUsecase) Execute(input AddStockUsecaseInput) error {
 	if input.Stock == 0 {
 		return errors.New("stock quantity must be higher than zero")
 	}
 
-	inventoryExists := a.inventoryDAO.ExistsById(input.InventoryId)
-
-	if !inventoryExists {
+	if input.Inventory.InventoryId != nil && !a.inventoryDAO.ExistsById(*input.Inventory.InventoryId) {
 		return errors.New("inventory not found")
 	}
 
-	_ = utils.GetOrThrow(a.pgxPool.Exec(context.Background(),
-		"UPDATE inventories SET stock_quantity = stock_quantity + $1 WHERE id = $2", input.Stock, input.InventoryId))
+	var productSchema *daos.ProductSchema
+
+	if input.Inventory.InventoryId == nil {
+		productSchema = findLookupProduct(a.productDAO, input.Inventory)
+
+		if productSchema == nil {
+			return errors.New("product not found")
+		}
+
+		if productSchema.IsBundle {
+			return errors.New("bundles have no stock of their own")
+		}
+
+		if input.Inventory.WarehouseId != nil && a.warehouseDAO.FindOneById(*input.Inventory.WarehouseId) == nil {
+			return errors.New("warehouse not found")
+		}
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var inventoryId uuid.UUID
+
+	if input.Inventory.InventoryId != nil {
+		inventoryId = *input.Inventory.InventoryId
+	} else {
+		utils.ThrowOnError(tx.QueryRow(context.Background(),
+			`INSERT INTO inventories (id, product_id, warehouse_id, stock_quantity, created_at)
+			VALUES ($1, $2, COALESCE($3, (SELECT id FROM warehouses WHERE is_default)), 0, $4)
+			ON CONFLICT (product_id, warehouse_id) DO UPDATE SET product_id = EXCLUDED.product_id
+			RETURNING id`,
+			uuid.New(), productSchema.Id, input.Inventory.WarehouseId, time.Now().UTC()).Scan(&inventoryId))
+	}
+
+	var productId uuid.UUID
+	utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT product_id FROM inventories WHERE id = $1", inventoryId).Scan(&productId))
+
+	outOfStockProductIds := outOfStockProductIds(tx, productId)
+
+	_, err := moveStock(tx, inventoryMovement{
+		InventoryId: inventoryId,
+		Reason:      "restock",
+		Quantity:    input.Stock,
+		ActorId:     &input.ActorId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := fillBackorders(tx, inventoryId, &input.ActorId); err != nil {
+		return err
+	}
+
+	queueRestockNotifications(tx, outOfStockProductIds)
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
 
 	return nil
 }
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+type AddWarehouseUsecaseInput struct {
+	Name    string
+	ZipCode *string
+}
+
+type AddWarehouseUsecaseOutput struct {
+	WarehouseId uuid.UUID
+}
+
+type AddWarehouseUsecase struct {
+	warehouseDAO daos.WarehouseDAO
+}
+
+func NewAddWarehouseUsecase(warehouseDAO daos.WarehouseDAO) AddWarehouseUsecase {
+	return AddWarehouseUsecase{warehouseDAO}
+}
+
+func (a *AddWarehouseUsecase) Execute(input AddWarehouseUsecaseInput) (AddWarehouseUsecaseOutput, error) {
+	if utf8.RuneCountInString(input.Name) > 50 {
+		return AddWarehouseUsecaseOutput{}, errors.New("warehouse name cannot exceed 50 characters")
+	}
+
+	if a.warehouseDAO.FindOneByName(input.Name) != nil {
+		return AddWarehouseUsecaseOutput{}, errors.New("warehouse name already exists")
+	}
+
+	warehouseId := uuid.New()
+
+	a.warehouseDAO.Create(daos.WarehouseSchema{
+		Id:        warehouseId,
+		Name:      input.Name,
+		ZipCode:   input.ZipCode,
+		IsDefault: false,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	return AddWarehouseUsecaseOutput{
+		WarehouseId: warehouseId,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+var usStates = []string{"AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "FL", "GA", "HI", "ID", "IL", "IN", "IA", "KS", "KY",
+	"LA", "ME", "MD", "MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH", "NJ", "NM", "NY", "NC", "ND", "OH", "OK", "OR", "PA",
+	"RI", "SC", "SD", "TN", "TX", "UT", "VT", "VA", "WA", "WV", "WI", "WY", "DC"}
+
+type postalAddress struct {
+	City         string
+	State        string
+	ZipCode      string
+	StreetName   string
+	StreetNumber string
+}
+
+// validateAddress checks the address format and that its ZIP code belongs to the given city and state. ZIP code
+// locations are cached in Redis so the external API is called once per ZIP code.
+func validateAddress(redisClient *redis.Client, httpZipCodeGateway gateways.HttpZipCodeGateway, address postalAddress) error {
+	if !slices.Contains(usStates, strings.ToUpper(address.State)) {
+		return errors.New("state must be a valid 2-letter U.S. abbreviation (e.g. NY, CA)")
+	}
+
+	zipRegex := regexp.MustCompile(`^[0-9]{5}$`)
+
+	if !zipRegex.MatchString(address.ZipCode) {
+		return errors.New("ZIP code is invalid. It must be 5 digits (e.g. 12345)")
+	}
+
+	if _, err := strconv.ParseUint(address.StreetNumber, 10, 64); err != nil {
+		return errors.New("street number must contain only digits (0-9)")
+	}
+
+	type Location struct {
+		City  string `json:"city"`
+		State string `json:"state"`
+	}
+	var location *Location = nil
+
+	cachedZipCode, err := redisClient.Get(context.Background(), "zip_codes:"+address.ZipCode).Result()
+	if err != nil && err.Error() != "redis: nil" {
+		return err
+	}
+
+	if cachedZipCode == "" {
+		httpZipCodeResponse := utils.GetOrThrow(httpZipCodeGateway.Get(address.ZipCode))
+
+		if httpZipCodeResponse != nil {
+			location = &Location{
+				City:  httpZipCodeResponse.City,
+				State: httpZipCodeResponse.State,
+			}
+
+			locationJson := utils.GetOrThrow(json.Marshal(location))
+			utils.ThrowOnError(redisClient.Set(context.Background(), "zip_codes:"+address.ZipCode, string(locationJson), 0).Err())
+		}
+	} else {
+		var locationJson Location
+		utils.ThrowOnError(json.Unmarshal([]byte(cachedZipCode), &locationJson))
+
+		location = &Location{
+			City:  locationJson.City,
+			State: locationJson.State,
+		}
+	}
+
+	if location == nil {
+		return errors.New("ZIP code does not match any location")
+	}
+
+	if location.City != address.City || location.State != address.State {
+		return errors.New("ZIP code location does not match with provided city and state")
+	}
+
+	return nil
+}
+
+func formatAddressLine(address postalAddress) string {
+	return fmt.Sprintf("%s %s, %s, %s %s", address.StreetNumber, address.StreetName, address.City, address.State, address.ZipCode)
+}
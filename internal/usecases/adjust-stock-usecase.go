@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdjustStockUsecaseInput carries a signed delta: positive values correct counting errors upwards and
+// negative values write off shrinkage. Damage can only ever take stock out.
+type AdjustStockUsecaseInput struct {
+	Inventory InventoryLookup
+	Quantity  int32
+	Reason    string
+	Note      string
+	ActorId   uuid.UUID
+}
+
+type AdjustStockUsecase struct {
+	pgxPool      *pgxpool.Pool
+	inventoryDAO daos.InventoryDAO
+	productDAO   daos.ProductDAO
+}
+
+func NewAdjustStockUsecase(pgxPool *pgxpool.Pool, inventoryDAO daos.InventoryDAO, productDAO daos.ProductDAO) AdjustStockUsecase {
+	return AdjustStockUsecase{pgxPool, inventoryDAO, productDAO}
+}
+
+func (a *AdjustStockUsecase) Execute(input AdjustStockUsecaseInput) error {
+	if input.Quantity == 0 {
+		return errors.New("adjustment quantity cannot be zero")
+	}
+
+	if input.Reason != "adjustment" && input.Reason != "damage" {
+		return errors.New("reason must be adjustment or damage")
+	}
+
+	if input.Reason == "damage" && input.Quantity > 0 {
+		return errors.New("damage can only decrease stock")
+	}
+
+	if utf8.RuneCountInString(input.Note) > 500 {
+		return errors.New("note cannot exceed 500 characters")
+	}
+
+	inventoryId, err := findInventoryId(a.inventoryDAO, a.productDAO, input.Inventory)
+	if err != nil {
+		return err
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_, err = moveStock(tx, inventoryMovement{
+		InventoryId: inventoryId,
+		Reason:      input.Reason,
+		Quantity:    input.Quantity,
+		ActorId:     &input.ActorId,
+		Note:        &input.Note,
+	})
+	if err != nil {
+		return err
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApplyCouponUsecaseInput struct {
+	CustomerId uuid.UUID
+	Code       string
+}
+
+type ApplyCouponUsecase struct {
+	pgxPool        *pgxpool.Pool
+	cartDAO        daos.CartDAO
+	promotionDAO   daos.PromotionDAO
+	pricingService PricingService
+}
+
+func NewApplyCouponUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, promotionDAO daos.PromotionDAO,
+	pricingService PricingService) ApplyCouponUsecase {
+	return ApplyCouponUsecase{pgxPool, cartDAO, promotionDAO, pricingService}
+}
+
+// Execute applies the coupon to the cart, replacing the one applied before. The coupon has to apply to the cart
+// as it is now; it is checked again whenever the cart is priced.
+func (a *ApplyCouponUsecase) Execute(input ApplyCouponUsecaseInput) error {
+	promotionSchema := a.promotionDAO.FindOneByCode(input.Code)
+
+	if promotionSchema == nil {
+		return errors.New("coupon not found")
+	}
+
+	cartSchema := a.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	lines := cartPricingLines(FindCartLines(a.pgxPool, cartSchema.Id))
+
+	if _, err := a.pricingService.Discount(a.pgxPool, *promotionSchema, input.CustomerId, cartSchema.Currency, lines); err != nil {
+		return err
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE carts SET promotion_id = $1 WHERE id = $2", promotionSchema.Id, cartSchema.Id))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ApplyStockCountUsecaseInput struct {
+	StockCountId uuid.UUID
+	ActorId      uuid.UUID
+}
+
+type ApplyStockCountUsecase struct {
+	pgxPool       *pgxpool.Pool
+	stockCountDAO daos.StockCountDAO
+}
+
+func NewApplyStockCountUsecase(pgxPool *pgxpool.Pool, stockCountDAO daos.StockCountDAO) ApplyStockCountUsecase {
+	return ApplyStockCountUsecase{pgxPool, stockCountDAO}
+}
+
+// Execute applies the variance between the counted and the expected quantities as a "count" movement.
+// The variance is applied as a delta rather than overwriting the stock, so sales and restocks that
+// happened while the count was in progress are preserved.
+func (a *ApplyStockCountUsecase) Execute(input ApplyStockCountUsecaseInput) error {
+	stockCountSchema := a.stockCountDAO.FindOneById(input.StockCountId)
+
+	if stockCountSchema == nil {
+		return errors.New("stock count not found")
+	}
+
+	tx := utils.GetOrThrow(a.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	// Locking the count serializes concurrent submissions and applications of the same count.
+	var status string
+	utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT status FROM stock_counts WHERE id = $1 FOR UPDATE", input.StockCountId).
+		Scan(&status))
+
+	if status != "submitted" {
+		return errors.New("stock count must be submitted before it is applied")
+	}
+
+	for _, item := range a.stockCountDAO.FindAllItemsByStockCountId(input.StockCountId) {
+		if item.CountedQuantity == nil || *item.CountedQuantity == item.ExpectedQuantity {
+			continue
+		}
+
+		_, err := moveStock(tx, inventoryMovement{
+			InventoryId: item.InventoryId,
+			Reason:      "count",
+			Quantity:    *item.CountedQuantity - item.ExpectedQuantity,
+			ActorId:     &input.ActorId,
+			ReferenceId: &input.StockCountId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE stock_counts SET status = $1, applied_by = $2, applied_at = $3 WHERE id = $4",
+		"applied", input.ActorId, time.Now().UTC(), input.StockCountId))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// sellableQuantity returns how many units of a product customers can buy: the stock on hand plus, for
+// products sold on backorder or pre-order, what is left of the backorder limit.
+func sellableQuantity(productSchema daos.ProductSchema, stockQuantity int32, backorderedQuantity int32) int32 {
+	if !productSchema.AllowsBackorder && !productSchema.AllowsPreorder {
+		return stockQuantity
+	}
+
+	return max(stockQuantity, 0) + max(productSchema.BackorderLimit-backorderedQuantity, 0)
+}
+
+// lockBackorderPolicy locks the product row so concurrent checkouts cannot oversell its backorder limit, and
+// returns its backorder policy along with the units already waiting for stock.
+func lockBackorderPolicy(tx pgx.Tx, productId uuid.UUID) (daos.ProductSchema, int32) {
+	productSchema := daos.ProductSchema{Id: productId}
+	var backorderedQuantity int32
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		`SELECT p.allows_backorder, p.allows_preorder, p.backorder_limit, p.expected_ship_date,
+		(SELECT COALESCE(SUM(oi.backordered_quantity), 0)::INT FROM order_items oi WHERE oi.product_id = p.id)
+		FROM products p WHERE p.id = $1 FOR UPDATE`, productId).
+		Scan(&productSchema.AllowsBackorder, &productSchema.AllowsPreorder, &productSchema.BackorderLimit,
+			&productSchema.ExpectedShipDate, &backorderedQuantity))
+
+	return productSchema, backorderedQuantity
+}
+
+// fillBackorders allocates the stock of an inventory to the order items waiting for its product, oldest
+// order first, so customers who bought on backorder or pre-order are served before new checkouts.
+func fillBackorders(tx pgx.Tx, inventoryId uuid.UUID, actorId *uuid.UUID) error {
+	var productId, warehouseId uuid.UUID
+	var stockQuantity int32
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		"SELECT product_id, warehouse_id, stock_quantity FROM inventories WHERE id = $1 FOR UPDATE", inventoryId).
+		Scan(&productId, &warehouseId, &stockQuantity))
+
+	lockBackorderPolicy(tx, productId)
+
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`SELECT id, order_id, backordered_quantity FROM order_items
+		WHERE product_id = $1 AND backordered_quantity > 0
+		ORDER BY created_at, id FOR UPDATE`, productId))
+
+	type schema struct {
+		OrderItemId         uuid.UUID
+		OrderId             uuid.UUID
+		BackorderedQuantity int32
+	}
+
+	records := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.OrderItemId, &item.OrderId, &item.BackorderedQuantity))
+		records = append(records, item)
+	}
+
+	for _, record := range records {
+		if stockQuantity == 0 {
+			break
+		}
+
+		quantity := min(record.BackorderedQuantity, stockQuantity)
+
+		balance, err := moveStock(tx, inventoryMovement{
+			InventoryId: inventoryId,
+			Reason:      "sale",
+			Quantity:    -quantity,
+			ActorId:     actorId,
+			ReferenceId: &record.OrderId,
+		})
+		if err != nil {
+			return err
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO order_item_allocations (id, order_item_id, inventory_id, warehouse_id, quantity, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			uuid.New(), record.OrderItemId, inventoryId, warehouseId, quantity, time.Now().UTC()))
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE order_items SET backordered_quantity = backordered_quantity - $1 WHERE id = $2", quantity, record.OrderItemId))
+
+		stockQuantity = balance
+	}
+
+	return nil
+}
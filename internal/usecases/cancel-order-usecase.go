@@ -0,0 +1,134 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	OrderStatusPlaced    = "placed"
+	OrderStatusFulfilled = "fulfilled"
+	OrderStatusCancelled = "cancelled"
+)
+
+type CancelOrderUsecaseInput struct {
+	OrderId uuid.UUID
+	ActorId uuid.UUID
+	// AsAdmin allows cancelling the orders of any customer, at any stage. Otherwise the actor must be the
+	// customer who placed the order, and it must not be fulfilled yet.
+	AsAdmin bool
+}
+
+type CancelOrderUsecaseOutput struct {
+	RefundedAmount int64
+}
+
+type CancelOrderUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewCancelOrderUsecase(pgxPool *pgxpool.Pool) CancelOrderUsecase {
+	return CancelOrderUsecase{pgxPool}
+}
+
+// Execute cancels the order, puts the stock it took back into the warehouses it was taken from and refunds what
+// is left of its payment. Returned stock goes to backorders waiting for it first, as any other incoming stock.
+func (c *CancelOrderUsecase) Execute(input CancelOrderUsecaseInput) (CancelOrderUsecaseOutput, error) {
+	tx := utils.GetOrThrow(c.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var customerId uuid.UUID
+	var status, currency string
+	var totalPrice int64
+
+	err := tx.QueryRow(context.Background(), "SELECT customer_id, status, currency, total_price FROM orders WHERE id = $1 FOR UPDATE",
+		input.OrderId).Scan(&customerId, &status, &currency, &totalPrice)
+	if err == pgx.ErrNoRows {
+		return CancelOrderUsecaseOutput{}, errors.New("order not found")
+	}
+	utils.ThrowOnError(err)
+
+	if !input.AsAdmin && customerId != input.ActorId {
+		return CancelOrderUsecaseOutput{}, errors.New("order not found")
+	}
+
+	if status == OrderStatusCancelled {
+		return CancelOrderUsecaseOutput{}, errors.New("order is already cancelled")
+	}
+
+	if status == OrderStatusFulfilled && !input.AsAdmin {
+		return CancelOrderUsecaseOutput{}, errors.New("order cannot be cancelled after it is fulfilled")
+	}
+
+	var hasReturnRequests bool
+
+	// Returned units are restocked and refunded by their return request, so they must not be cancelled again.
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		`SELECT EXISTS (SELECT 1 FROM return_requests rr JOIN order_items oi ON oi.id = rr.order_item_id
+		WHERE oi.order_id = $1 AND rr.status <> $2)`, input.OrderId, ReturnRequestStatusRejected).Scan(&hasReturnRequests))
+
+	if hasReturnRequests {
+		return CancelOrderUsecaseOutput{}, errors.New("order has return requests")
+	}
+
+	// Units still waiting for stock are dropped first, so the returned stock does not go back to this order.
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE order_items SET backordered_quantity = 0 WHERE order_id = $1",
+		input.OrderId))
+
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`SELECT a.inventory_id, SUM(a.quantity)::INT FROM order_item_allocations a
+		JOIN order_items oi ON oi.id = a.order_item_id
+		WHERE oi.order_id = $1
+		GROUP BY a.inventory_id ORDER BY a.inventory_id`, input.OrderId))
+
+	type schema struct {
+		InventoryId uuid.UUID
+		Quantity    int32
+	}
+
+	allocations := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.InventoryId, &item.Quantity))
+		allocations = append(allocations, item)
+	}
+
+	for _, allocation := range allocations {
+		_, err := moveStock(tx, inventoryMovement{
+			InventoryId: allocation.InventoryId,
+			Reason:      "cancellation",
+			Quantity:    allocation.Quantity,
+			ActorId:     &input.ActorId,
+			ReferenceId: &input.OrderId,
+		})
+		if err != nil {
+			return CancelOrderUsecaseOutput{}, err
+		}
+
+		if err := fillBackorders(tx, allocation.InventoryId, &input.ActorId); err != nil {
+			return CancelOrderUsecaseOutput{}, err
+		}
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		"UPDATE orders SET status = $1, cancelled_at = $2, cancelled_by = $3 WHERE id = $4",
+		OrderStatusCancelled, time.Now().UTC(), input.ActorId, input.OrderId))
+
+	refundedAmount := refundOrderPayment(tx, input.OrderId, totalPrice, currency, "cancellation", &input.ActorId)
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return CancelOrderUsecaseOutput{
+		RefundedAmount: refundedAmount,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// touchCart bumps the cart version, so clients holding the previous ETag get 412 on their next batch update,
+// and records the activity so the cart is not reminded as abandoned. Every change to a cart or its items must
+// call it in the same transaction.
+func touchCart(tx pgx.Tx, cartId uuid.UUID) int32 {
+	var version int32
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		"UPDATE carts SET version = version + 1, last_activity_at = $1 WHERE id = $2 RETURNING version", time.Now().UTC(), cartId).
+		Scan(&version))
+
+	return version
+}
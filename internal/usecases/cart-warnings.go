@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	CartWarningPriceChanged      = "price_changed"
+	CartWarningInsufficientStock = "insufficient_stock"
+	CartWarningUnavailable       = "unavailable"
+)
+
+// CartWarning tells the customer that a cart line changed since it was added. Price and PriceAtAdd are set
+// for price changes, AvailableQuantity for insufficient stock.
+type CartWarning struct {
+	ProductId         uuid.UUID
+	Type              string
+	Quantity          int32
+	PriceAtAdd        *int64
+	Price             *int64
+	AvailableQuantity *int32
+}
+
+// pgxQuerier is satisfied by both a pool and a transaction.
+type pgxQuerier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// CartLine is a line of a cart or guest cart, with its product and the product price in the cart currency. Price is
+// nil when the product is not priced in that currency.
+type CartLine struct {
+	ItemId              uuid.UUID
+	ProductId           uuid.UUID
+	Quantity            int32
+	PriceAtAdd          *int64
+	Price               *int64
+	Product             daos.ProductSchema
+	StockQuantity       int32
+	BackorderedQuantity int32
+}
+
+const cartLinesQuery = `
+	SELECT
+		i.id,
+		i.product_id,
+		i.quantity,
+		i.price_at_add,
+		COALESCE(pp.price, CASE WHEN p.currency = c.currency THEN p.price END),
+		p.name,
+		p.description,
+		p.tax_category,
+		p.status,
+		p.allows_backorder,
+		p.allows_preorder,
+		p.backorder_limit,
+		(SELECT COALESCE(SUM(s.stock_quantity), 0)::INT FROM product_available_stock s WHERE s.product_id = p.id),
+		(SELECT COALESCE(SUM(oi.backordered_quantity), 0)::INT FROM order_items oi WHERE oi.product_id = p.id)
+	FROM %[1]s c
+	JOIN %[2]s i
+		ON i.%[3]s = c.id
+	JOIN products p
+		ON p.id = i.product_id
+	LEFT JOIN product_prices pp
+		ON pp.product_id = p.id AND pp.currency = c.currency
+	WHERE c.id = $1
+	ORDER BY i.created_at, i.id
+`
+
+// FindCartLines returns the lines of a cart in the order they were added.
+func FindCartLines(querier pgxQuerier, cartId uuid.UUID) []CartLine {
+	return findCartLines(querier, fmt.Sprintf(cartLinesQuery, "carts", "cart_items", "cart_id"), cartId)
+}
+
+// FindGuestCartLines returns the lines of a guest cart in the order they were added.
+func FindGuestCartLines(querier pgxQuerier, guestCartId uuid.UUID) []CartLine {
+	return findCartLines(querier, fmt.Sprintf(cartLinesQuery, "guest_carts", "guest_cart_items", "guest_cart_id"), guestCartId)
+}
+
+func findCartLines(querier pgxQuerier, query string, cartId uuid.UUID) []CartLine {
+	rows := utils.GetOrThrow(querier.Query(context.Background(), query, cartId))
+
+	lines := []CartLine{}
+	for rows.Next() {
+		var line CartLine
+
+		utils.ThrowOnError(rows.Scan(&line.ItemId, &line.ProductId, &line.Quantity, &line.PriceAtAdd, &line.Price, &line.Product.Name,
+			&line.Product.Description, &line.Product.TaxCategory, &line.Product.Status, &line.Product.AllowsBackorder,
+			&line.Product.AllowsPreorder, &line.Product.BackorderLimit, &line.StockQuantity, &line.BackorderedQuantity))
+
+		line.Product.Id = line.ProductId
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// available tells whether the product on the line can still be bought in the cart currency.
+func (l CartLine) available() bool {
+	return l.Product.Status != "unpublished" && l.Price != nil
+}
+
+// warnings returns what changed on the line since it was added. A product that can no longer be bought only
+// gets the unavailable warning.
+func (l CartLine) warnings() []CartWarning {
+	if !l.available() {
+		return []CartWarning{{ProductId: l.ProductId, Type: CartWarningUnavailable, Quantity: l.Quantity}}
+	}
+
+	warnings := []CartWarning{}
+	availableQuantity := max(sellableQuantity(l.Product, l.StockQuantity, l.BackorderedQuantity), 0)
+
+	if l.Quantity > availableQuantity {
+		warnings = append(warnings, CartWarning{
+			ProductId:         l.ProductId,
+			Type:              CartWarningInsufficientStock,
+			Quantity:          l.Quantity,
+			AvailableQuantity: &availableQuantity,
+		})
+	}
+
+	if l.PriceAtAdd != nil && *l.PriceAtAdd != *l.Price {
+		warnings = append(warnings, CartWarning{
+			ProductId:  l.ProductId,
+			Type:       CartWarningPriceChanged,
+			Quantity:   l.Quantity,
+			PriceAtAdd: l.PriceAtAdd,
+			Price:      l.Price,
+		})
+	}
+
+	return warnings
+}
+
+// FindCartWarnings returns a warning for each cart line whose price, stock or availability changed since it was
+// added. Checkout is refused while there are any.
+func FindCartWarnings(querier pgxQuerier, cartId uuid.UUID) []CartWarning {
+	warnings := []CartWarning{}
+
+	for _, line := range FindCartLines(querier, cartId) {
+		warnings = append(warnings, line.warnings()...)
+	}
+
+	return warnings
+}
@@ -2,11 +2,13 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mercadopago/sdk-go/pkg/config"
 )
@@ -23,14 +25,24 @@ type CheckoutPostpaymentUsecase struct {
 	cartDAO           daos.CartDAO
 	cartItemDAO       daos.CartItemDAO
 	inventoryDAO      daos.InventoryDAO
+	addressDAO        daos.AddressDAO
+	allocation        WarehouseAllocationStrategy
+	pricingService    PricingService
 }
 
 func NewCheckoutPostpaymentUsecase(mercadoPagoConfig *config.Config, pgxPool *pgxpool.Pool, cartDAO daos.CartDAO,
-	cartItemDAO daos.CartItemDAO, inventoryDAO daos.InventoryDAO) CheckoutPostpaymentUsecase {
-	return CheckoutPostpaymentUsecase{mercadoPagoConfig, pgxPool, cartDAO, cartItemDAO, inventoryDAO}
+	cartItemDAO daos.CartItemDAO, inventoryDAO daos.InventoryDAO, addressDAO daos.AddressDAO,
+	allocation WarehouseAllocationStrategy, pricingService PricingService) CheckoutPostpaymentUsecase {
+	return CheckoutPostpaymentUsecase{mercadoPagoConfig, pgxPool, cartDAO, cartItemDAO, inventoryDAO, addressDAO, allocation, pricingService}
 }
 
 func (c *CheckoutPostpaymentUsecase) Execute(input CheckoutPostpaymentUsecaseInput) error {
+	addressSchema := c.addressDAO.FindOneByIdAndCustomerId(input.AddressId, input.CustomerId)
+
+	if addressSchema == nil {
+		return errors.New("address not found")
+	}
+
 	rows := utils.GetOrThrow(c.pgxPool.Query(context.Background(),
 		`
 			SELECT
@@ -40,12 +52,17 @@ func (c *CheckoutPostpaymentUsecase) Execute(input CheckoutPostpaymentUsecaseInp
 				p.id AS product_id,
 				p.name AS product_name,
 				p.description AS product_description,
-				p.price AS product_price
+				p.is_bundle AS product_is_bundle,
+				p.tax_category AS product_tax_category,
+				COALESCE(pp.price, CASE WHEN p.currency = c.currency THEN p.price END) AS product_price,
+				c.currency AS cart_currency
 			FROM carts c
 			JOIN cart_items ci
 				ON ci.cart_id = c.id
 			JOIN products p
 				ON ci.product_id = p.id
+			LEFT JOIN product_prices pp
+				ON pp.product_id = p.id AND pp.currency = c.currency
 			WHERE c.customer_id = $1
 		`, input.CustomerId))
 
@@ -56,7 +73,10 @@ func (c *CheckoutPostpaymentUsecase) Execute(input CheckoutPostpaymentUsecaseInp
 		CartItemQuantity   int32
 		ProductName        string
 		ProductDescription *string
-		ProductPrice       int64
+		ProductIsBundle    bool
+		ProductTaxCategory string
+		ProductPrice       *int64
+		CartCurrency       string
 	}
 
 	records := []schema{}
@@ -64,46 +84,268 @@ func (c *CheckoutPostpaymentUsecase) Execute(input CheckoutPostpaymentUsecaseInp
 		var item schema
 
 		utils.ThrowOnError(rows.Scan(&item.CartId, &item.CartItemId, &item.CartItemQuantity,
-			&item.ProductId, &item.ProductName, &item.ProductDescription, &item.ProductPrice))
+			&item.ProductId, &item.ProductName, &item.ProductDescription, &item.ProductIsBundle, &item.ProductTaxCategory, &item.ProductPrice,
+			&item.CartCurrency))
 
 		records = append(records, item)
 	}
 
+	for _, record := range records {
+		if record.ProductPrice == nil {
+			return errors.New("some products in the cart are not priced in the cart currency")
+		}
+	}
+
 	tx := utils.GetOrThrow(c.pgxPool.Begin(context.Background()))
 
 	defer func() {
 		_ = tx.Rollback(context.Background())
 	}()
 
+	orderId := uuid.New()
 	totalQuantity := int32(0)
-	totalPrice := int64(0)
+	pricingLines := []PricingLine{}
 
 	for _, record := range records {
 		totalQuantity += record.CartItemQuantity
-		totalPrice += record.ProductPrice * int64(record.CartItemQuantity)
+		pricingLines = append(pricingLines, PricingLine{
+			ProductId:   record.ProductId,
+			TaxCategory: record.ProductTaxCategory,
+			Quantity:    record.CartItemQuantity,
+			UnitPrice:   *record.ProductPrice,
+		})
+	}
 
-		_ = utils.GetOrThrow(tx.Exec(context.Background(),
-			"UPDATE inventories SET stock_quantity = stock_quantity - $1 WHERE product_id = $2", record.CartItemQuantity, record.ProductId))
+	cartSchema := c.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	// The coupon row is locked so concurrent orders cannot use it beyond its usage limits.
+	if cartSchema.PromotionId != nil {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "SELECT id FROM promotions WHERE id = $1 FOR UPDATE", *cartSchema.PromotionId))
 	}
 
-	orderId := uuid.New()
+	destination := TaxDestination{State: addressSchema.State, ZipCode: addressSchema.ZipCode}
+
+	// The payment is already taken, so a method that stopped shipping to the address since the preference was
+	// created leaves the order without one rather than failing it.
+	pricing, err := c.pricingService.Price(tx, input.CustomerId, records[0].CartCurrency, cartSchema.PromotionId,
+		cartSchema.ShippingMethodId, &destination, pricingLines)
+	if err != nil {
+		return err
+	}
+
+	// The customer paid what the cart was quoted at when its payment preference was created, so the order keeps
+	// that discount and total. A cart that prices differently by now, like one whose coupon no longer applies, is
+	// flagged with what it would cost instead.
+	totalPrice := pricing.Total
+	discounts := pricing.Discounts
+	var repricedTotal *int64
+
+	if quote := takeCheckoutQuote(tx, cartSchema.Id); quote != nil {
+		totalPrice = quote.TotalPrice
+		discounts = quote.Discounts
+
+		if pricing.Total != quote.TotalPrice {
+			repricedTotal = &pricing.Total
+		}
+	}
+
+	var shippingMethodId *uuid.UUID
+	var shippingMethodName *string
+
+	if pricing.ShippingMethod != nil {
+		shippingMethodId = &pricing.ShippingMethod.ShippingMethodId
+		shippingMethodName = &pricing.ShippingMethod.Name
+	}
 
 	_ = utils.GetOrThrow(tx.Exec(context.Background(),
-		"INSERT INTO orders (id, customer_id, total_price, total_quantity, created_at) VALUES ($1, $2, $3, $4, $5)",
-		orderId, input.CustomerId, totalPrice, totalQuantity, time.Now().UTC()))
+		`INSERT INTO orders (id, customer_id, total_price, tax_amount, shipping_method_id, shipping_method_name, shipping_price,
+		total_quantity, currency, created_at, repriced_total)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		orderId, input.CustomerId, totalPrice, pricing.Tax, shippingMethodId, shippingMethodName, pricing.Shipping, totalQuantity,
+		records[0].CartCurrency, time.Now().UTC(), repricedTotal))
+
+	for _, discount := range discounts {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO order_discounts (id, order_id, promotion_id, code, type, amount, currency, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			uuid.New(), orderId, discount.PromotionId, discount.Code, discount.Type, discount.Amount, pricing.Currency, time.Now().UTC()))
+	}
 
 	for _, record := range records {
+		orderItemId := uuid.New()
+
+		if record.ProductIsBundle {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(),
+				"INSERT INTO order_items (id, order_id, product_id, quantity, price, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+				orderItemId, orderId, record.ProductId, record.CartItemQuantity, *record.ProductPrice, record.CartCurrency, time.Now().UTC()))
+			recordOrderItemTaxes(tx, orderItemId, record.ProductId, pricing)
+
+			err := c.shipBundleComponents(tx, orderId, orderItemId, record.ProductId, record.CartItemQuantity, record.CartCurrency,
+				addressSchema.ZipCode, &input.CustomerId)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		warehouses, stockQuantity := lockWarehouseStock(tx, record.ProductId)
+
+		backorderedQuantity := int32(0)
+		var expectedShipDate *time.Time
+
+		if record.CartItemQuantity > stockQuantity {
+			productSchema, pendingBackorderedQuantity := lockBackorderPolicy(tx, record.ProductId)
+
+			if record.CartItemQuantity > sellableQuantity(productSchema, stockQuantity, pendingBackorderedQuantity) {
+				return errors.New("product quantity exceeds the stock available")
+			}
+
+			backorderedQuantity = record.CartItemQuantity - stockQuantity
+			expectedShipDate = productSchema.ExpectedShipDate
+		}
+
 		_ = utils.GetOrThrow(tx.Exec(context.Background(),
-			"INSERT INTO order_items (id, order_id, product_id, quantity, price, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
-			uuid.New(), orderId, record.ProductId, record.CartItemQuantity, record.ProductPrice, time.Now().UTC()))
+			`INSERT INTO order_items (id, order_id, product_id, quantity, price, currency, created_at, backordered_quantity, expected_ship_date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			orderItemId, orderId, record.ProductId, record.CartItemQuantity, *record.ProductPrice, record.CartCurrency, time.Now().UTC(),
+			backorderedQuantity, expectedShipDate))
+		recordOrderItemTaxes(tx, orderItemId, record.ProductId, pricing)
+
+		if record.CartItemQuantity == backorderedQuantity {
+			continue
+		}
+
+		err := shipOrderItem(tx, c.allocation, warehouses, addressSchema.ZipCode, orderId, orderItemId,
+			record.CartItemQuantity-backorderedQuantity, &input.CustomerId)
+		if err != nil {
+			return err
+		}
 	}
 
 	_ = utils.GetOrThrow(tx.Exec(context.Background(),
 		"INSERT INTO payments (id, order_id, payment_gateway_name, payment_gateway_transaction_id, created_at) VALUES ($1, $2, $3, $4, $5)",
 		uuid.New(), orderId, "mercado_pago", input.PaymentGatewayTransactionId, time.Now().UTC()))
 
+	// The order recovers the cart, so it is credited to the reminders sent since the previous order.
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE cart_reminders SET order_id = $1 WHERE cart_id = $2 AND order_id IS NULL",
+		orderId, records[0].CartId))
+
 	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE cart_id = $1", records[0].CartId))
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE carts SET promotion_id = NULL, shipping_method_id = NULL WHERE id = $1", records[0].CartId))
+	touchCart(tx, records[0].CartId)
+
+	// The guest cart checked out as this customer is done with once its order is placed.
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM guest_carts WHERE customer_id = $1", input.CustomerId))
 	utils.ThrowOnError(tx.Commit(context.Background()))
 
 	return nil
 }
+
+// shipBundleComponents records a component line under the bundle order item for each of its components and
+// takes their stock out, so a bundle ships only when every component is in stock.
+func (c *CheckoutPostpaymentUsecase) shipBundleComponents(tx pgx.Tx, orderId uuid.UUID, bundleOrderItemId uuid.UUID,
+	bundleProductId uuid.UUID, bundleQuantity int32, currency string, shippingZipCode string, actorId *uuid.UUID) error {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		"SELECT component_product_id, quantity FROM bundle_components WHERE bundle_product_id = $1 ORDER BY component_product_id",
+		bundleProductId))
+
+	type schema struct {
+		ProductId uuid.UUID
+		Quantity  int32
+	}
+
+	components := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.Quantity))
+		components = append(components, item)
+	}
+
+	for _, component := range components {
+		componentOrderItemId := uuid.New()
+		quantity := component.Quantity * bundleQuantity
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO order_items (id, order_id, product_id, quantity, price, currency, created_at, parent_order_item_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			componentOrderItemId, orderId, component.ProductId, quantity, 0, currency, time.Now().UTC(), bundleOrderItemId))
+
+		warehouses, _ := lockWarehouseStock(tx, component.ProductId)
+
+		if err := shipOrderItem(tx, c.allocation, warehouses, shippingZipCode, orderId, componentOrderItemId, quantity, actorId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordOrderItemTaxes keeps the tax charged on an order item, so the order shows what was charged even after
+// the rates change.
+func recordOrderItemTaxes(tx pgx.Tx, orderItemId uuid.UUID, productId uuid.UUID, pricing Pricing) {
+	for _, taxLine := range pricing.Taxes {
+		if taxLine.ProductId != productId {
+			continue
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO order_item_taxes (id, order_item_id, jurisdiction, tax_category, rate_basis_points, taxable_amount, amount,
+			currency, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			uuid.New(), orderItemId, taxLine.Jurisdiction, taxLine.TaxCategory, taxLine.RateBasisPoints, taxLine.TaxableAmount,
+			taxLine.Amount, pricing.Currency, time.Now().UTC()))
+	}
+}
+
+// lockWarehouseStock locks the inventories of a product until the transaction ends, and returns them along
+// with their total stock.
+func lockWarehouseStock(tx pgx.Tx, productId uuid.UUID) ([]WarehouseStock, int32) {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`SELECT i.id, i.warehouse_id, w.zip_code, i.stock_quantity FROM inventories i
+		JOIN warehouses w ON w.id = i.warehouse_id
+		WHERE i.product_id = $1 ORDER BY i.id FOR UPDATE OF i`, productId))
+
+	warehouses := []WarehouseStock{}
+	stockQuantity := int32(0)
+	for rows.Next() {
+		var item WarehouseStock
+
+		utils.ThrowOnError(rows.Scan(&item.InventoryId, &item.WarehouseId, &item.ZipCode, &item.StockQuantity))
+		warehouses = append(warehouses, item)
+		stockQuantity += item.StockQuantity
+	}
+
+	return warehouses, stockQuantity
+}
+
+// shipOrderItem takes the quantity of an order item out of the warehouses picked by the allocation strategy
+// and records which warehouses it ships from.
+func shipOrderItem(tx pgx.Tx, strategy WarehouseAllocationStrategy, warehouses []WarehouseStock, shippingZipCode string,
+	orderId uuid.UUID, orderItemId uuid.UUID, quantity int32, actorId *uuid.UUID) error {
+	allocations, err := allocateStock(strategy, warehouses, shippingZipCode, quantity)
+	if err != nil {
+		return err
+	}
+
+	for _, allocation := range allocations {
+		_, err := moveStock(tx, inventoryMovement{
+			InventoryId: allocation.InventoryId,
+			Reason:      "sale",
+			Quantity:    -allocation.Quantity,
+			ActorId:     actorId,
+			ReferenceId: &orderId,
+		})
+		if err != nil {
+			return err
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO order_item_allocations (id, order_item_id, inventory_id, warehouse_id, quantity, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			uuid.New(), orderItemId, allocation.InventoryId, allocation.WarehouseId, allocation.Quantity, time.Now().UTC()))
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package usecases
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mercadopago/sdk-go/pkg/preference"
@@ -11,6 +14,9 @@ import (
 
 type CheckoutPrepaymentInput struct {
 	CustomerId uuid.UUID
+	// AddressId is where the order ships to, which decides its shipping and tax. The default address is used when
+	// it is nil.
+	AddressId *uuid.UUID
 }
 
 type CheckoutPrepaymentOutput struct {
@@ -18,12 +24,16 @@ type CheckoutPrepaymentOutput struct {
 }
 
 type CheckoutPrepayment struct {
-	pgxPool          *pgxpool.Pool
-	preferenceClient preference.Client
+	pgxPool        *pgxpool.Pool
+	paymentGateway gateways.PaymentGateway
+	cartDAO        daos.CartDAO
+	addressDAO     daos.AddressDAO
+	pricingService PricingService
 }
 
-func NewCheckoutPrepayment(pgxPool *pgxpool.Pool, preferenceClient preference.Client) CheckoutPrepayment {
-	return CheckoutPrepayment{pgxPool, preferenceClient}
+func NewCheckoutPrepayment(pgxPool *pgxpool.Pool, paymentGateway gateways.PaymentGateway, cartDAO daos.CartDAO, addressDAO daos.AddressDAO,
+	pricingService PricingService) CheckoutPrepayment {
+	return CheckoutPrepayment{pgxPool, paymentGateway, cartDAO, addressDAO, pricingService}
 }
 
 func (c *CheckoutPrepayment) Execute(input CheckoutPrepaymentInput) (CheckoutPrepaymentOutput, error) {
@@ -36,12 +46,19 @@ func (c *CheckoutPrepayment) Execute(input CheckoutPrepaymentInput) (CheckoutPre
 				p.id AS product_id,
 				p.name AS product_name,
 				p.description AS product_description,
-				p.price AS product_price
+				p.tax_category AS product_tax_category,
+				COALESCE(pp.price, CASE WHEN p.currency = c.currency THEN p.price END) AS product_price,
+				c.currency AS cart_currency,
+				p.max_per_order AS product_max_per_order,
+				p.max_per_customer AS product_max_per_customer,
+				p.max_per_customer_period_days AS product_max_per_customer_period_days
 			FROM carts c
 			JOIN cart_items ci
 				ON ci.cart_id = c.id
 			JOIN products p
 				ON ci.product_id = p.id
+			LEFT JOIN product_prices pp
+				ON pp.product_id = p.id AND pp.currency = c.currency
 			WHERE c.customer_id = $1
 		`, input.CustomerId))
 
@@ -52,34 +69,168 @@ func (c *CheckoutPrepayment) Execute(input CheckoutPrepaymentInput) (CheckoutPre
 		CartItemQuantity   int32
 		ProductName        string
 		ProductDescription *string
-		ProductPrice       int64
+		ProductTaxCategory string
+		ProductPrice       *int64
+		CartCurrency       string
+
+		ProductMaxPerOrder              *int32
+		ProductMaxPerCustomer           *int32
+		ProductMaxPerCustomerPeriodDays *int32
 	}
 
 	records := []schema{}
 	for rows.Next() {
 		var item schema
 		utils.ThrowOnError(rows.Scan(&item.CartId, &item.CartItemId, &item.CartItemQuantity,
-			&item.ProductId, &item.ProductName, &item.ProductDescription, &item.ProductPrice))
+			&item.ProductId, &item.ProductName, &item.ProductDescription, &item.ProductTaxCategory, &item.ProductPrice, &item.CartCurrency,
+			&item.ProductMaxPerOrder, &item.ProductMaxPerCustomer, &item.ProductMaxPerCustomerPeriodDays))
 
 		records = append(records, item)
 	}
 
+	if len(records) == 0 {
+		return CheckoutPrepaymentOutput{}, errors.New("cart is empty")
+	}
+
+	if len(FindCartWarnings(c.pgxPool, records[0].CartId)) > 0 {
+		return CheckoutPrepaymentOutput{}, errors.New("cart has changes that must be acknowledged")
+	}
+
+	// Limits are checked again here since orders placed after the products were added count against them.
+	for _, record := range records {
+		productSchema := daos.ProductSchema{
+			Id:                       record.ProductId,
+			MaxPerOrder:              record.ProductMaxPerOrder,
+			MaxPerCustomer:           record.ProductMaxPerCustomer,
+			MaxPerCustomerPeriodDays: record.ProductMaxPerCustomerPeriodDays,
+		}
+
+		if err := checkPurchaseLimits(c.pgxPool, productSchema, &input.CustomerId, record.CartItemQuantity); err != nil {
+			return CheckoutPrepaymentOutput{}, err
+		}
+	}
+
+	pricingLines := []PricingLine{}
+	for _, record := range records {
+		if record.ProductPrice == nil {
+			return CheckoutPrepaymentOutput{}, errors.New("some products in the cart are not priced in the cart currency")
+		}
+
+		pricingLines = append(pricingLines, PricingLine{
+			ProductId:   record.ProductId,
+			TaxCategory: record.ProductTaxCategory,
+			Quantity:    record.CartItemQuantity,
+			UnitPrice:   *record.ProductPrice,
+		})
+	}
+
+	var addressSchema *daos.AddressSchema
+
+	if input.AddressId != nil {
+		addressSchema = c.addressDAO.FindOneByIdAndCustomerId(*input.AddressId, input.CustomerId)
+	} else {
+		addressSchema = c.addressDAO.FindOneDefaultByCustomerId(input.CustomerId)
+	}
+
+	if addressSchema == nil {
+		return CheckoutPrepaymentOutput{}, errors.New("address not found")
+	}
+
+	destination := TaxDestination{State: addressSchema.State, ZipCode: addressSchema.ZipCode}
+
+	cartSchema := c.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema.ShippingMethodId == nil {
+		return CheckoutPrepaymentOutput{}, errors.New("shipping method is required")
+	}
+
+	pricing, err := c.pricingService.Price(c.pgxPool, input.CustomerId, cartSchema.Currency, cartSchema.PromotionId,
+		cartSchema.ShippingMethodId, &destination, pricingLines)
+	if err != nil {
+		return CheckoutPrepaymentOutput{}, err
+	}
+
+	if pricing.ShippingMethod == nil {
+		return CheckoutPrepaymentOutput{}, errors.New("shipping method is not available for the address")
+	}
+
 	itemsRequest := []preference.ItemRequest{}
 	for _, record := range records {
+
+		// Prices are stored in minor units, while Mercado Pago expects a decimal in major units (2999 USD -> 29.99).
+		unitPrice := utils.Money{Amount: *record.ProductPrice, Currency: record.CartCurrency}
+
+		description := ""
+
+		if record.ProductDescription != nil {
+			description = *record.ProductDescription
+		}
+
 		itemsRequest = append(itemsRequest, preference.ItemRequest{
 			ID:          record.ProductId.String(),
 			Title:       record.ProductName,
-			Description: *record.ProductDescription,
+			Description: description,
 			Quantity:    int(record.CartItemQuantity),
-			UnitPrice:   float64(record.ProductPrice),
+			CurrencyID:  unitPrice.Currency,
+			UnitPrice:   unitPrice.Decimal(),
 		})
 	}
 
-	preferenceResponse := utils.GetOrThrow(c.preferenceClient.Create(context.Background(), preference.Request{
+	if pricing.Tax > 0 {
+		tax := utils.Money{Amount: pricing.Tax, Currency: pricing.Currency}
+
+		itemsRequest = append(itemsRequest, preference.ItemRequest{
+			ID:         "tax",
+			Title:      "Sales tax",
+			Quantity:   1,
+			CurrencyID: tax.Currency,
+			UnitPrice:  tax.Decimal(),
+		})
+	}
+
+	// Preference items cannot carry negative prices, so a discounted cart is charged as a single item for its total.
+	// Shipping is left out of it, since the preference charges it on its own.
+	if len(pricing.Discounts) > 0 {
+		total := utils.Money{Amount: pricing.Total - pricing.Shipping, Currency: pricing.Currency}
+
+		itemsRequest = []preference.ItemRequest{
+			{
+				ID:         cartSchema.Id.String(),
+				Title:      "Order",
+				Quantity:   1,
+				CurrencyID: total.Currency,
+				UnitPrice:  total.Decimal(),
+			},
+		}
+	}
+
+	shipping := utils.Money{Amount: pricing.Shipping, Currency: pricing.Currency}
+
+	preferenceId := utils.GetOrThrow(c.paymentGateway.CreatePreference(preference.Request{
 		Items: itemsRequest,
+		Shipments: &preference.ShipmentsRequest{
+			Mode:         "not_specified",
+			Cost:         shipping.Decimal(),
+			FreeShipping: pricing.Shipping == 0,
+			ReceiverAddress: &preference.ReceiverAddressRequest{
+				ZipCode:      addressSchema.ZipCode,
+				StreetName:   addressSchema.Street,
+				StreetNumber: addressSchema.Number,
+				CityName:     addressSchema.City,
+				StateName:    addressSchema.State,
+			},
+		},
+		Metadata: map[string]any{
+			"shipping_method_id":   pricing.ShippingMethod.ShippingMethodId.String(),
+			"shipping_method_name": pricing.ShippingMethod.Name,
+			"coupon_code":          pricing.CouponCode,
+			"total_price":          pricing.Total,
+		},
 	}))
 
+	saveCheckoutQuote(c.pgxPool, cartSchema.Id, preferenceId, pricing)
+
 	return CheckoutPrepaymentOutput{
-		PreferenceId: preferenceResponse.ID,
+		PreferenceId: preferenceId,
 	}, nil
 }
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type checkoutQuote struct {
+	TotalPrice int64
+	Discounts  []PricingDiscount
+}
+
+// saveCheckoutQuote keeps the discount and total of the pricing a payment preference was created for, replacing
+// the quote of an earlier preference of the cart.
+func saveCheckoutQuote(pgxPool *pgxpool.Pool, cartId uuid.UUID, preferenceId string, pricing Pricing) {
+	var discount PricingDiscount
+	var promotionId *uuid.UUID
+	var couponCode *string
+	var discountType *string
+
+	if len(pricing.Discounts) > 0 {
+		discount = pricing.Discounts[0]
+		promotionId = &discount.PromotionId
+		couponCode = &discount.Code
+		discountType = &discount.Type
+	}
+
+	_ = utils.GetOrThrow(pgxPool.Exec(context.Background(),
+		`INSERT INTO checkout_quotes (cart_id, preference_id, promotion_id, coupon_code, discount_type, discount_amount, total_price,
+		currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (cart_id) DO UPDATE SET preference_id = EXCLUDED.preference_id, promotion_id = EXCLUDED.promotion_id,
+		coupon_code = EXCLUDED.coupon_code, discount_type = EXCLUDED.discount_type, discount_amount = EXCLUDED.discount_amount,
+		total_price = EXCLUDED.total_price, currency = EXCLUDED.currency, created_at = EXCLUDED.created_at`,
+		cartId, preferenceId, promotionId, couponCode, discountType, discount.Amount, pricing.Total, pricing.Currency, time.Now().UTC()))
+}
+
+// takeCheckoutQuote returns the quote of the cart and deletes it, or nil when no preference was created for it.
+func takeCheckoutQuote(tx pgx.Tx, cartId uuid.UUID) *checkoutQuote {
+	var quote checkoutQuote
+	var promotionId *uuid.UUID
+	var couponCode *string
+	var discountType *string
+	var discountAmount int64
+
+	err := tx.QueryRow(context.Background(),
+		`DELETE FROM checkout_quotes WHERE cart_id = $1
+		RETURNING promotion_id, coupon_code, discount_type, discount_amount, total_price`, cartId).
+		Scan(&promotionId, &couponCode, &discountType, &discountAmount, &quote.TotalPrice)
+
+	if err != nil && err == pgx.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		panic(err)
+	}
+
+	quote.Discounts = []PricingDiscount{}
+
+	if promotionId != nil {
+		quote.Discounts = append(quote.Discounts, PricingDiscount{
+			PromotionId: *promotionId,
+			Code:        *couponCode,
+			Type:        *discountType,
+			Amount:      discountAmount,
+		})
+	}
+
+	return &quote
+}
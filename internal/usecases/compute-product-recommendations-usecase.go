@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Number of computed related products kept per product. More than the storefront shows,
+// so that unpublished or out-of-stock products can be filtered out at read time.
+const productRecommendationsLimit = 10
+
+type ComputeProductRecommendationsUsecaseOutput struct {
+	RecommendationsCount int64
+}
+
+type ComputeProductRecommendationsUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewComputeProductRecommendationsUsecase(pgxPool *pgxpool.Pool) ComputeProductRecommendationsUsecase {
+	return ComputeProductRecommendationsUsecase{pgxPool}
+}
+
+// Execute rebuilds the co-purchase affinities from order history. The score of a pair is
+// the number of distinct orders containing both products.
+func (c *ComputeProductRecommendationsUsecase) Execute() (ComputeProductRecommendationsUsecaseOutput, error) {
+	tx := utils.GetOrThrow(c.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM product_recommendations"))
+
+	commandTag := utils.GetOrThrow(tx.Exec(context.Background(),
+		`
+			INSERT INTO product_recommendations (product_id, related_product_id, score, computed_at)
+			SELECT product_id, related_product_id, score, $2
+			FROM (
+				SELECT
+					a.product_id,
+					b.product_id AS related_product_id,
+					COUNT(DISTINCT a.order_id) AS score,
+					ROW_NUMBER() OVER (
+						PARTITION BY a.product_id
+						ORDER BY COUNT(DISTINCT a.order_id) DESC, b.product_id
+					) AS rank
+				FROM order_items a
+				JOIN order_items b
+					ON b.order_id = a.order_id AND b.product_id <> a.product_id AND b.parent_order_item_id IS NULL
+				WHERE a.parent_order_item_id IS NULL
+				GROUP BY a.product_id, b.product_id
+			) affinities
+			WHERE rank <= $1
+		`, productRecommendationsLimit, time.Now().UTC()))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return ComputeProductRecommendationsUsecaseOutput{
+		RecommendationsCount: commandTag.RowsAffected(),
+	}, nil
+}
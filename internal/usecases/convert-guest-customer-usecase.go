@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type ConvertGuestCustomerUsecaseInput struct {
+	OrderId  uuid.UUID
+	Name     string
+	Password string
+}
+
+type ConvertGuestCustomerUsecase struct {
+	pgxPool     *pgxpool.Pool
+	orderDAO    daos.OrderDAO
+	customerDAO daos.CustomerDAO
+}
+
+func NewConvertGuestCustomerUsecase(pgxPool *pgxpool.Pool, orderDAO daos.OrderDAO, customerDAO daos.CustomerDAO) ConvertGuestCustomerUsecase {
+	return ConvertGuestCustomerUsecase{pgxPool, orderDAO, customerDAO}
+}
+
+// Execute turns the guest customer who placed the order into a full account. The order lookup token proves
+// they own the email address, and the orders they placed as a guest stay theirs.
+func (c *ConvertGuestCustomerUsecase) Execute(input ConvertGuestCustomerUsecaseInput) error {
+	if len(input.Name) < 2 {
+		return errors.New("name must be at least 2 characters")
+	}
+
+	if len(input.Password) < 6 {
+		return errors.New("password must be at least 6 characters")
+	}
+
+	orderSchema := c.orderDAO.FindOneById(input.OrderId)
+
+	if orderSchema == nil {
+		return errors.New("order not found")
+	}
+
+	customerSchema := c.customerDAO.FindOneById(orderSchema.CustomerId)
+
+	if !customerSchema.IsGuest {
+		return errors.New("customer already has an account")
+	}
+
+	hashedPassword := utils.GetOrThrow(bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost))
+
+	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(),
+		"UPDATE customers SET name = $1, password = $2, is_guest = FALSE WHERE id = $3 AND is_guest",
+		input.Name, string(hashedPassword), customerSchema.Id))
+
+	return nil
+}
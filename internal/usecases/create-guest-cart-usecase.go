@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreateGuestCartUsecaseOutput struct {
+	CartToken string
+	ExpiresAt time.Time
+}
+
+type CreateGuestCartUsecase struct {
+	pgxPool           *pgxpool.Pool
+	guestCartDAO      daos.GuestCartDAO
+	awsSecretsGateway gateways.AwsSecretsGateway
+}
+
+func NewCreateGuestCartUsecase(pgxPool *pgxpool.Pool, guestCartDAO daos.GuestCartDAO,
+	awsSecretsGateway gateways.AwsSecretsGateway) CreateGuestCartUsecase {
+	return CreateGuestCartUsecase{pgxPool, guestCartDAO, awsSecretsGateway}
+}
+
+func (c *CreateGuestCartUsecase) Execute() (CreateGuestCartUsecaseOutput, error) {
+	now := time.Now().UTC()
+
+	// Expired guest carts are never read again, so they are cleared whenever a new one is created.
+	_ = utils.GetOrThrow(c.pgxPool.Exec(context.Background(), "DELETE FROM guest_carts WHERE expires_at <= $1", now))
+
+	guestCartSchema := daos.GuestCartSchema{
+		Id:        uuid.New(),
+		ExpiresAt: now.Add(GuestCartTTL),
+		CreatedAt: now,
+	}
+
+	c.guestCartDAO.Create(guestCartSchema)
+
+	return CreateGuestCartUsecaseOutput{
+		CartToken: signGuestCartToken(c.awsSecretsGateway, guestCartSchema.Id),
+		ExpiresAt: guestCartSchema.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+type CreateWishlistUsecaseInput struct {
+	CustomerId uuid.UUID
+	Name       string
+}
+
+type CreateWishlistUsecaseOutput struct {
+	WishlistId uuid.UUID
+}
+
+type CreateWishlistUsecase struct {
+	wishlistDAO daos.WishlistDAO
+}
+
+func NewCreateWishlistUsecase(wishlistDAO daos.WishlistDAO) CreateWishlistUsecase {
+	return CreateWishlistUsecase{wishlistDAO}
+}
+
+func (c *CreateWishlistUsecase) Execute(input CreateWishlistUsecaseInput) (CreateWishlistUsecaseOutput, error) {
+	if utf8.RuneCountInString(input.Name) > 100 {
+		return CreateWishlistUsecaseOutput{}, errors.New("wishlist name cannot exceed 100 characters")
+	}
+
+	if c.wishlistDAO.FindOneByCustomerIdAndName(input.CustomerId, input.Name) != nil {
+		return CreateWishlistUsecaseOutput{}, errors.New("wishlist name already exists")
+	}
+
+	wishlistId := uuid.New()
+
+	c.wishlistDAO.Create(daos.WishlistSchema{
+		Id:         wishlistId,
+		CustomerId: input.CustomerId,
+		Kind:       WishlistKindWishlist,
+		Name:       input.Name,
+		CreatedAt:  time.Now().UTC(),
+	})
+
+	return CreateWishlistUsecaseOutput{
+		WishlistId: wishlistId,
+	}, nil
+}
@@ -38,12 +38,21 @@ func (i *DecreaseProductQuantityInCartUsecase) Execute(input DecreaseProductQuan
 		return errors.New("product not found in cart")
 	}
 
+	tx := utils.GetOrThrow(i.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
 	if input.Quantity >= cartItemSchema.Quantity {
-		_ = utils.GetOrThrow(i.pgxPool.Exec(context.Background(), "DELETE FROM cart_items WHERE id = $1", cartItemSchema.Id))
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE id = $1", cartItemSchema.Id))
 	}
 
-	_ = utils.GetOrThrow(i.pgxPool.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity - $1 WHERE id = $2",
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity - $1 WHERE id = $2",
 		input.Quantity, cartItemSchema.Id))
 
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
 	return nil
 }
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type FulfilOrderUsecaseInput struct {
+	OrderId uuid.UUID
+}
+
+type FulfilOrderUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewFulfilOrderUsecase(pgxPool *pgxpool.Pool) FulfilOrderUsecase {
+	return FulfilOrderUsecase{pgxPool}
+}
+
+// Execute marks the order as shipped, after which only admins can cancel it.
+func (f *FulfilOrderUsecase) Execute(input FulfilOrderUsecaseInput) error {
+	tx := utils.GetOrThrow(f.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var status string
+
+	err := tx.QueryRow(context.Background(), "SELECT status FROM orders WHERE id = $1 FOR UPDATE", input.OrderId).Scan(&status)
+	if err == pgx.ErrNoRows {
+		return errors.New("order not found")
+	}
+	utils.ThrowOnError(err)
+
+	if status == OrderStatusCancelled {
+		return errors.New("order is cancelled")
+	}
+
+	if status == OrderStatusFulfilled {
+		return errors.New("order is already fulfilled")
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE orders SET status = $1, fulfilled_at = $2 WHERE id = $3",
+		OrderStatusFulfilled, time.Now().UTC(), input.OrderId))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// GuestCartTTL is how long a guest cart is kept after it was last changed.
+const GuestCartTTL = 7 * 24 * time.Hour
+
+type JwtGuestCartTokenClaims struct {
+	jwt.RegisteredClaims
+}
+
+// signGuestCartToken signs the token that identifies a guest cart. It uses its own signing key so a cart token
+// is never accepted as an access token.
+func signGuestCartToken(awsSecretsGateway gateways.AwsSecretsGateway, guestCartId uuid.UUID) string {
+	cartToken := jwt.NewWithClaims(jwt.SigningMethodHS256, JwtGuestCartTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:  guestCartId.String(),
+			IssuedAt: jwt.NewNumericDate(time.Now().UTC()),
+		},
+	})
+
+	cartTokenSigningKey := utils.GetOrThrow(awsSecretsGateway.Get("CART_TOKEN_SIGNING_KEY"))
+	return utils.GetOrThrow(cartToken.SignedString([]byte(cartTokenSigningKey)))
+}
+
+func parseGuestCartToken(awsSecretsGateway gateways.AwsSecretsGateway, cartToken string) (uuid.UUID, error) {
+	cartTokenSigningKey := utils.GetOrThrow(awsSecretsGateway.Get("CART_TOKEN_SIGNING_KEY"))
+
+	token, err := jwt.ParseWithClaims(cartToken, new(JwtGuestCartTokenClaims), func(token *jwt.Token) (any, error) {
+		return []byte(cartTokenSigningKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	if err != nil {
+		return uuid.UUID{}, errors.New("cart token is invalid")
+	}
+
+	guestCartId, err := uuid.Parse(token.Claims.(*JwtGuestCartTokenClaims).Subject)
+	if err != nil {
+		return uuid.UUID{}, errors.New("cart token is invalid")
+	}
+
+	return guestCartId, nil
+}
+
+// findGuestCart returns the guest cart unless it has expired, in which case it is as good as gone.
+func findGuestCart(guestCartDAO daos.GuestCartDAO, guestCartId uuid.UUID) *daos.GuestCartSchema {
+	guestCartSchema := guestCartDAO.FindOneById(guestCartId)
+
+	if guestCartSchema == nil || !guestCartSchema.ExpiresAt.After(time.Now().UTC()) {
+		return nil
+	}
+
+	return guestCartSchema
+}
+
+// findCheckedOutGuestCart returns the guest cart, which must have been checked out with a guest customer and
+// shipping address.
+func findCheckedOutGuestCart(guestCartDAO daos.GuestCartDAO, guestCartId uuid.UUID) (*daos.GuestCartSchema, error) {
+	guestCartSchema := findGuestCart(guestCartDAO, guestCartId)
+
+	if guestCartSchema == nil {
+		return nil, errors.New("cart not found")
+	}
+
+	if guestCartSchema.CustomerId == nil {
+		return nil, errors.New("guest checkout is required")
+	}
+
+	return guestCartSchema, nil
+}
+
+// copyGuestCartItems replaces the items of the guest customer's cart with those of the guest cart, since a guest
+// checks out what is in the guest cart now, not what was left from an earlier attempt.
+func copyGuestCartItems(tx pgx.Tx, guestCartId uuid.UUID, cartId uuid.UUID) {
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE cart_id = $1", cartId))
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO cart_items (id, cart_id, product_id, quantity, price_at_add, created_at)
+		SELECT gen_random_uuid(), $1, product_id, quantity, price_at_add, $3 FROM guest_cart_items WHERE guest_cart_id = $2`,
+		cartId, guestCartId, time.Now().UTC()))
+
+	touchCart(tx, cartId)
+}
+
+// mergeGuestCart moves the items of a guest cart into a customer cart and deletes the guest cart. Quantities
+// of products found in both carts are summed and capped at what can still be sold and at the product purchase
+// limits; products not priced in the customer cart currency are dropped. New lines keep the price the guest saw
+// when both carts share a currency.
+func mergeGuestCart(tx pgx.Tx, productDAO daos.ProductDAO, productPriceDAO daos.ProductPriceDAO, guestCartId uuid.UUID,
+	cartSchema daos.CartSchema) {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`SELECT gci.product_id, gci.quantity, gci.price_at_add, gc.currency FROM guest_cart_items gci
+		JOIN guest_carts gc ON gc.id = gci.guest_cart_id
+		WHERE gc.id = $1 AND gc.expires_at > $2
+		ORDER BY gci.created_at FOR UPDATE`, guestCartId, time.Now().UTC()))
+
+	type schema struct {
+		ProductId  uuid.UUID
+		Quantity   int32
+		PriceAtAdd *int64
+		Currency   string
+	}
+
+	records := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.Quantity, &item.PriceAtAdd, &item.Currency))
+		records = append(records, item)
+	}
+
+	for _, record := range records {
+		productSchema := productDAO.FindOneById(record.ProductId)
+
+		if productSchema == nil {
+			continue
+		}
+
+		price, ok := findProductPrice(productPriceDAO, *productSchema, cartSchema.Currency)
+
+		if !ok {
+			continue
+		}
+
+		backorderPolicy, backorderedQuantity := lockBackorderPolicy(tx, record.ProductId)
+
+		var stockQuantity int32
+		utils.ThrowOnError(tx.QueryRow(context.Background(),
+			"SELECT COALESCE(SUM(stock_quantity), 0)::INT FROM product_available_stock WHERE product_id = $1", record.ProductId).
+			Scan(&stockQuantity))
+
+		var cartQuantity int32
+		err := tx.QueryRow(context.Background(),
+			"SELECT quantity FROM cart_items WHERE cart_id = $1 AND product_id = $2 FOR UPDATE", cartSchema.Id, record.ProductId).
+			Scan(&cartQuantity)
+		if err != nil && err != pgx.ErrNoRows {
+			panic(err)
+		}
+
+		quantity := min(cartQuantity+record.Quantity, sellableQuantity(backorderPolicy, stockQuantity, backorderedQuantity))
+
+		if limit, ok := purchaseLimit(tx, *productSchema, cartSchema.CustomerId); ok {
+			quantity = min(quantity, limit)
+		}
+
+		if quantity <= cartQuantity {
+			continue
+		}
+
+		if err == pgx.ErrNoRows {
+			priceAtAdd := price.Amount
+			if record.PriceAtAdd != nil && record.Currency == cartSchema.Currency {
+				priceAtAdd = *record.PriceAtAdd
+			}
+
+			_ = utils.GetOrThrow(tx.Exec(context.Background(),
+				"INSERT INTO cart_items (id, cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+				uuid.New(), cartSchema.Id, record.ProductId, quantity, priceAtAdd, time.Now().UTC()))
+
+			continue
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE cart_items SET quantity = $1 WHERE cart_id = $2 AND product_id = $3", quantity, cartSchema.Id, record.ProductId))
+	}
+
+	if len(records) > 0 {
+		touchCart(tx, cartSchema.Id)
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM guest_carts WHERE id = $1", guestCartId))
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GuestCheckoutPrepaymentUsecaseInput struct {
+	GuestCartId uuid.UUID
+}
+
+type GuestCheckoutPrepaymentUsecase struct {
+	pgxPool            *pgxpool.Pool
+	guestCartDAO       daos.GuestCartDAO
+	cartDAO            daos.CartDAO
+	checkoutPrepayment CheckoutPrepayment
+}
+
+func NewGuestCheckoutPrepaymentUsecase(pgxPool *pgxpool.Pool, guestCartDAO daos.GuestCartDAO, cartDAO daos.CartDAO,
+	checkoutPrepayment CheckoutPrepayment) GuestCheckoutPrepaymentUsecase {
+	return GuestCheckoutPrepaymentUsecase{pgxPool, guestCartDAO, cartDAO, checkoutPrepayment}
+}
+
+// Execute creates the payment preference of a checked out guest cart, shipping to the address given at the guest
+// checkout. Changes made to the guest cart since then are brought over first.
+func (g *GuestCheckoutPrepaymentUsecase) Execute(input GuestCheckoutPrepaymentUsecaseInput) (CheckoutPrepaymentOutput, error) {
+	guestCartSchema, err := findCheckedOutGuestCart(g.guestCartDAO, input.GuestCartId)
+	if err != nil {
+		return CheckoutPrepaymentOutput{}, err
+	}
+
+	cartSchema := g.cartDAO.FindOneByCustomerId(*guestCartSchema.CustomerId)
+
+	tx := utils.GetOrThrow(g.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	copyGuestCartItems(tx, guestCartSchema.Id, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return g.checkoutPrepayment.Execute(CheckoutPrepaymentInput{
+		CustomerId: *guestCartSchema.CustomerId,
+		AddressId:  guestCartSchema.AddressId,
+	})
+}
@@ -0,0 +1,179 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+type GuestCheckoutUsecaseInput struct {
+	GuestCartId  uuid.UUID
+	Name         string
+	Email        string
+	City         string
+	State        string
+	ZipCode      string
+	StreetName   string
+	StreetNumber string
+	// OrderLookupToken is the token of the lookup link of an earlier order, which lets a returning guest check out
+	// with the same email address again.
+	OrderLookupToken *string
+}
+
+type GuestCheckoutUsecaseOutput struct {
+	CustomerId uuid.UUID
+	AddressId  uuid.UUID
+}
+
+type GuestCheckoutUsecase struct {
+	pgxPool            *pgxpool.Pool
+	redisClient        *redis.Client
+	customerDAO        daos.CustomerDAO
+	guestCartDAO       daos.GuestCartDAO
+	guestCartItemDAO   daos.GuestCartItemDAO
+	productDAO         daos.ProductDAO
+	httpZipCodeGateway gateways.HttpZipCodeGateway
+	awsSecretsGateway  gateways.AwsSecretsGateway
+}
+
+func NewGuestCheckoutUsecase(pgxPool *pgxpool.Pool, redisClient *redis.Client, customerDAO daos.CustomerDAO, guestCartDAO daos.GuestCartDAO,
+	guestCartItemDAO daos.GuestCartItemDAO, productDAO daos.ProductDAO, httpZipCodeGateway gateways.HttpZipCodeGateway,
+	awsSecretsGateway gateways.AwsSecretsGateway) GuestCheckoutUsecase {
+	return GuestCheckoutUsecase{pgxPool, redisClient, customerDAO, guestCartDAO, guestCartItemDAO, productDAO, httpZipCodeGateway,
+		awsSecretsGateway}
+}
+
+// Execute records the guest customer and shipping address on the guest cart and copies its items into the guest
+// customer's cart. The guest then picks a shipping method and pays with the cart token, and the order is placed
+// through the same postpayment flow as authenticated customers, using the returned customer and address ids.
+func (g *GuestCheckoutUsecase) Execute(input GuestCheckoutUsecaseInput) (GuestCheckoutUsecaseOutput, error) {
+	if utf8.RuneCountInString(input.Name) < 2 {
+		return GuestCheckoutUsecaseOutput{}, errors.New("name must be at least 2 characters")
+	}
+
+	if _, err := mail.ParseAddress(input.Email); err != nil {
+		return GuestCheckoutUsecaseOutput{}, errors.New("email address is invalid")
+	}
+
+	guestCartSchema := findGuestCart(g.guestCartDAO, input.GuestCartId)
+
+	if guestCartSchema == nil {
+		return GuestCheckoutUsecaseOutput{}, errors.New("cart not found")
+	}
+
+	guestCartItemsSchema := g.guestCartItemDAO.FindAllByGuestCartId(guestCartSchema.Id)
+
+	if len(guestCartItemsSchema) == 0 {
+		return GuestCheckoutUsecaseOutput{}, errors.New("cart is empty")
+	}
+
+	address := postalAddress{
+		City:         input.City,
+		State:        input.State,
+		ZipCode:      input.ZipCode,
+		StreetName:   input.StreetName,
+		StreetNumber: input.StreetNumber,
+	}
+
+	if err := validateAddress(g.redisClient, g.httpZipCodeGateway, address); err != nil {
+		return GuestCheckoutUsecaseOutput{}, err
+	}
+
+	customerSchema := g.customerDAO.FindOneByEmail(input.Email)
+
+	if customerSchema != nil && !customerSchema.IsGuest {
+		return GuestCheckoutUsecaseOutput{}, errors.New("this email address belongs to an account, log in to check out")
+	}
+
+	if customerSchema != nil && !g.ownsGuestCustomer(*guestCartSchema, customerSchema.Id, input.OrderLookupToken) {
+		return GuestCheckoutUsecaseOutput{}, errors.New("this email address was used to check out before, check out from your order link")
+	}
+
+	// A returning guest is known by email, so what they ordered before counts against the per-customer limits.
+	var customerId *uuid.UUID
+	if customerSchema != nil {
+		customerId = &customerSchema.Id
+	}
+
+	for _, guestCartItemSchema := range guestCartItemsSchema {
+		productSchema := g.productDAO.FindOneById(guestCartItemSchema.ProductId)
+
+		if err := checkPurchaseLimits(g.pgxPool, *productSchema, customerId, guestCartItemSchema.Quantity); err != nil {
+			return GuestCheckoutUsecaseOutput{}, err
+		}
+	}
+
+	tx := utils.GetOrThrow(g.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	output := GuestCheckoutUsecaseOutput{
+		CustomerId: uuid.New(),
+		AddressId:  uuid.New(),
+	}
+
+	if customerSchema == nil {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO customers (id, name, email, password, is_guest, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			output.CustomerId, input.Name, input.Email, "", true, time.Now().UTC()))
+	} else {
+		output.CustomerId = customerSchema.Id
+	}
+
+	var cartId uuid.UUID
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		`INSERT INTO carts (id, customer_id, currency, created_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (customer_id) DO UPDATE SET currency = EXCLUDED.currency
+		RETURNING id`,
+		uuid.New(), output.CustomerId, guestCartSchema.Currency, time.Now().UTC()).Scan(&cartId))
+
+	copyGuestCartItems(tx, guestCartSchema.Id, cartId)
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO addresses (id, customer_id, is_default, street, city, state, number, zip_code, address_line, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		output.AddressId, output.CustomerId, customerSchema == nil, address.StreetName, address.City, address.State,
+		address.StreetNumber, address.ZipCode, formatAddressLine(address), time.Now().UTC()))
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE guest_carts SET customer_id = $1, address_id = $2 WHERE id = $3",
+		output.CustomerId, output.AddressId, guestCartSchema.Id))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return output, nil
+}
+
+// ownsGuestCustomer tells whether the caller may check out as the guest customer who used the email address before.
+// Anyone can give an email address, so it takes the cart that guest checked out with or the lookup link of one of
+// their orders, which was sent to that address.
+func (g *GuestCheckoutUsecase) ownsGuestCustomer(guestCartSchema daos.GuestCartSchema, customerId uuid.UUID, orderLookupToken *string) bool {
+	if guestCartSchema.CustomerId != nil && *guestCartSchema.CustomerId == customerId {
+		return true
+	}
+
+	if orderLookupToken == nil {
+		return false
+	}
+
+	orderId, err := parseOrderLookupToken(g.awsSecretsGateway, *orderLookupToken)
+	if err != nil {
+		return false
+	}
+
+	var ownsOrder bool
+	utils.ThrowOnError(g.pgxPool.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM orders WHERE id = $1 AND customer_id = $2)",
+		orderId, customerId).Scan(&ownsOrder))
+
+	return ownsOrder
+}
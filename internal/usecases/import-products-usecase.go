@@ -0,0 +1,220 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+type ImportProductsUsecaseInput struct {
+	Format  string
+	Content []byte
+}
+
+type ImportProductsUsecaseOutput struct {
+	JobId uuid.UUID
+}
+
+type productImportRow struct {
+	Number      int32
+	Sku         *string
+	Name        string
+	Description *string
+	Price       int64
+	Currency    *string
+	ParseError  error
+}
+
+type ImportProductsUsecase struct {
+	productImportJobDAO daos.ProductImportJobDAO
+}
+
+func NewImportProductsUsecase(productImportJobDAO daos.ProductImportJobDAO) ImportProductsUsecase {
+	return ImportProductsUsecase{productImportJobDAO}
+}
+
+func (i *ImportProductsUsecase) Execute(input ImportProductsUsecaseInput) (ImportProductsUsecaseOutput, error) {
+	if input.Format != "csv" && input.Format != "ndjson" {
+		return ImportProductsUsecaseOutput{}, errors.New("import format must be csv or ndjson")
+	}
+
+	rows, err := parseProductImport(input.Format, input.Content)
+	if err != nil {
+		return ImportProductsUsecaseOutput{}, err
+	}
+
+	if len(rows) == 0 {
+		return ImportProductsUsecaseOutput{}, errors.New("import file has no rows")
+	}
+
+	jobId := uuid.New()
+
+	i.productImportJobDAO.Create(daos.ProductImportJobSchema{
+		Id:            jobId,
+		Status:        "queued",
+		Format:        input.Format,
+		Content:       input.Content,
+		TotalRows:     int32(len(rows)),
+		ProcessedRows: 0,
+		FailedRows:    0,
+		CreatedAt:     time.Now().UTC(),
+	})
+
+	return ImportProductsUsecaseOutput{
+		JobId: jobId,
+	}, nil
+}
+
+func validateProductImportRow(row productImportRow) error {
+	if row.Sku != nil && utf8.RuneCountInString(*row.Sku) > 50 {
+		return errors.New("the product sku cannot exceed 50 characters")
+	}
+
+	if row.Currency != nil && !utils.IsSupportedCurrency(*row.Currency) {
+		return errors.New("currency is not supported")
+	}
+
+	return validateProduct(row.Name, row.Price)
+}
+
+func parseProductImport(format string, content []byte) ([]productImportRow, error) {
+	if format == "ndjson" {
+		return parseProductImportNDJSON(content)
+	}
+
+	return parseProductImportCSV(content)
+}
+
+func parseProductImportCSV(content []byte) ([]productImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("import file has no rows")
+	}
+
+	if err != nil {
+		return nil, errors.New("import file is not a valid csv")
+	}
+
+	columns := map[string]int{}
+	for index, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = index
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, errors.New("csv header must contain name and price columns")
+	}
+
+	if _, ok := columns["price"]; !ok {
+		return nil, errors.New("csv header must contain name and price columns")
+	}
+
+	column := func(record []string, name string) *string {
+		index, ok := columns[name]
+
+		if !ok || index >= len(record) || strings.TrimSpace(record[index]) == "" {
+			return nil
+		}
+
+		value := strings.TrimSpace(record[index])
+		return &value
+	}
+
+	rows := []productImportRow{}
+	rowNumber := int32(1)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		rowNumber++
+		row := productImportRow{Number: rowNumber}
+
+		if err != nil {
+			row.ParseError = errors.New("row is not a valid csv record")
+			rows = append(rows, row)
+			continue
+		}
+
+		row.Sku = column(record, "sku")
+		row.Description = column(record, "description")
+		if currency := column(record, "currency"); currency != nil {
+			row.Currency = utils.NewPointer(strings.ToUpper(*currency))
+		}
+
+		if name := column(record, "name"); name != nil {
+			row.Name = *name
+		}
+
+		if price := column(record, "price"); price != nil {
+			row.Price, err = strconv.ParseInt(*price, 10, 64)
+
+			if err != nil {
+				row.ParseError = errors.New("the product price must be an integer")
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseProductImportNDJSON(content []byte) ([]productImportRow, error) {
+	type record struct {
+		Sku         *string `json:"sku"`
+		Name        string  `json:"name"`
+		Description *string `json:"description"`
+		Price       any     `json:"price"`
+		Currency    *string `json:"currency"`
+	}
+
+	rows := []productImportRow{}
+
+	for index, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		row := productImportRow{Number: int32(index + 1)}
+
+		var item record
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			row.ParseError = errors.New("row is not a valid json object")
+			rows = append(rows, row)
+			continue
+		}
+
+		row.Sku = item.Sku
+		row.Name = item.Name
+		row.Description = item.Description
+		if item.Currency != nil {
+			row.Currency = utils.NewPointer(strings.ToUpper(*item.Currency))
+		}
+
+		price, ok := item.Price.(float64)
+		if item.Price != nil && (!ok || price != float64(int64(price))) {
+			row.ParseError = errors.New("the product price must be an integer")
+		}
+
+		row.Price = int64(price)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
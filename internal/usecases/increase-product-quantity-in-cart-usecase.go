@@ -21,11 +21,13 @@ type IncreaseProductQuantityInCartUsecase struct {
 	cartDAO      daos.CartDAO
 	cartItemDAO  daos.CartItemDAO
 	inventoryDAO daos.InventoryDAO
+	productDAO   daos.ProductDAO
+	orderItemDAO daos.OrderItemDAO
 }
 
-func NewIncreaseProductQuantityInCartUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO,
-	cartItemDAO daos.CartItemDAO, inventoryDAO daos.InventoryDAO) IncreaseProductQuantityInCartUsecase {
-	return IncreaseProductQuantityInCartUsecase{pgxPool, cartDAO, cartItemDAO, inventoryDAO}
+func NewIncreaseProductQuantityInCartUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, cartItemDAO daos.CartItemDAO,
+	inventoryDAO daos.InventoryDAO, productDAO daos.ProductDAO, orderItemDAO daos.OrderItemDAO) IncreaseProductQuantityInCartUsecase {
+	return IncreaseProductQuantityInCartUsecase{pgxPool, cartDAO, cartItemDAO, inventoryDAO, productDAO, orderItemDAO}
 }
 
 func (i *IncreaseProductQuantityInCartUsecase) Execute(input IncreaseProductQuantityInCartUsecaseInput) error {
@@ -40,14 +42,31 @@ func (i *IncreaseProductQuantityInCartUsecase) Execute(input IncreaseProductQuan
 		return errors.New("product not found in cart")
 	}
 
-	inventorySchema := i.inventoryDAO.FindOneByProductId(input.ProductId)
+	productSchema := i.productDAO.FindOneById(input.ProductId)
+	stockQuantity := i.inventoryDAO.SumStockQuantityByProductId(input.ProductId)
+	backorderedQuantity := i.orderItemDAO.SumBackorderedQuantityByProductId(input.ProductId)
 
-	if input.Quantity > inventorySchema.StockQuantity {
+	quantity := cartItemSchema.Quantity + input.Quantity
+
+	if quantity > sellableQuantity(*productSchema, stockQuantity, backorderedQuantity) {
 		return errors.New("product quantity exceeds the stock available")
 	}
 
-	_ = utils.GetOrThrow(i.pgxPool.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity + $1 WHERE id = $2",
+	tx := utils.GetOrThrow(i.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	if err := checkPurchaseLimits(tx, *productSchema, &input.CustomerId, quantity); err != nil {
+		return err
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE cart_items SET quantity = quantity + $1 WHERE id = $2",
 		input.Quantity, cartItemSchema.Id))
 
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
 	return nil
 }
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+// InventoryLookup identifies an inventory either by its id or by its product, given by id or SKU, and
+// warehouse. A nil WarehouseId stands for the default warehouse.
+type InventoryLookup struct {
+	InventoryId *uuid.UUID
+	ProductId   *uuid.UUID
+	Sku         *string
+	WarehouseId *uuid.UUID
+}
+
+// findLookupProduct returns the product the lookup refers to, or nil when it does not exist.
+func findLookupProduct(productDAO daos.ProductDAO, lookup InventoryLookup) *daos.ProductSchema {
+	if lookup.ProductId != nil {
+		return productDAO.FindOneById(*lookup.ProductId)
+	}
+
+	if lookup.Sku != nil {
+		return productDAO.FindOneBySku(*lookup.Sku)
+	}
+
+	return nil
+}
+
+// findInventoryId resolves the lookup to an existing inventory.
+func findInventoryId(inventoryDAO daos.InventoryDAO, productDAO daos.ProductDAO, lookup InventoryLookup) (uuid.UUID, error) {
+	if lookup.InventoryId != nil {
+		if !inventoryDAO.ExistsById(*lookup.InventoryId) {
+			return uuid.Nil, errors.New("inventory not found")
+		}
+
+		return *lookup.InventoryId, nil
+	}
+
+	productSchema := findLookupProduct(productDAO, lookup)
+
+	if productSchema == nil {
+		return uuid.Nil, errors.New("product not found")
+	}
+
+	var inventorySchema *daos.InventorySchema
+
+	if lookup.WarehouseId != nil {
+		inventorySchema = inventoryDAO.FindOneByProductIdAndWarehouseId(productSchema.Id, *lookup.WarehouseId)
+	} else {
+		inventorySchema = inventoryDAO.FindOneByProductId(productSchema.Id)
+	}
+
+	if inventorySchema == nil {
+		return uuid.Nil, errors.New("inventory not found")
+	}
+
+	return inventorySchema.Id, nil
+}
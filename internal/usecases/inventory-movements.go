@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type inventoryMovement struct {
+	InventoryId uuid.UUID
+	Reason      string
+	Quantity    int32
+	ActorId     *uuid.UUID
+	ReferenceId *uuid.UUID
+	Note        *string
+}
+
+// moveStock applies a signed quantity to an inventory and records it in the inventory_movements ledger
+// within the given transaction, so stock never changes without a trail. When the movement takes the stock
+// from above the reorder point to at or below it, a low stock alert is queued in the same transaction.
+// It returns the resulting balance, or an error when the inventory does not exist or the movement would take
+// the stock below zero.
+func moveStock(tx pgx.Tx, movement inventoryMovement) (int32, error) {
+	var productId, warehouseId uuid.UUID
+	var balance, reorderPoint int32
+
+	err := tx.QueryRow(context.Background(),
+		`UPDATE inventories SET stock_quantity = stock_quantity + $1
+		WHERE id = $2 AND stock_quantity + $1 >= 0
+		RETURNING product_id, warehouse_id, stock_quantity, reorder_point`,
+		movement.Quantity, movement.InventoryId).Scan(&productId, &warehouseId, &balance, &reorderPoint)
+
+	if err == pgx.ErrNoRows {
+		var inventoryExists bool
+
+		utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM inventories WHERE id = $1)",
+			movement.InventoryId).Scan(&inventoryExists))
+
+		if !inventoryExists {
+			return 0, errors.New("inventory not found")
+		}
+
+		return 0, errors.New("stock cannot be negative")
+	}
+	utils.ThrowOnError(err)
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO inventory_movements (id, inventory_id, product_id, reason, quantity, balance, actor_id, reference_id, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		uuid.New(), movement.InventoryId, productId, movement.Reason, movement.Quantity, balance, movement.ActorId,
+		movement.ReferenceId, movement.Note, time.Now().UTC()))
+
+	previousBalance := balance - movement.Quantity
+
+	if reorderPoint > 0 && previousBalance > reorderPoint && balance <= reorderPoint {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			`INSERT INTO low_stock_alerts (id, inventory_id, product_id, warehouse_id, stock_quantity, reorder_point, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			uuid.New(), movement.InventoryId, productId, warehouseId, balance, reorderPoint, time.Now().UTC()))
+	}
+
+	return balance, nil
+}
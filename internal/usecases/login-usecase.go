@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"context"
 	"errors"
 	"net/mail"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -19,8 +21,9 @@ type JwtAccessTokenClaims struct {
 }
 
 type LoginUsecaseInput struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	CartToken *string
 }
 
 type LoginUsecaseOutput struct {
@@ -29,12 +32,17 @@ type LoginUsecaseOutput struct {
 }
 
 type LoginUsecase struct {
+	pgxPool           *pgxpool.Pool
 	customerDAO       daos.CustomerDAO
+	cartDAO           daos.CartDAO
+	productDAO        daos.ProductDAO
+	productPriceDAO   daos.ProductPriceDAO
 	awsSecretsGateway gateways.AwsSecretsGateway
 }
 
-func NewLoginUsecase(customerDAO daos.CustomerDAO, awsSecretsGateway gateways.AwsSecretsGateway) LoginUsecase {
-	return LoginUsecase{customerDAO, awsSecretsGateway}
+func NewLoginUsecase(pgxPool *pgxpool.Pool, customerDAO daos.CustomerDAO, cartDAO daos.CartDAO, productDAO daos.ProductDAO,
+	productPriceDAO daos.ProductPriceDAO, awsSecretsGateway gateways.AwsSecretsGateway) LoginUsecase {
+	return LoginUsecase{pgxPool, customerDAO, cartDAO, productDAO, productPriceDAO, awsSecretsGateway}
 }
 
 func (l *LoginUsecase) Execute(input LoginUsecaseInput) (LoginUsecaseOutput, error) {
@@ -54,6 +62,23 @@ func (l *LoginUsecase) Execute(input LoginUsecaseInput) (LoginUsecaseOutput, err
 		return LoginUsecaseOutput{}, errors.New("email or password is incorrect")
 	}
 
+	if input.CartToken != nil {
+		guestCartId, err := parseGuestCartToken(l.awsSecretsGateway, *input.CartToken)
+		if err != nil {
+			return LoginUsecaseOutput{}, err
+		}
+
+		tx := utils.GetOrThrow(l.pgxPool.Begin(context.Background()))
+
+		defer func() {
+			_ = tx.Rollback(context.Background())
+		}()
+
+		mergeGuestCart(tx, l.productDAO, l.productPriceDAO, guestCartId, *l.cartDAO.FindOneByCustomerId(customerSchema.Id))
+
+		utils.ThrowOnError(tx.Commit(context.Background()))
+	}
+
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, JwtAccessTokenClaims{
 		Roles: []string{"customer"},
 		RegisteredClaims: jwt.RegisteredClaims{
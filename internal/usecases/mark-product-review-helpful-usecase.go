@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MarkProductReviewHelpfulUsecaseInput struct {
+	CustomerId      uuid.UUID
+	ProductReviewId uuid.UUID
+}
+
+type MarkProductReviewHelpfulUsecase struct {
+	pgxPool          *pgxpool.Pool
+	productReviewDAO daos.ProductReviewDAO
+}
+
+func NewMarkProductReviewHelpfulUsecase(pgxPool *pgxpool.Pool, productReviewDAO daos.ProductReviewDAO) MarkProductReviewHelpfulUsecase {
+	return MarkProductReviewHelpfulUsecase{pgxPool, productReviewDAO}
+}
+
+func (m *MarkProductReviewHelpfulUsecase) Execute(input MarkProductReviewHelpfulUsecaseInput) error {
+	productReviewSchema := m.productReviewDAO.FindOneById(input.ProductReviewId)
+
+	if productReviewSchema == nil || productReviewSchema.Status != "approved" {
+		return errors.New("product review not found")
+	}
+
+	if productReviewSchema.CustomerId == input.CustomerId {
+		return errors.New("you cannot mark your own review as helpful")
+	}
+
+	tx := utils.GetOrThrow(m.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	commandTag := utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO product_review_votes (product_review_id, customer_id, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (product_review_id, customer_id) DO NOTHING`,
+		input.ProductReviewId, input.CustomerId, time.Now().UTC()))
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("you have already marked this review as helpful")
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE product_reviews SET helpful_count = helpful_count + 1 WHERE id = $1",
+		input.ProductReviewId))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ModerateProductReviewUsecaseInput struct {
+	ProductReviewId uuid.UUID
+	Status          string
+}
+
+type ModerateProductReviewUsecase struct {
+	pgxPool          *pgxpool.Pool
+	productReviewDAO daos.ProductReviewDAO
+}
+
+func NewModerateProductReviewUsecase(pgxPool *pgxpool.Pool, productReviewDAO daos.ProductReviewDAO) ModerateProductReviewUsecase {
+	return ModerateProductReviewUsecase{pgxPool, productReviewDAO}
+}
+
+func (m *ModerateProductReviewUsecase) Execute(input ModerateProductReviewUsecaseInput) error {
+	if input.Status != "approved" && input.Status != "rejected" {
+		return errors.New("status must be approved or rejected")
+	}
+
+	productReviewSchema := m.productReviewDAO.FindOneById(input.ProductReviewId)
+
+	if productReviewSchema == nil {
+		return errors.New("product review not found")
+	}
+
+	_ = utils.GetOrThrow(m.pgxPool.Exec(context.Background(), "UPDATE product_reviews SET status = $1, moderated_at = $2 WHERE id = $3",
+		input.Status, time.Now().UTC(), input.ProductReviewId))
+
+	return nil
+}
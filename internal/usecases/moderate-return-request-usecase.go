@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ModerateReturnRequestUsecaseInput struct {
+	ReturnRequestId uuid.UUID
+	Status          string
+	Note            *string
+	ActorId         uuid.UUID
+}
+
+type ModerateReturnRequestUsecase struct {
+	pgxPool          *pgxpool.Pool
+	returnRequestDAO daos.ReturnRequestDAO
+}
+
+func NewModerateReturnRequestUsecase(pgxPool *pgxpool.Pool, returnRequestDAO daos.ReturnRequestDAO) ModerateReturnRequestUsecase {
+	return ModerateReturnRequestUsecase{pgxPool, returnRequestDAO}
+}
+
+// Execute approves or rejects a return request. Approved units are expected back, rejected ones can be asked to
+// be returned again.
+func (m *ModerateReturnRequestUsecase) Execute(input ModerateReturnRequestUsecaseInput) error {
+	if input.Status != ReturnRequestStatusApproved && input.Status != ReturnRequestStatusRejected {
+		return errors.New("status must be approved or rejected")
+	}
+
+	if m.returnRequestDAO.FindOneById(input.ReturnRequestId) == nil {
+		return errors.New("return request not found")
+	}
+
+	commandTag := utils.GetOrThrow(m.pgxPool.Exec(context.Background(),
+		`UPDATE return_requests SET status = $1, decision_note = $2, decided_by = $3, decided_at = $4
+		WHERE id = $5 AND status = $6`,
+		input.Status, input.Note, input.ActorId, time.Now().UTC(), input.ReturnRequestId, ReturnRequestStatusRequested))
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("return request was already reviewed")
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MoveWishlistItemToCartUsecaseInput struct {
+	CustomerId uuid.UUID
+	WishlistId uuid.UUID
+	ProductId  uuid.UUID
+}
+
+type MoveWishlistItemToCartUsecase struct {
+	pgxPool                 *pgxpool.Pool
+	wishlistDAO             daos.WishlistDAO
+	wishlistItemDAO         daos.WishlistItemDAO
+	addProductToCartUsecase AddProductToCartUsecase
+}
+
+func NewMoveWishlistItemToCartUsecase(pgxPool *pgxpool.Pool, wishlistDAO daos.WishlistDAO, wishlistItemDAO daos.WishlistItemDAO,
+	addProductToCartUsecase AddProductToCartUsecase) MoveWishlistItemToCartUsecase {
+	return MoveWishlistItemToCartUsecase{pgxPool, wishlistDAO, wishlistItemDAO, addProductToCartUsecase}
+}
+
+// Execute adds the item's quantity to the cart as adding the product to the cart would, and takes it off the
+// list only if that succeeds.
+func (m *MoveWishlistItemToCartUsecase) Execute(input MoveWishlistItemToCartUsecaseInput) error {
+	wishlistSchema := m.wishlistDAO.FindOneById(input.WishlistId)
+
+	if wishlistSchema == nil || wishlistSchema.CustomerId != input.CustomerId {
+		return errors.New("wishlist not found")
+	}
+
+	wishlistItemSchema := m.wishlistItemDAO.FindOneByWishlistIdAndProductId(input.WishlistId, input.ProductId)
+
+	if wishlistItemSchema == nil {
+		return errors.New("product not found in wishlist")
+	}
+
+	tx := utils.GetOrThrow(m.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	err := m.addProductToCartUsecase.addToCart(tx, AddProductToCartUsecaseInput{
+		CustomerId: input.CustomerId,
+		ProductId:  input.ProductId,
+		Quantity:   wishlistItemSchema.Quantity,
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM wishlist_items WHERE id = $1", wishlistItemSchema.Id))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultCartReminderThresholds remind customers an hour, a day and three days after they last touched their cart.
+var DefaultCartReminderThresholds = []time.Duration{time.Hour, 24 * time.Hour, 72 * time.Hour}
+
+// ParseCartReminderThresholds parses a comma separated list of durations, such as "1h,24h,72h". An empty value
+// gives the default thresholds.
+func ParseCartReminderThresholds(value string) ([]time.Duration, error) {
+	if strings.TrimSpace(value) == "" {
+		return DefaultCartReminderThresholds, nil
+	}
+
+	thresholds := []time.Duration{}
+
+	for _, part := range strings.Split(value, ",") {
+		threshold, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cart reminder threshold %q: %w", part, err)
+		}
+
+		if threshold <= 0 || (len(thresholds) > 0 && threshold <= thresholds[len(thresholds)-1]) {
+			return nil, errors.New("cart reminder thresholds must be positive and increasing")
+		}
+
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, nil
+}
+
+type NotifyAbandonedCartsUsecase struct {
+	pgxPool              *pgxpool.Pool
+	cartReminderNotifier gateways.CartReminderNotifier
+	thresholds           []time.Duration
+}
+
+func NewNotifyAbandonedCartsUsecase(pgxPool *pgxpool.Pool, cartReminderNotifier gateways.CartReminderNotifier,
+	thresholds []time.Duration) NotifyAbandonedCartsUsecase {
+	return NotifyAbandonedCartsUsecase{pgxPool, cartReminderNotifier, slices.Clone(thresholds)}
+}
+
+func (n *NotifyAbandonedCartsUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(n.pgxPool, n.claim, n.cartReminderNotifier.NotifyCartReminder, func(tx pgx.Tx, reminder gateways.CartReminder) {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO cart_reminders (id, cart_id, customer_id, sequence, sent_at) VALUES ($1, $2, $3, $4, $5)",
+			reminder.ReminderId, reminder.CartId, reminder.CustomerId, reminder.Sequence, time.Now().UTC()))
+	})
+}
+
+// claim counts the reminders a cart got since its last order, so touching the cart does not start the thresholds
+// over.
+func (n *NotifyAbandonedCartsUsecase) claim(tx pgx.Tx, limit int) []gateways.CartReminder {
+	thresholdSeconds := []int64{}
+	for _, threshold := range n.thresholds {
+		thresholdSeconds = append(thresholdSeconds, int64(threshold.Seconds()))
+	}
+
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT c.id, cu.id, cu.name, cu.email, r.sent_count
+			FROM carts c
+			JOIN customers cu
+				ON cu.id = c.customer_id
+			CROSS JOIN LATERAL (
+				SELECT COUNT(*)::INT AS sent_count FROM cart_reminders cr WHERE cr.cart_id = c.id AND cr.order_id IS NULL
+			) r
+			WHERE NOT cu.is_guest
+				AND NOT cu.cart_reminders_opted_out
+				AND r.sent_count < CARDINALITY($1::BIGINT[])
+				AND c.last_activity_at <= $2::TIMESTAMPTZ - ($1::BIGINT[])[r.sent_count + 1] * INTERVAL '1 second'
+				AND EXISTS (
+					SELECT 1
+					FROM cart_items ci
+					JOIN products p
+						ON p.id = ci.product_id
+					WHERE ci.cart_id = c.id
+						AND p.status <> 'unpublished'
+						AND (SELECT COALESCE(SUM(a.stock_quantity), 0) FROM product_available_stock a WHERE a.product_id = p.id) > 0
+				)
+			ORDER BY c.last_activity_at, c.id
+			LIMIT $3
+			FOR UPDATE OF c SKIP LOCKED
+		`, thresholdSeconds, time.Now().UTC(), limit))
+
+	reminders := []gateways.CartReminder{}
+	for rows.Next() {
+		var item gateways.CartReminder
+		var sentCount int32
+
+		utils.ThrowOnError(rows.Scan(&item.CartId, &item.CustomerId, &item.CustomerName, &item.CustomerEmail, &sentCount))
+
+		item.ReminderId = uuid.New()
+		item.Sequence = sentCount + 1
+		reminders = append(reminders, item)
+	}
+
+	for i := range reminders {
+		rows := utils.GetOrThrow(tx.Query(context.Background(),
+			`
+				SELECT p.id, p.name, p.slug, ci.quantity
+				FROM cart_items ci
+				JOIN products p
+					ON p.id = ci.product_id
+				WHERE ci.cart_id = $1
+					AND p.status <> 'unpublished'
+					AND (SELECT COALESCE(SUM(a.stock_quantity), 0) FROM product_available_stock a WHERE a.product_id = p.id) > 0
+				ORDER BY ci.created_at, ci.id
+			`, reminders[i].CartId))
+
+		reminders[i].Items = []gateways.CartReminderItem{}
+		for rows.Next() {
+			var item gateways.CartReminderItem
+
+			utils.ThrowOnError(rows.Scan(&item.ProductId, &item.ProductName, &item.ProductSlug, &item.Quantity))
+			reminders[i].Items = append(reminders[i].Items, item)
+		}
+	}
+
+	return reminders
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotifyGuestOrdersUsecase struct {
+	pgxPool             *pgxpool.Pool
+	orderLookupNotifier gateways.OrderLookupNotifier
+	awsSecretsGateway   gateways.AwsSecretsGateway
+	publicUrl           string
+}
+
+func NewNotifyGuestOrdersUsecase(pgxPool *pgxpool.Pool, orderLookupNotifier gateways.OrderLookupNotifier,
+	awsSecretsGateway gateways.AwsSecretsGateway, publicUrl string) NotifyGuestOrdersUsecase {
+	return NotifyGuestOrdersUsecase{pgxPool, orderLookupNotifier, awsSecretsGateway, publicUrl}
+}
+
+func (n *NotifyGuestOrdersUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(n.pgxPool, n.claim, n.orderLookupNotifier.NotifyOrderLookup, func(tx pgx.Tx, notification gateways.OrderLookupNotification) {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE orders SET lookup_link_sent_at = $1 WHERE id = $2",
+			time.Now().UTC(), notification.OrderId))
+	})
+}
+
+func (n *NotifyGuestOrdersUsecase) claim(tx pgx.Tx, limit int) []gateways.OrderLookupNotification {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT o.id, c.id, c.name, c.email
+			FROM orders o
+			JOIN customers c
+				ON c.id = o.customer_id
+			WHERE c.is_guest AND o.lookup_link_sent_at IS NULL
+			ORDER BY o.created_at, o.id
+			LIMIT $1
+			FOR UPDATE OF o SKIP LOCKED
+		`, limit))
+
+	notifications := []gateways.OrderLookupNotification{}
+	for rows.Next() {
+		var item gateways.OrderLookupNotification
+
+		utils.ThrowOnError(rows.Scan(&item.OrderId, &item.CustomerId, &item.CustomerName, &item.CustomerEmail))
+		notifications = append(notifications, item)
+	}
+
+	for i := range notifications {
+		lookupToken := signOrderLookupToken(n.awsSecretsGateway, notifications[i].OrderId)
+		notifications[i].LookupUrl = fmt.Sprintf("%s/v1/order-lookup?token=%s", n.publicUrl, url.QueryEscape(lookupToken))
+	}
+
+	return notifications
+}
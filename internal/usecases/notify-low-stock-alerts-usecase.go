@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotifyLowStockAlertsUsecase struct {
+	pgxPool          *pgxpool.Pool
+	lowStockNotifier gateways.LowStockNotifier
+}
+
+func NewNotifyLowStockAlertsUsecase(pgxPool *pgxpool.Pool, lowStockNotifier gateways.LowStockNotifier) NotifyLowStockAlertsUsecase {
+	return NotifyLowStockAlertsUsecase{pgxPool, lowStockNotifier}
+}
+
+func (n *NotifyLowStockAlertsUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(n.pgxPool, n.claim, n.lowStockNotifier.NotifyLowStock, func(tx pgx.Tx, alert gateways.LowStockAlert) {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE low_stock_alerts SET notified_at = $1 WHERE id = $2",
+			time.Now().UTC(), alert.AlertId))
+	})
+}
+
+func (n *NotifyLowStockAlertsUsecase) claim(tx pgx.Tx, limit int) []gateways.LowStockAlert {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT a.id, a.inventory_id, a.product_id, p.name, a.warehouse_id, w.name, a.stock_quantity, a.reorder_point, a.created_at
+			FROM low_stock_alerts a
+			JOIN products p
+				ON p.id = a.product_id
+			JOIN warehouses w
+				ON w.id = a.warehouse_id
+			WHERE a.notified_at IS NULL
+			ORDER BY a.created_at, a.id
+			LIMIT $1
+			FOR UPDATE OF a SKIP LOCKED
+		`, limit))
+
+	alerts := []gateways.LowStockAlert{}
+	for rows.Next() {
+		var item gateways.LowStockAlert
+
+		utils.ThrowOnError(rows.Scan(&item.AlertId, &item.InventoryId, &item.ProductId, &item.ProductName, &item.WarehouseId,
+			&item.WarehouseName, &item.StockQuantity, &item.ReorderPoint, &item.CreatedAt))
+		alerts = append(alerts, item)
+	}
+
+	return alerts
+}
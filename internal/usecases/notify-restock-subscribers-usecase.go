@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotifyRestockSubscribersUsecase struct {
+	pgxPool         *pgxpool.Pool
+	restockNotifier gateways.RestockNotifier
+	publicUrl       string
+}
+
+func NewNotifyRestockSubscribersUsecase(pgxPool *pgxpool.Pool, restockNotifier gateways.RestockNotifier,
+	publicUrl string) NotifyRestockSubscribersUsecase {
+	return NotifyRestockSubscribersUsecase{pgxPool, restockNotifier, publicUrl}
+}
+
+func (n *NotifyRestockSubscribersUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(n.pgxPool, n.claim, n.restockNotifier.NotifyRestock, func(tx pgx.Tx, notification gateways.RestockNotification) {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE restock_subscriptions SET notified_at = $1 WHERE id = $2",
+			time.Now().UTC(), notification.SubscriptionId))
+	})
+}
+
+func (n *NotifyRestockSubscribersUsecase) claim(tx pgx.Tx, limit int) []gateways.RestockNotification {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT s.id, s.unsubscribe_token, c.id, c.name, c.email, p.id, p.name, p.slug
+			FROM restock_subscriptions s
+			JOIN customers c
+				ON c.id = s.customer_id
+			JOIN products p
+				ON p.id = s.product_id
+			WHERE s.queued_at IS NOT NULL
+				AND s.notified_at IS NULL
+				AND s.unsubscribed_at IS NULL
+			ORDER BY s.queued_at, s.id
+			LIMIT $1
+			FOR UPDATE OF s SKIP LOCKED
+		`, limit))
+
+	notifications := []gateways.RestockNotification{}
+	for rows.Next() {
+		var item gateways.RestockNotification
+		var unsubscribeToken string
+
+		utils.ThrowOnError(rows.Scan(&item.SubscriptionId, &unsubscribeToken, &item.CustomerId, &item.CustomerName, &item.CustomerEmail,
+			&item.ProductId, &item.ProductName, &item.ProductSlug))
+
+		item.UnsubscribeUrl = fmt.Sprintf("%s/v1/unsubscribe-from-restock?token=%s", n.publicUrl, url.QueryEscape(unsubscribeToken))
+		notifications = append(notifications, item)
+	}
+
+	return notifications
+}
+
+// outOfStockProductIds returns the product and the bundles made with it that have no stock available.
+func outOfStockProductIds(tx pgx.Tx, productId uuid.UUID) []uuid.UUID {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT product_id
+			FROM product_available_stock
+			WHERE stock_quantity <= 0
+				AND (product_id = $1 OR product_id IN (SELECT bundle_product_id FROM bundle_components WHERE component_product_id = $1))
+		`, productId))
+
+	productIds := []uuid.UUID{}
+	for rows.Next() {
+		var item uuid.UUID
+
+		utils.ThrowOnError(rows.Scan(&item))
+		productIds = append(productIds, item)
+	}
+
+	return productIds
+}
+
+// queueRestockNotifications queues the pending subscriptions of the products, out of stock until now, that are
+// back in stock.
+func queueRestockNotifications(tx pgx.Tx, productIds []uuid.UUID) {
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`
+			UPDATE restock_subscriptions s SET queued_at = $2
+			WHERE s.product_id = ANY($1)
+				AND s.queued_at IS NULL
+				AND s.notified_at IS NULL
+				AND s.unsubscribed_at IS NULL
+				AND (SELECT COALESCE(SUM(a.stock_quantity), 0) FROM product_available_stock a WHERE a.product_id = s.product_id) > 0
+		`, productIds, time.Now().UTC()))
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+// OrderLookupTokenTTL is how long the order lookup link sent to a guest customer stays valid.
+const OrderLookupTokenTTL = 90 * 24 * time.Hour
+
+type JwtOrderLookupTokenClaims struct {
+	jwt.RegisteredClaims
+}
+
+// signOrderLookupToken signs the token of an order lookup link. Whoever holds it received the order email, so
+// it also lets the guest customer convert to a full account.
+func signOrderLookupToken(awsSecretsGateway gateways.AwsSecretsGateway, orderId uuid.UUID) string {
+	lookupToken := jwt.NewWithClaims(jwt.SigningMethodHS256, JwtOrderLookupTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   orderId.String(),
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(OrderLookupTokenTTL)),
+		},
+	})
+
+	lookupTokenSigningKey := utils.GetOrThrow(awsSecretsGateway.Get("ORDER_LOOKUP_TOKEN_SIGNING_KEY"))
+	return utils.GetOrThrow(lookupToken.SignedString([]byte(lookupTokenSigningKey)))
+}
+
+func parseOrderLookupToken(awsSecretsGateway gateways.AwsSecretsGateway, lookupToken string) (uuid.UUID, error) {
+	lookupTokenSigningKey := utils.GetOrThrow(awsSecretsGateway.Get("ORDER_LOOKUP_TOKEN_SIGNING_KEY"))
+
+	token, err := jwt.ParseWithClaims(lookupToken, new(JwtOrderLookupTokenClaims), func(token *jwt.Token) (any, error) {
+		return []byte(lookupTokenSigningKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}))
+	if err != nil {
+		return uuid.UUID{}, errors.New("order lookup token is invalid")
+	}
+
+	orderId, err := uuid.Parse(token.Claims.(*JwtOrderLookupTokenClaims).Subject)
+	if err != nil {
+		return uuid.UUID{}, errors.New("order lookup token is invalid")
+	}
+
+	return orderId, nil
+}
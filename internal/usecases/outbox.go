@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const outboxBatchSize = 100
+
+type OutboxDispatchOutput struct {
+	NotifiedCount int64
+	HasMore       bool
+}
+
+// dispatchOutbox sends a batch of pending items and records each one sent, in a single transaction. claim must
+// lock the rows it returns with SKIP LOCKED. Items sent before a send fails stay recorded.
+func dispatchOutbox[T any](pgxPool *pgxpool.Pool, claim func(tx pgx.Tx, limit int) []T, send func(item T) error,
+	record func(tx pgx.Tx, item T)) (OutboxDispatchOutput, error) {
+	tx := utils.GetOrThrow(pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	items := claim(tx, outboxBatchSize)
+	output := OutboxDispatchOutput{HasMore: len(items) == outboxBatchSize}
+
+	var sendErr error
+	for _, item := range items {
+		if sendErr = send(item); sendErr != nil {
+			output.HasMore = false
+			break
+		}
+
+		record(tx, item)
+		output.NotifiedCount++
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return output, sendErr
+}
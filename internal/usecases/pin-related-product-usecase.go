@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PinRelatedProductUsecaseInput struct {
+	ProductId        uuid.UUID
+	RelatedProductId uuid.UUID
+	Position         int32
+}
+
+type PinRelatedProductUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewPinRelatedProductUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) PinRelatedProductUsecase {
+	return PinRelatedProductUsecase{pgxPool, productDAO}
+}
+
+func (p *PinRelatedProductUsecase) Execute(input PinRelatedProductUsecaseInput) error {
+	if input.Position <= 0 {
+		return errors.New("position must be higher than zero")
+	}
+
+	if input.ProductId == input.RelatedProductId {
+		return errors.New("a product cannot be related to itself")
+	}
+
+	if !p.productDAO.ExistsById(input.ProductId) {
+		return errors.New("product not found")
+	}
+
+	if !p.productDAO.ExistsById(input.RelatedProductId) {
+		return errors.New("related product not found")
+	}
+
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		`
+			INSERT INTO product_recommendation_pins (product_id, related_product_id, position, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (product_id, related_product_id) DO UPDATE SET position = EXCLUDED.position
+		`, input.ProductId, input.RelatedProductId, input.Position, time.Now().UTC()))
+
+	return nil
+}
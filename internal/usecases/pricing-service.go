@@ -0,0 +1,306 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+const (
+	PromotionTypePercentage   = "percentage"
+	PromotionTypeFixedAmount  = "fixed_amount"
+	PromotionTypeBuyXGetY     = "buy_x_get_y"
+	PromotionTypeFreeShipping = "free_shipping"
+)
+
+type PricingLine struct {
+	ProductId   uuid.UUID
+	TaxCategory string
+	Quantity    int32
+	UnitPrice   int64
+}
+
+// PricingDiscount is what a coupon takes off the cart. ProductId is set when it only discounts that product.
+type PricingDiscount struct {
+	PromotionId uuid.UUID
+	Code        string
+	Type        string
+	Amount      int64
+	ProductId   *uuid.UUID
+}
+
+// Pricing is what a cart costs, in its currency. Total is the subtotal net of the discounts plus the shipping and
+// the tax. CouponCode is the coupon applied to the cart, whether or not it gave a discount, and ShippingMethod the
+// method the shipping was quoted by, if any.
+type Pricing struct {
+	Currency       string
+	CouponCode     *string
+	Subtotal       int64
+	Discounts      []PricingDiscount
+	ShippingMethod *ShippingQuote
+	Shipping       int64
+	Tax            int64
+	Taxes          []TaxLine
+	Total          int64
+	FreeShipping   bool
+}
+
+// PricingService prices carts. The cart view, payment preference and order all go through it, so the
+// customer is charged what the cart showed.
+type PricingService struct {
+	promotionDAO        daos.PromotionDAO
+	shippingMethodDAO   daos.ShippingMethodDAO
+	shippingZoneRateDAO daos.ShippingZoneRateDAO
+	taxProvider         TaxProvider
+}
+
+func NewPricingService(promotionDAO daos.PromotionDAO, shippingMethodDAO daos.ShippingMethodDAO,
+	shippingZoneRateDAO daos.ShippingZoneRateDAO, taxProvider TaxProvider) PricingService {
+	return PricingService{promotionDAO, shippingMethodDAO, shippingZoneRateDAO, taxProvider}
+}
+
+// Price totals the lines, applies the coupon, if any, and adds the shipping and the tax for the destination. A
+// coupon that does not apply to the cart is left out rather than failing, since the cart may still change to meet
+// it, and so is a shipping method that does not ship to the destination. Without a destination the shipping and
+// the tax are not known yet and are left out.
+func (p *PricingService) Price(querier pgxQuerier, customerId uuid.UUID, currency string, promotionId *uuid.UUID,
+	shippingMethodId *uuid.UUID, destination *TaxDestination, lines []PricingLine) (Pricing, error) {
+	pricing := Pricing{
+		Currency:  currency,
+		Discounts: []PricingDiscount{},
+		Taxes:     []TaxLine{},
+	}
+
+	for _, line := range lines {
+		pricing.Subtotal += line.UnitPrice * int64(line.Quantity)
+	}
+
+	pricing.Total = pricing.Subtotal
+
+	if promotionId != nil {
+		p.applyCoupon(querier, &pricing, customerId, *promotionId, lines)
+	}
+
+	if destination == nil {
+		return pricing, nil
+	}
+
+	if shippingMethodId != nil {
+		if shippingMethodSchema := p.shippingMethodDAO.FindOneById(*shippingMethodId); shippingMethodSchema != nil {
+			if quote, ok := p.quoteShipping(querier, *shippingMethodSchema, destination.ZipCode, pricing, lines); ok {
+				pricing.ShippingMethod = &quote
+				pricing.Shipping = quote.Price
+				pricing.Total += quote.Price
+			}
+		}
+	}
+
+	var taxExempt bool
+	utils.ThrowOnError(querier.QueryRow(context.Background(), "SELECT tax_exempt FROM customers WHERE id = $1", customerId).
+		Scan(&taxExempt))
+
+	if taxExempt {
+		return pricing, nil
+	}
+
+	taxLines, err := p.taxProvider.Calculate(*destination, taxableLines(lines, pricing.Discounts))
+	if err != nil {
+		return Pricing{}, err
+	}
+
+	for _, taxLine := range taxLines {
+		pricing.Tax += taxLine.Amount
+	}
+
+	pricing.Taxes = taxLines
+	pricing.Total += pricing.Tax
+
+	return pricing, nil
+}
+
+// QuoteShipping returns what each active shipping method in the cart currency charges to ship the lines to the
+// destination, leaving out the methods that do not ship there. pricing is the cart priced without a shipping method.
+func (p *PricingService) QuoteShipping(querier pgxQuerier, destination TaxDestination, pricing Pricing,
+	lines []PricingLine) []ShippingQuote {
+	quotes := []ShippingQuote{}
+
+	for _, shippingMethodSchema := range p.shippingMethodDAO.FindAllActiveByCurrency(pricing.Currency) {
+		if quote, ok := p.quoteShipping(querier, shippingMethodSchema, destination.ZipCode, pricing, lines); ok {
+			quotes = append(quotes, quote)
+		}
+	}
+
+	return quotes
+}
+
+func (p *PricingService) applyCoupon(querier pgxQuerier, pricing *Pricing, customerId uuid.UUID, promotionId uuid.UUID,
+	lines []PricingLine) {
+	promotionSchema := p.promotionDAO.FindOneById(promotionId)
+
+	if promotionSchema == nil {
+		return
+	}
+
+	pricing.CouponCode = &promotionSchema.Code
+
+	discount, err := p.Discount(querier, *promotionSchema, customerId, pricing.Currency, lines)
+	if err != nil {
+		return
+	}
+
+	pricing.Discounts = append(pricing.Discounts, discount)
+	pricing.Total -= discount.Amount
+	pricing.FreeShipping = promotionSchema.Type == PromotionTypeFreeShipping
+}
+
+// Discount returns what the coupon takes off the lines, or why it does not apply to them.
+func (p *PricingService) Discount(querier pgxQuerier, promotionSchema daos.PromotionSchema, customerId uuid.UUID, currency string,
+	lines []PricingLine) (PricingDiscount, error) {
+	now := time.Now().UTC()
+
+	if promotionSchema.StartsAt != nil && now.Before(*promotionSchema.StartsAt) {
+		return PricingDiscount{}, errors.New("coupon is not active")
+	}
+
+	if promotionSchema.EndsAt != nil && !now.Before(*promotionSchema.EndsAt) {
+		return PricingDiscount{}, errors.New("coupon is not active")
+	}
+
+	if promotionSchema.Currency != nil && *promotionSchema.Currency != currency {
+		return PricingDiscount{}, errors.New("coupon does not apply to the cart currency")
+	}
+
+	subtotal := int64(0)
+	for _, line := range lines {
+		subtotal += line.UnitPrice * int64(line.Quantity)
+	}
+
+	if promotionSchema.MinimumSubtotal != nil && subtotal < *promotionSchema.MinimumSubtotal {
+		return PricingDiscount{}, errors.New("cart subtotal is below the coupon minimum")
+	}
+
+	// Cancelled orders give their coupon use back.
+	var usageCount int32
+	var customerUsageCount int32
+
+	utils.ThrowOnError(querier.QueryRow(context.Background(),
+		`
+			SELECT COUNT(*)::INT, (COUNT(*) FILTER (WHERE o.customer_id = $2))::INT
+			FROM order_discounts od
+			JOIN orders o
+				ON o.id = od.order_id
+			WHERE od.promotion_id = $1 AND o.status <> 'cancelled'
+		`, promotionSchema.Id, customerId).Scan(&usageCount, &customerUsageCount))
+
+	if promotionSchema.UsageLimit != nil && usageCount >= *promotionSchema.UsageLimit {
+		return PricingDiscount{}, errors.New("coupon usage limit was reached")
+	}
+
+	if promotionSchema.PerCustomerLimit != nil && customerUsageCount >= *promotionSchema.PerCustomerLimit {
+		return PricingDiscount{}, errors.New("coupon was already used the maximum number of times")
+	}
+
+	discount := PricingDiscount{
+		PromotionId: promotionSchema.Id,
+		Code:        promotionSchema.Code,
+		Type:        promotionSchema.Type,
+	}
+
+	switch promotionSchema.Type {
+	case PromotionTypePercentage:
+		discount.Amount = subtotal * int64(*promotionSchema.Percentage) / 100
+	case PromotionTypeFixedAmount:
+		discount.Amount = min(*promotionSchema.Amount, subtotal)
+	case PromotionTypeBuyXGetY:
+		discount.ProductId = promotionSchema.ProductId
+
+		for _, line := range lines {
+			if line.ProductId != *promotionSchema.ProductId {
+				continue
+			}
+
+			// Every buy_quantity + get_quantity units, get_quantity of them are free.
+			freeQuantity := line.Quantity / (*promotionSchema.BuyQuantity + *promotionSchema.GetQuantity) * *promotionSchema.GetQuantity
+			discount.Amount = int64(freeQuantity) * line.UnitPrice
+		}
+
+		if discount.Amount == 0 {
+			return PricingDiscount{}, errors.New("coupon does not apply to the cart items")
+		}
+	}
+
+	return discount, nil
+}
+
+// taxableLines spreads the discounts over the lines they apply to, in proportion to the line amounts, since tax is
+// paid on what the customer is charged. Rounding leftovers go to the last line a discount applies to.
+func taxableLines(lines []PricingLine, discounts []PricingDiscount) []TaxableLine {
+	taxable := []TaxableLine{}
+	for _, line := range lines {
+		taxable = append(taxable, TaxableLine{
+			ProductId:   line.ProductId,
+			TaxCategory: line.TaxCategory,
+			Amount:      line.UnitPrice * int64(line.Quantity),
+		})
+	}
+
+	for _, discount := range discounts {
+		eligible := []int{}
+		eligibleAmount := int64(0)
+
+		for i, line := range taxable {
+			if discount.ProductId == nil || line.ProductId == *discount.ProductId {
+				eligible = append(eligible, i)
+				eligibleAmount += line.Amount
+			}
+		}
+
+		if eligibleAmount == 0 {
+			continue
+		}
+
+		amounts := []int64{}
+		for _, i := range eligible {
+			amounts = append(amounts, taxable[i].Amount)
+		}
+
+		remaining := discount.Amount
+		for j, i := range eligible {
+			share := discount.Amount * amounts[j] / eligibleAmount
+
+			if j == len(eligible)-1 {
+				share = remaining
+			}
+
+			share = min(share, taxable[i].Amount)
+			taxable[i].Amount -= share
+			remaining -= share
+		}
+	}
+
+	return taxable
+}
+
+// cartPricingLines returns the cart lines priced in the cart currency, the only ones the cart totals count.
+func cartPricingLines(lines []CartLine) []PricingLine {
+	pricingLines := []PricingLine{}
+
+	for _, line := range lines {
+		if line.Price == nil {
+			continue
+		}
+
+		pricingLines = append(pricingLines, PricingLine{
+			ProductId:   line.ProductId,
+			TaxCategory: line.Product.TaxCategory,
+			Quantity:    line.Quantity,
+			UnitPrice:   *line.Price,
+		})
+	}
+
+	return pricingLines
+}
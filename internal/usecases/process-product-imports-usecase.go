@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type queuedProductImportJob struct {
+	Id      uuid.UUID
+	Format  string
+	Content []byte
+}
+
+type ProcessProductImportsUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewProcessProductImportsUsecase(pgxPool *pgxpool.Pool) ProcessProductImportsUsecase {
+	return ProcessProductImportsUsecase{pgxPool}
+}
+
+// Execute processes the oldest queued import job, one row at a time. A job that cannot be processed to the end is
+// marked as failed and its error returned.
+func (p *ProcessProductImportsUsecase) Execute() (OutboxDispatchOutput, error) {
+	var job queuedProductImportJob
+
+	err := p.pgxPool.QueryRow(context.Background(),
+		`
+			UPDATE product_import_jobs
+			SET status = $1
+			WHERE id = (
+				SELECT id
+				FROM product_import_jobs
+				WHERE status = $2
+				ORDER BY created_at, id
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, format, content
+		`, "processing", "queued").Scan(&job.Id, &job.Format, &job.Content)
+
+	if err == pgx.ErrNoRows {
+		return OutboxDispatchOutput{}, nil
+	}
+
+	utils.ThrowOnError(err)
+
+	if err := p.process(job); err != nil {
+		_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+			"UPDATE product_import_jobs SET status = $1, content = NULL, finished_at = $2 WHERE id = $3", "failed", time.Now().UTC(), job.Id))
+
+		return OutboxDispatchOutput{HasMore: true}, err
+	}
+
+	return OutboxDispatchOutput{NotifiedCount: 1, HasMore: true}, nil
+}
+
+func (p *ProcessProductImportsUsecase) process(job queuedProductImportJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("product import job %s failed: %v", job.Id, r)
+		}
+	}()
+
+	rows, err := parseProductImport(job.Format, job.Content)
+	if err != nil {
+		return fmt.Errorf("product import job %s failed: %w", job.Id, err)
+	}
+
+	for _, row := range rows {
+		err := row.ParseError
+
+		if err == nil {
+			err = validateProductImportRow(row)
+		}
+
+		if err == nil {
+			err = p.upsert(row)
+		}
+
+		if err == nil {
+			_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+				"UPDATE product_import_jobs SET processed_rows = processed_rows + 1 WHERE id = $1", job.Id))
+
+			continue
+		}
+
+		tx := utils.GetOrThrow(p.pgxPool.Begin(context.Background()))
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO product_import_job_errors (id, product_import_job_id, row_number, message, created_at) VALUES ($1, $2, $3, $4, $5)",
+			uuid.New(), job.Id, row.Number, err.Error(), time.Now().UTC()))
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE product_import_jobs SET processed_rows = processed_rows + 1, failed_rows = failed_rows + 1 WHERE id = $1", job.Id))
+
+		utils.ThrowOnError(tx.Commit(context.Background()))
+	}
+
+	_ = utils.GetOrThrow(p.pgxPool.Exec(context.Background(),
+		"UPDATE product_import_jobs SET status = $1, content = NULL, finished_at = $2 WHERE id = $3", "completed", time.Now().UTC(), job.Id))
+
+	return nil
+}
+
+func (p *ProcessProductImportsUsecase) upsert(row productImportRow) (err error) {
+	tx := utils.GetOrThrow(p.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+
+		if recover() != nil {
+			err = errors.New("the product could not be saved")
+		}
+	}()
+
+	var productId uuid.UUID
+
+	if row.Sku != nil {
+		err = tx.QueryRow(context.Background(), "SELECT id FROM products WHERE sku = $1 FOR UPDATE", *row.Sku).Scan(&productId)
+	} else {
+		productIds := utils.GetOrThrow(pgx.CollectRows(
+			utils.GetOrThrow(tx.Query(context.Background(), "SELECT id FROM products WHERE name = $1 LIMIT 2 FOR UPDATE", row.Name)),
+			pgx.RowTo[uuid.UUID]))
+
+		switch len(productIds) {
+		case 0:
+			err = pgx.ErrNoRows
+		case 1:
+			productId = productIds[0]
+		default:
+			return errors.New("the product name matches more than one product")
+		}
+	}
+
+	if err != nil && err != pgx.ErrNoRows {
+		panic(err)
+	}
+
+	var nameTaken bool
+	utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT EXISTS (SELECT 1 FROM products WHERE name = $1 AND id <> $2)",
+		row.Name, productId).Scan(&nameTaken))
+
+	if nameTaken {
+		return errors.New("product name already exists")
+	}
+
+	if err == pgx.ErrNoRows {
+		productId = uuid.New()
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO products (id, sku, status, name, description, price, currency, created_at) VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, $8), $9)",
+			productId, row.Sku, "unpublished", row.Name, row.Description, row.Price, row.Currency, utils.DefaultCurrency, time.Now().UTC()))
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO inventories (id, product_id, stock_quantity, created_at) VALUES ($1, $2, $3, $4)",
+			uuid.New(), productId, 0, time.Now().UTC()))
+	} else {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE products SET sku = COALESCE($1, sku), name = $2, description = $3, price = $4, currency = COALESCE($5, currency) WHERE id = $6",
+			row.Sku, row.Name, row.Description, row.Price, row.Currency, productId))
+	}
+
+	assignProductSlug(tx, productId, row.Name)
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
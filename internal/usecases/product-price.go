@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+// findProductPrice returns the product price in the given currency. The price list wins; the product's own
+// price is used only when the currency is the product's base currency.
+func findProductPrice(productPriceDAO daos.ProductPriceDAO, productSchema daos.ProductSchema, currency string) (utils.Money, bool) {
+	productPriceSchema := productPriceDAO.FindOneByProductIdAndCurrency(productSchema.Id, currency)
+
+	if productPriceSchema != nil {
+		return utils.Money{Amount: productPriceSchema.Price, Currency: currency}, true
+	}
+
+	if productSchema.Currency == currency {
+		return utils.Money{Amount: productSchema.Price, Currency: currency}, true
+	}
+
+	return utils.Money{}, false
+}
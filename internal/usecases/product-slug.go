@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// assignProductSlug regenerates the product slug from its name, keeping the previous slug as a redirect.
+func assignProductSlug(tx pgx.Tx, productId uuid.UUID, name string) string {
+	base := utils.Slugify(name)
+
+	if base == "" {
+		base = "product"
+	}
+
+	if len(base) > 100 {
+		base = strings.TrimRight(base[:100], "-")
+	}
+
+	var currentSlug *string
+	utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT slug FROM products WHERE id = $1 FOR UPDATE", productId).Scan(&currentSlug))
+
+	slug := base
+	for suffix := 2; ; suffix++ {
+		var taken bool
+
+		utils.ThrowOnError(tx.QueryRow(context.Background(),
+			`SELECT
+				EXISTS (SELECT 1 FROM products WHERE slug = $1 AND id <> $2) OR
+				EXISTS (SELECT 1 FROM product_slug_redirects WHERE slug = $1 AND product_id <> $2)`,
+			slug, productId).Scan(&taken))
+
+		if !taken {
+			break
+		}
+
+		slug = fmt.Sprintf("%s-%d", base, suffix)
+	}
+
+	if currentSlug != nil && *currentSlug == slug {
+		return slug
+	}
+
+	if currentSlug != nil {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO product_slug_redirects (slug, product_id, created_at) VALUES ($1, $2, $3) ON CONFLICT (slug) DO NOTHING",
+			*currentSlug, productId, time.Now().UTC()))
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM product_slug_redirects WHERE slug = $1", slug))
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE products SET slug = $1 WHERE id = $2", slug, productId))
+
+	return slug
+}
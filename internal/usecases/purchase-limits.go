@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+// customerPurchasedQuantity returns how many units of a product a customer ordered within the product's purchase
+// limit period. Only the products the customer picked count, not the components shipped for a bundle, and
+// cancelled orders do not count.
+func customerPurchasedQuantity(querier pgxQuerier, productSchema daos.ProductSchema, customerId uuid.UUID) int32 {
+	var quantity int32
+
+	since := time.Now().UTC().AddDate(0, 0, -int(*productSchema.MaxPerCustomerPeriodDays))
+
+	utils.ThrowOnError(querier.QueryRow(context.Background(),
+		`SELECT COALESCE(SUM(oi.quantity), 0)::INT FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.customer_id = $1 AND oi.product_id = $2 AND oi.parent_order_item_id IS NULL AND o.created_at >= $3
+		AND o.status <> 'cancelled'`,
+		customerId, productSchema.Id, since).Scan(&quantity))
+
+	return quantity
+}
+
+// checkPurchaseLimits checks the customer can hold quantity units of a product in the cart, quantity being the
+// whole cart line rather than the units being added. The per-customer limit also counts what the customer
+// already ordered, and is skipped for guest carts since there is no customer to count for yet.
+func checkPurchaseLimits(querier pgxQuerier, productSchema daos.ProductSchema, customerId *uuid.UUID, quantity int32) error {
+	if productSchema.MaxPerOrder != nil && quantity > *productSchema.MaxPerOrder {
+		return errors.New("product quantity exceeds the maximum per order")
+	}
+
+	if productSchema.MaxPerCustomer == nil || customerId == nil {
+		return nil
+	}
+
+	if customerPurchasedQuantity(querier, productSchema, *customerId)+quantity > *productSchema.MaxPerCustomer {
+		return errors.New("product quantity exceeds the maximum per customer")
+	}
+
+	return nil
+}
+
+// purchaseLimit returns the most units of a product the customer can hold in the cart, or false when the
+// product has no purchase limits.
+func purchaseLimit(querier pgxQuerier, productSchema daos.ProductSchema, customerId uuid.UUID) (int32, bool) {
+	if productSchema.MaxPerOrder == nil && productSchema.MaxPerCustomer == nil {
+		return 0, false
+	}
+
+	limit := int32(0)
+
+	if productSchema.MaxPerOrder != nil {
+		limit = *productSchema.MaxPerOrder
+	}
+
+	if productSchema.MaxPerCustomer != nil {
+		remaining := max(*productSchema.MaxPerCustomer-customerPurchasedQuantity(querier, productSchema, customerId), 0)
+
+		if productSchema.MaxPerOrder == nil || remaining < limit {
+			limit = remaining
+		}
+	}
+
+	return limit, true
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+type QuoteGuestShippingRatesUsecaseInput struct {
+	GuestCartId uuid.UUID
+}
+
+type QuoteGuestShippingRatesUsecase struct {
+	guestCartDAO              daos.GuestCartDAO
+	quoteShippingRatesUsecase QuoteShippingRatesUsecase
+}
+
+func NewQuoteGuestShippingRatesUsecase(guestCartDAO daos.GuestCartDAO,
+	quoteShippingRatesUsecase QuoteShippingRatesUsecase) QuoteGuestShippingRatesUsecase {
+	return QuoteGuestShippingRatesUsecase{guestCartDAO, quoteShippingRatesUsecase}
+}
+
+// Execute quotes the shipping methods that ship a checked out guest cart to the address given at the guest checkout.
+func (q *QuoteGuestShippingRatesUsecase) Execute(input QuoteGuestShippingRatesUsecaseInput) (QuoteShippingRatesUsecaseOutput, error) {
+	guestCartSchema, err := findCheckedOutGuestCart(q.guestCartDAO, input.GuestCartId)
+	if err != nil {
+		return QuoteShippingRatesUsecaseOutput{}, err
+	}
+
+	return q.quoteShippingRatesUsecase.Execute(QuoteShippingRatesUsecaseInput{
+		CustomerId: *guestCartSchema.CustomerId,
+		AddressId:  guestCartSchema.AddressId,
+	})
+}
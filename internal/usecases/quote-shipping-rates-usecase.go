@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type QuoteShippingRatesUsecaseInput struct {
+	CustomerId uuid.UUID
+	// AddressId is where the cart would ship to. The default address is used when it is nil.
+	AddressId *uuid.UUID
+}
+
+type QuoteShippingRatesUsecaseOutput struct {
+	Currency string
+	Quotes   []ShippingQuote
+}
+
+type QuoteShippingRatesUsecase struct {
+	pgxPool        *pgxpool.Pool
+	cartDAO        daos.CartDAO
+	addressDAO     daos.AddressDAO
+	pricingService PricingService
+}
+
+func NewQuoteShippingRatesUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, addressDAO daos.AddressDAO,
+	pricingService PricingService) QuoteShippingRatesUsecase {
+	return QuoteShippingRatesUsecase{pgxPool, cartDAO, addressDAO, pricingService}
+}
+
+// Execute quotes every shipping method that ships the cart to the address, so the customer can pick one.
+func (q *QuoteShippingRatesUsecase) Execute(input QuoteShippingRatesUsecaseInput) (QuoteShippingRatesUsecaseOutput, error) {
+	cartSchema := q.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema == nil {
+		return QuoteShippingRatesUsecaseOutput{}, errors.New("cart not found")
+	}
+
+	var addressSchema *daos.AddressSchema
+
+	if input.AddressId != nil {
+		addressSchema = q.addressDAO.FindOneByIdAndCustomerId(*input.AddressId, input.CustomerId)
+	} else {
+		addressSchema = q.addressDAO.FindOneDefaultByCustomerId(input.CustomerId)
+	}
+
+	if addressSchema == nil {
+		return QuoteShippingRatesUsecaseOutput{}, errors.New("address not found")
+	}
+
+	lines := cartPricingLines(FindCartLines(q.pgxPool, cartSchema.Id))
+
+	if len(lines) == 0 {
+		return QuoteShippingRatesUsecaseOutput{}, errors.New("cart is empty")
+	}
+
+	pricing, err := q.pricingService.Price(q.pgxPool, input.CustomerId, cartSchema.Currency, cartSchema.PromotionId, nil, nil, lines)
+	if err != nil {
+		return QuoteShippingRatesUsecaseOutput{}, err
+	}
+
+	destination := TaxDestination{State: addressSchema.State, ZipCode: addressSchema.ZipCode}
+
+	return QuoteShippingRatesUsecaseOutput{
+		Currency: cartSchema.Currency,
+		Quotes:   q.pricingService.QuoteShipping(q.pgxPool, destination, pricing, lines),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReceiveReturnRequestUsecaseInput struct {
+	ReturnRequestId uuid.UUID
+	// Restock puts the returned units back into stock. Units that cannot be sold again are left out of it.
+	Restock bool
+	ActorId uuid.UUID
+}
+
+type ReceiveReturnRequestUsecaseOutput struct {
+	RefundedAmount int64
+}
+
+type ReceiveReturnRequestUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewReceiveReturnRequestUsecase(pgxPool *pgxpool.Pool) ReceiveReturnRequestUsecase {
+	return ReceiveReturnRequestUsecase{pgxPool}
+}
+
+// Execute records that the units of an approved return request came back, optionally restocks them and refunds
+// what was paid for them.
+func (r *ReceiveReturnRequestUsecase) Execute(input ReceiveReturnRequestUsecaseInput) (ReceiveReturnRequestUsecaseOutput, error) {
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var orderId, orderItemId uuid.UUID
+	var status, currency string
+	var price int64
+	var quantity, orderItemQuantity int32
+
+	err := tx.QueryRow(context.Background(),
+		`SELECT rr.status, rr.quantity, oi.id, oi.order_id, oi.quantity, oi.price, oi.currency
+		FROM return_requests rr JOIN order_items oi ON oi.id = rr.order_item_id
+		WHERE rr.id = $1 FOR UPDATE OF rr`, input.ReturnRequestId).
+		Scan(&status, &quantity, &orderItemId, &orderId, &orderItemQuantity, &price, &currency)
+	if err == pgx.ErrNoRows {
+		return ReceiveReturnRequestUsecaseOutput{}, errors.New("return request not found")
+	}
+	utils.ThrowOnError(err)
+
+	if status != ReturnRequestStatusApproved {
+		return ReceiveReturnRequestUsecaseOutput{}, errors.New("return request is not approved")
+	}
+
+	if input.Restock {
+		var restockedQuantity int32
+
+		utils.ThrowOnError(tx.QueryRow(context.Background(),
+			"SELECT COALESCE(SUM(quantity), 0)::INT FROM return_requests WHERE order_item_id = $1 AND restocked",
+			orderItemId).Scan(&restockedQuantity))
+
+		err := restockReturnedUnits(tx, orderItemId, orderItemQuantity, quantity, restockedQuantity, &input.ActorId,
+			input.ReturnRequestId)
+		if err != nil {
+			return ReceiveReturnRequestUsecaseOutput{}, err
+		}
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		"UPDATE return_requests SET status = $1, restocked = $2, received_by = $3, received_at = $4 WHERE id = $5",
+		ReturnRequestStatusReceived, input.Restock, input.ActorId, time.Now().UTC(), input.ReturnRequestId))
+
+	amount := returnedLineAmount(tx, orderId, orderItemId, price, orderItemQuantity, quantity)
+
+	refundedAmount := refundOrderPayment(tx, orderId, amount, currency, "return", &input.ActorId)
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE return_requests SET refunded_amount = $1 WHERE id = $2",
+		refundedAmount, input.ReturnRequestId))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return ReceiveReturnRequestUsecaseOutput{
+		RefundedAmount: refundedAmount,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+const RefundStatusPending = "pending"
+
+// refundOrderPayment records a pending refund of up to amount of what is left of the order payment, which
+// SendPendingRefundsUsecase sends to the payment gateway once the transaction commits. The payment row is locked
+// so concurrent refunds cannot exceed what was charged. It returns the amount refunded, which is zero when the
+// order has no payment or it was already refunded in full.
+func refundOrderPayment(tx pgx.Tx, orderId uuid.UUID, amount int64, currency string, reason string, actorId *uuid.UUID) int64 {
+	var paymentId uuid.UUID
+	var totalPrice, refundedAmount int64
+
+	err := tx.QueryRow(context.Background(),
+		`SELECT p.id, o.total_price, p.refunded_amount
+		FROM payments p JOIN orders o ON o.id = p.order_id
+		WHERE p.order_id = $1 FOR UPDATE OF p`, orderId).Scan(&paymentId, &totalPrice, &refundedAmount)
+
+	if err == pgx.ErrNoRows {
+		return 0
+	}
+	utils.ThrowOnError(err)
+
+	amount = min(amount, totalPrice-refundedAmount)
+
+	if amount <= 0 {
+		return 0
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO refunds (id, payment_id, amount, currency, reason, status, actor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		uuid.New(), paymentId, amount, currency, reason, RefundStatusPending, actorId, time.Now().UTC()))
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE payments SET refunded_amount = refunded_amount + $1 WHERE id = $2",
+		amount, paymentId))
+
+	return amount
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type outdatedProductSlug struct {
+	ProductId uuid.UUID
+	Name      string
+}
+
+type RegenerateProductSlugsUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewRegenerateProductSlugsUsecase(pgxPool *pgxpool.Pool) RegenerateProductSlugsUsecase {
+	return RegenerateProductSlugsUsecase{pgxPool}
+}
+
+// Execute regenerates the slugs flagged as outdated from the product names, the same way new slugs are made.
+func (r *RegenerateProductSlugsUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(r.pgxPool, r.claim, func(outdatedProductSlug) error { return nil },
+		func(tx pgx.Tx, product outdatedProductSlug) {
+			assignProductSlug(tx, product.ProductId, product.Name)
+
+			_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE products SET slug_outdated = FALSE WHERE id = $1", product.ProductId))
+		})
+}
+
+func (r *RegenerateProductSlugsUsecase) claim(tx pgx.Tx, limit int) []outdatedProductSlug {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		"SELECT id, name FROM products WHERE slug_outdated ORDER BY created_at, id LIMIT $1 FOR UPDATE SKIP LOCKED", limit))
+
+	products := []outdatedProductSlug{}
+	for rows.Next() {
+		var item outdatedProductSlug
+
+		utils.ThrowOnError(rows.Scan(&item.ProductId, &item.Name))
+		products = append(products, item)
+	}
+
+	return products
+}
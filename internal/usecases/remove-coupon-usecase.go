@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RemoveCouponUsecaseInput struct {
+	CustomerId uuid.UUID
+}
+
+type RemoveCouponUsecase struct {
+	pgxPool *pgxpool.Pool
+	cartDAO daos.CartDAO
+}
+
+func NewRemoveCouponUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO) RemoveCouponUsecase {
+	return RemoveCouponUsecase{pgxPool, cartDAO}
+}
+
+func (r *RemoveCouponUsecase) Execute(input RemoveCouponUsecaseInput) error {
+	cartSchema := r.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	if cartSchema.PromotionId == nil {
+		return errors.New("cart has no coupon")
+	}
+
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE carts SET promotion_id = NULL WHERE id = $1", cartSchema.Id))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -33,7 +33,16 @@ func (r *RemoveProductFromCartUsecase) Execute(input RemoveProductFromCartUsecas
 		return errors.New("product not found in cart")
 	}
 
-	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(), "DELETE FROM cart_items WHERE product_id = $1", input.ProductId))
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE product_id = $1", input.ProductId))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
 
 	return nil
 }
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RemoveProductFromGuestCartUsecaseInput struct {
+	GuestCartId uuid.UUID
+	ProductId   uuid.UUID
+}
+
+type RemoveProductFromGuestCartUsecase struct {
+	pgxPool          *pgxpool.Pool
+	guestCartDAO     daos.GuestCartDAO
+	guestCartItemDAO daos.GuestCartItemDAO
+}
+
+func NewRemoveProductFromGuestCartUsecase(pgxPool *pgxpool.Pool, guestCartDAO daos.GuestCartDAO,
+	guestCartItemDAO daos.GuestCartItemDAO) RemoveProductFromGuestCartUsecase {
+	return RemoveProductFromGuestCartUsecase{pgxPool, guestCartDAO, guestCartItemDAO}
+}
+
+func (r *RemoveProductFromGuestCartUsecase) Execute(input RemoveProductFromGuestCartUsecaseInput) error {
+	guestCartSchema := findGuestCart(r.guestCartDAO, input.GuestCartId)
+
+	if guestCartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	guestCartItemSchema := r.guestCartItemDAO.FindOneByGuestCartIdAndProductId(guestCartSchema.Id, input.ProductId)
+
+	if guestCartItemSchema == nil {
+		return errors.New("product not found in cart")
+	}
+
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM guest_cart_items WHERE id = $1", guestCartItemSchema.Id))
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE guest_carts SET expires_at = $1 WHERE id = $2",
+		time.Now().UTC().Add(GuestCartTTL), guestCartSchema.Id))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RemoveProductFromWishlistUsecaseInput struct {
+	CustomerId uuid.UUID
+	WishlistId uuid.UUID
+	ProductId  uuid.UUID
+}
+
+type RemoveProductFromWishlistUsecase struct {
+	pgxPool         *pgxpool.Pool
+	wishlistDAO     daos.WishlistDAO
+	wishlistItemDAO daos.WishlistItemDAO
+}
+
+func NewRemoveProductFromWishlistUsecase(pgxPool *pgxpool.Pool, wishlistDAO daos.WishlistDAO,
+	wishlistItemDAO daos.WishlistItemDAO) RemoveProductFromWishlistUsecase {
+	return RemoveProductFromWishlistUsecase{pgxPool, wishlistDAO, wishlistItemDAO}
+}
+
+func (r *RemoveProductFromWishlistUsecase) Execute(input RemoveProductFromWishlistUsecaseInput) error {
+	wishlistSchema := r.wishlistDAO.FindOneById(input.WishlistId)
+
+	if wishlistSchema == nil || wishlistSchema.CustomerId != input.CustomerId {
+		return errors.New("wishlist not found")
+	}
+
+	wishlistItemSchema := r.wishlistItemDAO.FindOneByWishlistIdAndProductId(input.WishlistId, input.ProductId)
+
+	if wishlistItemSchema == nil {
+		return errors.New("product not found in wishlist")
+	}
+
+	_ = utils.GetOrThrow(r.pgxPool.Exec(context.Background(), "DELETE FROM wishlist_items WHERE id = $1", wishlistItemSchema.Id))
+
+	return nil
+}
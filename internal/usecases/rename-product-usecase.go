@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RenameProductUsecaseInput struct {
+	ProductId uuid.UUID
+	Name      string
+}
+
+type RenameProductUsecaseOutput struct {
+	Slug string
+}
+
+type RenameProductUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewRenameProductUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) RenameProductUsecase {
+	return RenameProductUsecase{pgxPool, productDAO}
+}
+
+func (r *RenameProductUsecase) Execute(input RenameProductUsecaseInput) (RenameProductUsecaseOutput, error) {
+	name := strings.TrimSpace(input.Name)
+
+	if name == "" {
+		return RenameProductUsecaseOutput{}, errors.New("the product name cannot be empty")
+	}
+
+	if len(name) > 50 {
+		return RenameProductUsecaseOutput{}, errors.New("the product name cannot exceed 50 characters")
+	}
+
+	productExists := r.productDAO.ExistsById(input.ProductId)
+
+	if !productExists {
+		return RenameProductUsecaseOutput{}, errors.New("product not found")
+	}
+
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE products SET name = $1 WHERE id = $2", name, input.ProductId))
+
+	slug := assignProductSlug(tx, input.ProductId, name)
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return RenameProductUsecaseOutput{
+		Slug: slug,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RequestReturnUsecaseInput struct {
+	CustomerId  uuid.UUID
+	OrderItemId uuid.UUID
+	Quantity    int32
+	ReasonCode  string
+	Comment     *string
+}
+
+type RequestReturnUsecaseOutput struct {
+	ReturnRequestId uuid.UUID
+}
+
+type RequestReturnUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewRequestReturnUsecase(pgxPool *pgxpool.Pool) RequestReturnUsecase {
+	return RequestReturnUsecase{pgxPool}
+}
+
+// Execute asks to return units of an order item. The order must be fulfilled and still within the return window
+// of the product, and units already asked to be returned, unless rejected, cannot be asked for again.
+func (r *RequestReturnUsecase) Execute(input RequestReturnUsecaseInput) (RequestReturnUsecaseOutput, error) {
+	if input.Quantity <= 0 {
+		return RequestReturnUsecaseOutput{}, errors.New("quantity must be higher than zero")
+	}
+
+	if !slices.Contains(returnReasonCodes, input.ReasonCode) {
+		return RequestReturnUsecaseOutput{}, errors.New("reason code is not valid")
+	}
+
+	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var customerId uuid.UUID
+	var parentOrderItemId *uuid.UUID
+	var status string
+	var fulfilledAt *time.Time
+	var quantity, backorderedQuantity, returnWindowDays, requestedQuantity int32
+
+	// The order item is locked so concurrent requests cannot return more units than were shipped.
+	err := tx.QueryRow(context.Background(),
+		`SELECT o.customer_id, o.status, o.fulfilled_at, oi.quantity, oi.backordered_quantity, oi.parent_order_item_id, p.return_window_days
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		JOIN products p ON p.id = oi.product_id
+		WHERE oi.id = $1 FOR UPDATE OF oi`, input.OrderItemId).
+		Scan(&customerId, &status, &fulfilledAt, &quantity, &backorderedQuantity, &parentOrderItemId, &returnWindowDays)
+	if err == pgx.ErrNoRows {
+		return RequestReturnUsecaseOutput{}, errors.New("order item not found")
+	}
+	utils.ThrowOnError(err)
+
+	if customerId != input.CustomerId {
+		return RequestReturnUsecaseOutput{}, errors.New("order item not found")
+	}
+
+	// Bundle components are returned with their bundle.
+	if parentOrderItemId != nil || returnWindowDays == 0 {
+		return RequestReturnUsecaseOutput{}, errors.New("order item is not returnable")
+	}
+
+	if status != OrderStatusFulfilled {
+		return RequestReturnUsecaseOutput{}, errors.New("order has not been fulfilled")
+	}
+
+	if time.Now().UTC().After(fulfilledAt.AddDate(0, 0, int(returnWindowDays))) {
+		return RequestReturnUsecaseOutput{}, errors.New("return window has expired")
+	}
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		"SELECT COALESCE(SUM(quantity), 0)::INT FROM return_requests WHERE order_item_id = $1 AND status <> $2",
+		input.OrderItemId, ReturnRequestStatusRejected).Scan(&requestedQuantity))
+
+	if requestedQuantity+input.Quantity > quantity-backorderedQuantity {
+		return RequestReturnUsecaseOutput{}, errors.New("return quantity exceeds the quantity shipped")
+	}
+
+	returnRequestId := uuid.New()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO return_requests (id, order_item_id, customer_id, quantity, reason_code, comment, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		returnRequestId, input.OrderItemId, input.CustomerId, input.Quantity, input.ReasonCode, input.Comment,
+		ReturnRequestStatusRequested, time.Now().UTC()))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return RequestReturnUsecaseOutput{
+		ReturnRequestId: returnRequestId,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	ReturnRequestStatusRequested = "requested"
+	ReturnRequestStatusApproved  = "approved"
+	ReturnRequestStatusRejected  = "rejected"
+	ReturnRequestStatusReceived  = "received"
+)
+
+var returnReasonCodes = []string{"damaged", "defective", "wrong_item", "not_as_described", "no_longer_needed", "other"}
+
+// returnedLineAmount returns what the customer paid for quantity units of an order item: their price net of their
+// share of the order discounts, plus their share of the tax charged on the item. Discounts are shared by price
+// across the order lines. Shipping is not refunded for returns.
+func returnedLineAmount(tx pgx.Tx, orderId uuid.UUID, orderItemId uuid.UUID, price int64, orderItemQuantity int32,
+	quantity int32) int64 {
+	var subtotal, discount, tax int64
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		`SELECT
+			(SELECT COALESCE(SUM(price * quantity), 0)::BIGINT FROM order_items WHERE order_id = $1 AND parent_order_item_id IS NULL),
+			(SELECT COALESCE(SUM(amount), 0)::BIGINT FROM order_discounts WHERE order_id = $1),
+			(SELECT COALESCE(SUM(amount), 0)::BIGINT FROM order_item_taxes WHERE order_item_id = $2)`,
+		orderId, orderItemId).Scan(&subtotal, &discount, &tax))
+
+	amount := price * int64(quantity)
+
+	if subtotal > 0 {
+		amount -= discount * amount / subtotal
+	}
+
+	return amount + tax*int64(quantity)/int64(orderItemQuantity)
+}
+
+// restockReturnedUnits puts quantity returned units of an order item back into the inventories they shipped from,
+// along with the components of a bundle. restockedQuantity is how many units of the item earlier returns already
+// put back, so their allocations are skipped. Returned stock goes to backorders waiting for it first.
+func restockReturnedUnits(tx pgx.Tx, orderItemId uuid.UUID, orderItemQuantity int32, quantity int32, restockedQuantity int32,
+	actorId *uuid.UUID, returnRequestId uuid.UUID) error {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		"SELECT id, quantity FROM order_items WHERE id = $1 OR parent_order_item_id = $1 ORDER BY created_at, id", orderItemId))
+
+	type schema struct {
+		OrderItemId uuid.UUID
+		Quantity    int32
+	}
+
+	orderItems := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.OrderItemId, &item.Quantity))
+		orderItems = append(orderItems, item)
+	}
+
+	for _, orderItem := range orderItems {
+		// A bundle component ships a fixed number of units per bundle.
+		skip := orderItem.Quantity * restockedQuantity / orderItemQuantity
+		remaining := orderItem.Quantity * quantity / orderItemQuantity
+
+		rows := utils.GetOrThrow(tx.Query(context.Background(),
+			"SELECT inventory_id, quantity FROM order_item_allocations WHERE order_item_id = $1 ORDER BY created_at, id",
+			orderItem.OrderItemId))
+
+		type allocationSchema struct {
+			InventoryId uuid.UUID
+			Quantity    int32
+		}
+
+		allocations := []allocationSchema{}
+		for rows.Next() {
+			var allocation allocationSchema
+
+			utils.ThrowOnError(rows.Scan(&allocation.InventoryId, &allocation.Quantity))
+			allocations = append(allocations, allocation)
+		}
+
+		for _, allocation := range allocations {
+			if remaining == 0 {
+				break
+			}
+
+			skipped := min(skip, allocation.Quantity)
+			skip -= skipped
+
+			restocked := min(remaining, allocation.Quantity-skipped)
+			if restocked == 0 {
+				continue
+			}
+			remaining -= restocked
+
+			_, err := moveStock(tx, inventoryMovement{
+				InventoryId: allocation.InventoryId,
+				Reason:      "return",
+				Quantity:    restocked,
+				ActorId:     actorId,
+				ReferenceId: &returnRequestId,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := fillBackorders(tx, allocation.InventoryId, actorId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
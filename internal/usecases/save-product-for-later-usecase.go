@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SaveProductForLaterUsecaseInput struct {
+	CustomerId uuid.UUID
+	ProductId  uuid.UUID
+}
+
+type SaveProductForLaterUsecase struct {
+	pgxPool     *pgxpool.Pool
+	cartDAO     daos.CartDAO
+	cartItemDAO daos.CartItemDAO
+}
+
+func NewSaveProductForLaterUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO, cartItemDAO daos.CartItemDAO) SaveProductForLaterUsecase {
+	return SaveProductForLaterUsecase{pgxPool, cartDAO, cartItemDAO}
+}
+
+// Execute moves a cart item to the saved for later list with its quantity and the price the customer saw
+// when adding it. Saving a product already on the list adds to its quantity.
+func (s *SaveProductForLaterUsecase) Execute(input SaveProductForLaterUsecaseInput) error {
+	cartSchema := s.cartDAO.FindOneByCustomerId(input.CustomerId)
+	cartItemSchema := s.cartItemDAO.FindOneByCartIdAndProductId(cartSchema.Id, input.ProductId)
+
+	if cartItemSchema == nil {
+		return errors.New("product not found in cart")
+	}
+
+	tx := utils.GetOrThrow(s.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	wishlistId := savedForLaterWishlist(tx, input.CustomerId)
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO wishlist_items (id, wishlist_id, product_id, quantity, price_at_add, currency, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (wishlist_id, product_id) DO UPDATE SET quantity = wishlist_items.quantity + EXCLUDED.quantity,
+		price_at_add = EXCLUDED.price_at_add, currency = EXCLUDED.currency`,
+		uuid.New(), wishlistId, input.ProductId, cartItemSchema.Quantity, cartItemSchema.PriceAtAdd, cartSchema.Currency,
+		time.Now().UTC()))
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE id = $1", cartItemSchema.Id))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+type SelectGuestShippingMethodUsecaseInput struct {
+	GuestCartId      uuid.UUID
+	ShippingMethodId uuid.UUID
+}
+
+type SelectGuestShippingMethodUsecase struct {
+	guestCartDAO                daos.GuestCartDAO
+	selectShippingMethodUsecase SelectShippingMethodUsecase
+}
+
+func NewSelectGuestShippingMethodUsecase(guestCartDAO daos.GuestCartDAO,
+	selectShippingMethodUsecase SelectShippingMethodUsecase) SelectGuestShippingMethodUsecase {
+	return SelectGuestShippingMethodUsecase{guestCartDAO, selectShippingMethodUsecase}
+}
+
+// Execute picks how a checked out guest cart ships, on the cart of its guest customer.
+func (s *SelectGuestShippingMethodUsecase) Execute(input SelectGuestShippingMethodUsecaseInput) error {
+	guestCartSchema, err := findCheckedOutGuestCart(s.guestCartDAO, input.GuestCartId)
+	if err != nil {
+		return err
+	}
+
+	return s.selectShippingMethodUsecase.Execute(SelectShippingMethodUsecaseInput{
+		CustomerId:       *guestCartSchema.CustomerId,
+		ShippingMethodId: input.ShippingMethodId,
+	})
+}
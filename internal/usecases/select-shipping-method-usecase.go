@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SelectShippingMethodUsecaseInput struct {
+	CustomerId       uuid.UUID
+	ShippingMethodId uuid.UUID
+}
+
+type SelectShippingMethodUsecase struct {
+	pgxPool           *pgxpool.Pool
+	cartDAO           daos.CartDAO
+	shippingMethodDAO daos.ShippingMethodDAO
+}
+
+func NewSelectShippingMethodUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO,
+	shippingMethodDAO daos.ShippingMethodDAO) SelectShippingMethodUsecase {
+	return SelectShippingMethodUsecase{pgxPool, cartDAO, shippingMethodDAO}
+}
+
+// Execute picks how the cart ships. Whether the method ships to the address is only known once the address is,
+// so it is checked at checkout.
+func (s *SelectShippingMethodUsecase) Execute(input SelectShippingMethodUsecaseInput) error {
+	cartSchema := s.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	shippingMethodSchema := s.shippingMethodDAO.FindOneById(input.ShippingMethodId)
+
+	if shippingMethodSchema == nil || !shippingMethodSchema.IsActive {
+		return errors.New("shipping method not found")
+	}
+
+	if shippingMethodSchema.Currency != cartSchema.Currency {
+		return errors.New("shipping method does not apply to the cart currency")
+	}
+
+	tx := utils.GetOrThrow(s.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE carts SET shipping_method_id = $1 WHERE id = $2",
+		shippingMethodSchema.Id, cartSchema.Id))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pendingRefund struct {
+	Id            uuid.UUID
+	PaymentId     uuid.UUID
+	TransactionId string
+	Amount        utils.Money
+	PaymentRefund gateways.PaymentRefund
+}
+
+type SendPendingRefundsUsecase struct {
+	pgxPool        *pgxpool.Pool
+	paymentGateway gateways.PaymentGateway
+}
+
+func NewSendPendingRefundsUsecase(pgxPool *pgxpool.Pool, paymentGateway gateways.PaymentGateway) SendPendingRefundsUsecase {
+	return SendPendingRefundsUsecase{pgxPool, paymentGateway}
+}
+
+// Execute sends the pending refunds to the payment gateway. A refund the gateway turns down gives its amount back
+// to the payment, so it can be refunded again.
+func (s *SendPendingRefundsUsecase) Execute() (OutboxDispatchOutput, error) {
+	return dispatchOutbox(s.pgxPool, s.claim, s.send, func(tx pgx.Tx, refund *pendingRefund) {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE refunds SET payment_gateway_refund_id = $1, status = $2, sent_at = $3 WHERE id = $4",
+			refund.PaymentRefund.RefundId, refund.PaymentRefund.Status, time.Now().UTC(), refund.Id))
+
+		if slices.Contains([]string{"rejected", "cancelled"}, refund.PaymentRefund.Status) {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE payments SET refunded_amount = refunded_amount - $1 WHERE id = $2",
+				refund.Amount.Amount, refund.PaymentId))
+		}
+	})
+}
+
+func (s *SendPendingRefundsUsecase) claim(tx pgx.Tx, limit int) []*pendingRefund {
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		`
+			SELECT r.id, r.payment_id, p.payment_gateway_transaction_id, r.amount, r.currency
+			FROM refunds r
+			JOIN payments p
+				ON p.id = r.payment_id
+			WHERE r.sent_at IS NULL
+			ORDER BY r.created_at, r.id
+			LIMIT $1
+			FOR UPDATE OF r SKIP LOCKED
+		`, limit))
+
+	refunds := []*pendingRefund{}
+	for rows.Next() {
+		var item pendingRefund
+
+		utils.ThrowOnError(rows.Scan(&item.Id, &item.PaymentId, &item.TransactionId, &item.Amount.Amount, &item.Amount.Currency))
+		refunds = append(refunds, &item)
+	}
+
+	return refunds
+}
+
+func (s *SendPendingRefundsUsecase) send(refund *pendingRefund) error {
+	paymentRefund, err := s.paymentGateway.Refund(refund.Id, refund.TransactionId, refund.Amount)
+	if err != nil {
+		return err
+	}
+
+	refund.PaymentRefund = paymentRefund
+	return nil
+}
@@ -0,0 +1,82 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetCartCurrencyUsecaseInput struct {
+	CustomerId uuid.UUID
+	Currency   string
+}
+
+type SetCartCurrencyUsecase struct {
+	pgxPool *pgxpool.Pool
+	cartDAO daos.CartDAO
+}
+
+func NewSetCartCurrencyUsecase(pgxPool *pgxpool.Pool, cartDAO daos.CartDAO) SetCartCurrencyUsecase {
+	return SetCartCurrencyUsecase{pgxPool, cartDAO}
+}
+
+func (s *SetCartCurrencyUsecase) Execute(input SetCartCurrencyUsecaseInput) error {
+	if !utils.IsSupportedCurrency(input.Currency) {
+		return errors.New("currency is not supported")
+	}
+
+	cartSchema := s.cartDAO.FindOneByCustomerId(input.CustomerId)
+
+	if cartSchema == nil {
+		return errors.New("cart not found")
+	}
+
+	var unpricedItems int64
+
+	utils.ThrowOnError(s.pgxPool.QueryRow(context.Background(),
+		`
+			SELECT COUNT(*)
+			FROM cart_items ci
+			JOIN products p
+				ON p.id = ci.product_id
+			LEFT JOIN product_prices pp
+				ON pp.product_id = p.id AND pp.currency = $2
+			WHERE ci.cart_id = $1 AND pp.price IS NULL AND p.currency <> $2
+		`, cartSchema.Id, input.Currency).Scan(&unpricedItems))
+
+	if unpricedItems > 0 {
+		return errors.New("some products in the cart are not priced in this currency")
+	}
+
+	tx := utils.GetOrThrow(s.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	// Shipping methods only quote in their own currency, so the one picked has to be picked again.
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE carts SET currency = $1, shipping_method_id = NULL WHERE id = $2",
+		input.Currency, cartSchema.Id))
+
+	// The prices the customer saw were in the previous currency, so the new prices become the ones to compare with.
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`
+			UPDATE cart_items ci SET price_at_add = (
+				SELECT COALESCE(pp.price, CASE WHEN p.currency = $1 THEN p.price END)
+				FROM products p
+				LEFT JOIN product_prices pp
+					ON pp.product_id = p.id AND pp.currency = $1
+				WHERE p.id = ci.product_id
+			)
+			WHERE ci.cart_id = $2
+		`, input.Currency, cartSchema.Id))
+
+	touchCart(tx, cartSchema.Id)
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetCartReminderPreferenceUsecaseInput struct {
+	CustomerId uuid.UUID
+	OptedOut   bool
+}
+
+type SetCartReminderPreferenceUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewSetCartReminderPreferenceUsecase(pgxPool *pgxpool.Pool) SetCartReminderPreferenceUsecase {
+	return SetCartReminderPreferenceUsecase{pgxPool}
+}
+
+// Execute opts the customer out of or back into reminders about their idle cart.
+func (s *SetCartReminderPreferenceUsecase) Execute(input SetCartReminderPreferenceUsecaseInput) error {
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE customers SET cart_reminders_opted_out = $1 WHERE id = $2",
+		input.OptedOut, input.CustomerId))
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetCustomerTaxExemptionUsecaseInput struct {
+	CustomerId uuid.UUID
+	TaxExempt  bool
+}
+
+type SetCustomerTaxExemptionUsecase struct {
+	pgxPool     *pgxpool.Pool
+	customerDAO daos.CustomerDAO
+}
+
+func NewSetCustomerTaxExemptionUsecase(pgxPool *pgxpool.Pool, customerDAO daos.CustomerDAO) SetCustomerTaxExemptionUsecase {
+	return SetCustomerTaxExemptionUsecase{pgxPool, customerDAO}
+}
+
+// Execute marks a customer as exempt from sales tax, such as a charity or a reseller, once their exemption
+// certificate was checked. Orders already placed keep the tax they were charged.
+func (s *SetCustomerTaxExemptionUsecase) Execute(input SetCustomerTaxExemptionUsecaseInput) error {
+	if s.customerDAO.FindOneById(input.CustomerId) == nil {
+		return errors.New("customer not found")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE customers SET tax_exempt = $1 WHERE id = $2",
+		input.TaxExempt, input.CustomerId))
+
+	return nil
+}
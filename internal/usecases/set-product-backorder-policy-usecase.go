@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductBackorderPolicyUsecaseInput struct {
+	ProductId        uuid.UUID
+	AllowsBackorder  bool
+	AllowsPreorder   bool
+	BackorderLimit   int32
+	ExpectedShipDate *time.Time
+}
+
+type SetProductBackorderPolicyUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductBackorderPolicyUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductBackorderPolicyUsecase {
+	return SetProductBackorderPolicyUsecase{pgxPool, productDAO}
+}
+
+// Execute sets whether a product can be sold beyond its stock. Backorders are for products temporarily out
+// of stock and pre-orders for products not released yet, so a pre-order always has an expected ship date.
+// Disabling both stops new backorders, but the ones already placed are still filled as stock arrives.
+func (s *SetProductBackorderPolicyUsecase) Execute(input SetProductBackorderPolicyUsecaseInput) error {
+	if input.AllowsBackorder && input.AllowsPreorder {
+		return errors.New("product cannot allow both backorders and pre-orders")
+	}
+
+	if input.BackorderLimit < 0 {
+		return errors.New("backorder limit cannot be negative")
+	}
+
+	if (input.AllowsBackorder || input.AllowsPreorder) && input.BackorderLimit == 0 {
+		return errors.New("backorder limit must be higher than zero")
+	}
+
+	if input.AllowsPreorder && input.ExpectedShipDate == nil {
+		return errors.New("pre-orders require an expected ship date")
+	}
+
+	productSchema := s.productDAO.FindOneById(input.ProductId)
+
+	if productSchema == nil {
+		return errors.New("product not found")
+	}
+
+	if productSchema.IsBundle && (input.AllowsBackorder || input.AllowsPreorder) {
+		return errors.New("bundles cannot be sold beyond their component stock")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`UPDATE products SET allows_backorder = $1, allows_preorder = $2, backorder_limit = $3, expected_ship_date = $4
+		WHERE id = $5`,
+		input.AllowsBackorder, input.AllowsPreorder, input.BackorderLimit, input.ExpectedShipDate, input.ProductId))
+
+	return nil
+}
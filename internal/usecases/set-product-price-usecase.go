@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductPriceUsecaseInput struct {
+	ProductId uuid.UUID
+	Currency  string
+	Price     int64
+}
+
+type SetProductPriceUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductPriceUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductPriceUsecase {
+	return SetProductPriceUsecase{pgxPool, productDAO}
+}
+
+func (s *SetProductPriceUsecase) Execute(input SetProductPriceUsecaseInput) error {
+	if _, err := utils.NewMoney(input.Price, input.Currency); err != nil {
+		return err
+	}
+
+	if input.Price == 0 {
+		return errors.New("the product price cannot be zero")
+	}
+
+	if input.Price < 0 {
+		return errors.New("the product price cannot be negative")
+	}
+
+	productSchema := s.productDAO.FindOneById(input.ProductId)
+
+	if productSchema == nil {
+		return errors.New("product not found")
+	}
+
+	if productSchema.Currency == input.Currency {
+		_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE products SET price = $1 WHERE id = $2", input.Price, input.ProductId))
+
+		return nil
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`
+			INSERT INTO product_prices (product_id, currency, price, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (product_id, currency) DO UPDATE SET price = EXCLUDED.price, updated_at = EXCLUDED.updated_at
+		`, input.ProductId, input.Currency, input.Price, time.Now().UTC()))
+
+	return nil
+}
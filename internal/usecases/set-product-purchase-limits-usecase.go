@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductPurchaseLimitsUsecaseInput struct {
+	ProductId      uuid.UUID
+	MaxPerOrder    *int32
+	MaxPerCustomer *int32
+	PeriodDays     *int32
+}
+
+type SetProductPurchaseLimitsUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductPurchaseLimitsUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductPurchaseLimitsUsecase {
+	return SetProductPurchaseLimitsUsecase{pgxPool, productDAO}
+}
+
+// Execute sets how many units of a product can be bought per order and per customer within a period of days.
+// A limit left out is removed. Lowering a limit does not touch carts already over it, they are stopped at checkout.
+func (s *SetProductPurchaseLimitsUsecase) Execute(input SetProductPurchaseLimitsUsecaseInput) error {
+	if input.MaxPerOrder != nil && *input.MaxPerOrder <= 0 {
+		return errors.New("maximum per order must be higher than zero")
+	}
+
+	if input.MaxPerCustomer != nil && *input.MaxPerCustomer <= 0 {
+		return errors.New("maximum per customer must be higher than zero")
+	}
+
+	if (input.MaxPerCustomer == nil) != (input.PeriodDays == nil) {
+		return errors.New("maximum per customer and period days must be set together")
+	}
+
+	if input.PeriodDays != nil && *input.PeriodDays <= 0 {
+		return errors.New("period days must be higher than zero")
+	}
+
+	if !s.productDAO.ExistsById(input.ProductId) {
+		return errors.New("product not found")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`UPDATE products SET max_per_order = $1, max_per_customer = $2, max_per_customer_period_days = $3 WHERE id = $4`,
+		input.MaxPerOrder, input.MaxPerCustomer, input.PeriodDays, input.ProductId))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductReturnWindowUsecaseInput struct {
+	ProductId        uuid.UUID
+	ReturnWindowDays int32
+}
+
+type SetProductReturnWindowUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductReturnWindowUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductReturnWindowUsecase {
+	return SetProductReturnWindowUsecase{pgxPool, productDAO}
+}
+
+// Execute sets how many days after fulfilment a product can be returned. Zero stops it from being returned.
+// Orders already fulfilled follow the new window.
+func (s *SetProductReturnWindowUsecase) Execute(input SetProductReturnWindowUsecaseInput) error {
+	if input.ReturnWindowDays < 0 {
+		return errors.New("return window days cannot be negative")
+	}
+
+	if !s.productDAO.ExistsById(input.ProductId) {
+		return errors.New("product not found")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE products SET return_window_days = $1 WHERE id = $2",
+		input.ReturnWindowDays, input.ProductId))
+
+	return nil
+}
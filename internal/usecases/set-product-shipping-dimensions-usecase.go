@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductShippingDimensionsUsecaseInput struct {
+	ProductId   uuid.UUID
+	WeightGrams int32
+	LengthCm    *int32
+	WidthCm     *int32
+	HeightCm    *int32
+}
+
+type SetProductShippingDimensionsUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductShippingDimensionsUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductShippingDimensionsUsecase {
+	return SetProductShippingDimensionsUsecase{pgxPool, productDAO}
+}
+
+// Execute sets what a product weighs and, optionally, the size of its package. Dimensions left out are removed,
+// and the product then ships at its weight alone.
+func (s *SetProductShippingDimensionsUsecase) Execute(input SetProductShippingDimensionsUsecaseInput) error {
+	if input.WeightGrams < 0 {
+		return errors.New("weight cannot be negative")
+	}
+
+	dimensions := []*int32{input.LengthCm, input.WidthCm, input.HeightCm}
+	setDimensions := 0
+
+	for _, dimension := range dimensions {
+		if dimension == nil {
+			continue
+		}
+
+		if *dimension <= 0 {
+			return errors.New("dimensions must be higher than zero")
+		}
+
+		setDimensions++
+	}
+
+	if setDimensions != 0 && setDimensions != len(dimensions) {
+		return errors.New("length, width and height must be set together")
+	}
+
+	if !s.productDAO.ExistsById(input.ProductId) {
+		return errors.New("product not found")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		"UPDATE products SET weight_grams = $1, length_cm = $2, width_cm = $3, height_cm = $4 WHERE id = $5",
+		input.WeightGrams, input.LengthCm, input.WidthCm, input.HeightCm, input.ProductId))
+
+	return nil
+}
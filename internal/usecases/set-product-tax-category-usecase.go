@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetProductTaxCategoryUsecaseInput struct {
+	ProductId   uuid.UUID
+	TaxCategory string
+}
+
+type SetProductTaxCategoryUsecase struct {
+	pgxPool    *pgxpool.Pool
+	productDAO daos.ProductDAO
+}
+
+func NewSetProductTaxCategoryUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO) SetProductTaxCategoryUsecase {
+	return SetProductTaxCategoryUsecase{pgxPool, productDAO}
+}
+
+func (s *SetProductTaxCategoryUsecase) Execute(input SetProductTaxCategoryUsecaseInput) error {
+	taxCategory, err := parseTaxCategory(input.TaxCategory)
+	if err != nil {
+		return err
+	}
+
+	if !s.productDAO.ExistsById(input.ProductId) {
+		return errors.New("product not found")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE products SET tax_category = $1 WHERE id = $2",
+		taxCategory, input.ProductId))
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetReorderPointUsecaseInput struct {
+	Inventory    InventoryLookup
+	ReorderPoint int32
+}
+
+type SetReorderPointUsecase struct {
+	pgxPool      *pgxpool.Pool
+	inventoryDAO daos.InventoryDAO
+	productDAO   daos.ProductDAO
+}
+
+func NewSetReorderPointUsecase(pgxPool *pgxpool.Pool, inventoryDAO daos.InventoryDAO, productDAO daos.ProductDAO) SetReorderPointUsecase {
+	return SetReorderPointUsecase{pgxPool, inventoryDAO, productDAO}
+}
+
+// Execute sets the stock level at or below which the inventory is considered low. Zero disables the alerts.
+func (s *SetReorderPointUsecase) Execute(input SetReorderPointUsecaseInput) error {
+	if input.ReorderPoint < 0 {
+		return errors.New("reorder point cannot be negative")
+	}
+
+	inventoryId, err := findInventoryId(s.inventoryDAO, s.productDAO, input.Inventory)
+	if err != nil {
+		return err
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(), "UPDATE inventories SET reorder_point = $1 WHERE id = $2",
+		input.ReorderPoint, inventoryId))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SetTaxRateUsecaseInput struct {
+	State           string
+	TaxCategory     string
+	RateBasisPoints int32
+}
+
+type SetTaxRateUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewSetTaxRateUsecase(pgxPool *pgxpool.Pool) SetTaxRateUsecase {
+	return SetTaxRateUsecase{pgxPool}
+}
+
+// Execute sets the rate a state taxes a category of products at, in basis points (825 is 8.25%). A zero rate
+// makes the category tax free in the state, while the general category sets the rate of every other category.
+func (s *SetTaxRateUsecase) Execute(input SetTaxRateUsecaseInput) error {
+	state := strings.ToUpper(input.State)
+
+	if !slices.Contains(usStates, state) {
+		return errors.New("state must be a valid 2-letter U.S. abbreviation (e.g. NY, CA)")
+	}
+
+	taxCategory, err := parseTaxCategory(input.TaxCategory)
+	if err != nil {
+		return err
+	}
+
+	if input.RateBasisPoints > 10000 {
+		return errors.New("tax rate cannot be higher than 10000 basis points")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`
+			INSERT INTO tax_rates (id, state, tax_category, rate_basis_points, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (state, tax_category) DO UPDATE SET rate_basis_points = EXCLUDED.rate_basis_points
+		`, uuid.New(), state, taxCategory, input.RateBasisPoints, time.Now().UTC()))
+
+	return nil
+}
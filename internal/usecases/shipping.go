@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+)
+
+const (
+	ShippingMethodTypeFlat              = "flat"
+	ShippingMethodTypeWeightBased       = "weight_based"
+	ShippingMethodTypeFreeOverThreshold = "free_over_threshold"
+	ShippingMethodTypeZone              = "zone"
+)
+
+// volumetricDivisor turns a parcel volume in cubic centimeters into the weight in grams carriers bill it at, so
+// light but bulky products cost what they take up.
+const volumetricDivisor = 5
+
+// ShippingQuote is what a shipping method charges to ship the cart to an address.
+type ShippingQuote struct {
+	ShippingMethodId uuid.UUID
+	Name             string
+	Type             string
+	Price            int64
+}
+
+// quoteShipping returns what the method charges for the lines, or false when it does not ship them to the zip
+// code. pricing is the cart priced without shipping, which decides free-over-threshold methods and free shipping
+// coupons.
+func (p *PricingService) quoteShipping(querier pgxQuerier, shippingMethodSchema daos.ShippingMethodSchema, zipCode string,
+	pricing Pricing, lines []PricingLine) (ShippingQuote, bool) {
+	if !shippingMethodSchema.IsActive || shippingMethodSchema.Currency != pricing.Currency {
+		return ShippingQuote{}, false
+	}
+
+	quote := ShippingQuote{
+		ShippingMethodId: shippingMethodSchema.Id,
+		Name:             shippingMethodSchema.Name,
+		Type:             shippingMethodSchema.Type,
+	}
+
+	switch shippingMethodSchema.Type {
+	case ShippingMethodTypeFlat:
+		quote.Price = *shippingMethodSchema.Rate
+	case ShippingMethodTypeWeightBased:
+		// Every started kilogram is charged in full.
+		kilograms := (billableWeightGrams(querier, lines) + 999) / 1000
+		quote.Price = *shippingMethodSchema.Rate + kilograms**shippingMethodSchema.RatePerKg
+	case ShippingMethodTypeFreeOverThreshold:
+		quote.Price = *shippingMethodSchema.Rate
+
+		if discountedSubtotal(pricing) >= *shippingMethodSchema.FreeThreshold {
+			quote.Price = 0
+		}
+	case ShippingMethodTypeZone:
+		rate, ok := p.zoneRate(shippingMethodSchema.Id, zipCode)
+
+		if !ok {
+			return ShippingQuote{}, false
+		}
+
+		quote.Price = rate
+	}
+
+	if pricing.FreeShipping {
+		quote.Price = 0
+	}
+
+	return quote, true
+}
+
+// zoneRate returns the rate of the longest zip prefix of the method that matches the zip code.
+func (p *PricingService) zoneRate(shippingMethodId uuid.UUID, zipCode string) (int64, bool) {
+	var matched *daos.ShippingZoneRateSchema
+
+	for _, zoneRate := range p.shippingZoneRateDAO.FindAllByShippingMethodId(shippingMethodId) {
+		if !strings.HasPrefix(zipCode, zoneRate.ZipPrefix) {
+			continue
+		}
+
+		if matched == nil || len(zoneRate.ZipPrefix) > len(matched.ZipPrefix) {
+			matched = &zoneRate
+		}
+	}
+
+	if matched == nil {
+		return 0, false
+	}
+
+	return matched.Rate, true
+}
+
+// billableWeightGrams sums what the lines weigh, counting each unit at its volumetric weight when that is higher.
+// A bundle weighs what its components do, since they are what gets shipped.
+func billableWeightGrams(querier pgxQuerier, lines []PricingLine) int64 {
+	quantities := map[uuid.UUID]int32{}
+	productIds := []uuid.UUID{}
+
+	for _, line := range lines {
+		quantities[line.ProductId] += line.Quantity
+		productIds = append(productIds, line.ProductId)
+	}
+
+	rows := utils.GetOrThrow(querier.Query(context.Background(),
+		`
+			SELECT id, is_bundle, weight_grams, length_cm, width_cm, height_cm
+			FROM products
+			WHERE id = ANY($1)
+				OR id IN (SELECT component_product_id FROM bundle_components WHERE bundle_product_id = ANY($1))
+		`, productIds))
+
+	unitWeights := map[uuid.UUID]int64{}
+	bundleIds := []uuid.UUID{}
+
+	for rows.Next() {
+		var productSchema daos.ProductSchema
+
+		utils.ThrowOnError(rows.Scan(&productSchema.Id, &productSchema.IsBundle, &productSchema.WeightGrams, &productSchema.LengthCm,
+			&productSchema.WidthCm, &productSchema.HeightCm))
+
+		if productSchema.IsBundle {
+			bundleIds = append(bundleIds, productSchema.Id)
+			continue
+		}
+
+		unitWeight := int64(productSchema.WeightGrams)
+
+		if productSchema.LengthCm != nil && productSchema.WidthCm != nil && productSchema.HeightCm != nil {
+			volume := int64(*productSchema.LengthCm) * int64(*productSchema.WidthCm) * int64(*productSchema.HeightCm)
+			unitWeight = max(unitWeight, volume/volumetricDivisor)
+		}
+
+		unitWeights[productSchema.Id] = unitWeight
+	}
+
+	rows = utils.GetOrThrow(querier.Query(context.Background(),
+		"SELECT bundle_product_id, component_product_id, quantity FROM bundle_components WHERE bundle_product_id = ANY($1)",
+		bundleIds))
+
+	for rows.Next() {
+		var bundleComponentSchema daos.BundleComponentSchema
+
+		utils.ThrowOnError(rows.Scan(&bundleComponentSchema.BundleProductId, &bundleComponentSchema.ComponentProductId,
+			&bundleComponentSchema.Quantity))
+
+		unitWeights[bundleComponentSchema.BundleProductId] +=
+			unitWeights[bundleComponentSchema.ComponentProductId] * int64(bundleComponentSchema.Quantity)
+	}
+
+	weight := int64(0)
+	for productId, quantity := range quantities {
+		weight += unitWeights[productId] * int64(quantity)
+	}
+
+	return weight
+}
+
+func discountedSubtotal(pricing Pricing) int64 {
+	subtotal := pricing.Subtotal
+
+	for _, discount := range pricing.Discounts {
+		subtotal -= discount.Amount
+	}
+
+	return subtotal
+}
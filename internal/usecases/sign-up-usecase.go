@@ -8,24 +8,30 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/gateways"
 	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type SignUpUsecaseInput struct {
-	Name     string
-	Email    string
-	Password string
+	Name      string
+	Email     string
+	Password  string
+	CartToken *string
 }
 
 type SignUpUsecase struct {
-	pgxPool     *pgxpool.Pool
-	customerDAO daos.CustomerDAO
+	pgxPool           *pgxpool.Pool
+	customerDAO       daos.CustomerDAO
+	productDAO        daos.ProductDAO
+	productPriceDAO   daos.ProductPriceDAO
+	awsSecretsGateway gateways.AwsSecretsGateway
 }
 
-func NewSignUpUsecase(pgxPool *pgxpool.Pool, customerDAO daos.CustomerDAO) SignUpUsecase {
-	return SignUpUsecase{pgxPool, customerDAO}
+func NewSignUpUsecase(pgxPool *pgxpool.Pool, customerDAO daos.CustomerDAO, productDAO daos.ProductDAO, productPriceDAO daos.ProductPriceDAO,
+	awsSecretsGateway gateways.AwsSecretsGateway) SignUpUsecase {
+	return SignUpUsecase{pgxPool, customerDAO, productDAO, productPriceDAO, awsSecretsGateway}
 }
 
 func (r SignUpUsecase) Execute(input SignUpUsecaseInput) error {
@@ -48,6 +54,16 @@ func (r SignUpUsecase) Execute(input SignUpUsecaseInput) error {
 		return errors.New("this email address has already been taken by someone")
 	}
 
+	var guestCartId *uuid.UUID
+	if input.CartToken != nil {
+		id, err := parseGuestCartToken(r.awsSecretsGateway, *input.CartToken)
+		if err != nil {
+			return err
+		}
+
+		guestCartId = &id
+	}
+
 	hashedPassword := utils.GetOrThrow(bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost))
 
 	tx := utils.GetOrThrow(r.pgxPool.Begin(context.Background()))
@@ -61,8 +77,19 @@ func (r SignUpUsecase) Execute(input SignUpUsecaseInput) error {
 	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO customers (id, name, email, password, created_at) VALUES ($1, $2, $3, $4, $5)",
 		customerId, input.Name, input.Email, string(hashedPassword), time.Now().UTC()))
 
-	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO carts (id, customer_id, created_at) VALUES ($1, $2, $3)",
-		uuid.New(), customerId, time.Now().UTC()))
+	cartSchema := daos.CartSchema{
+		Id:         uuid.New(),
+		CustomerId: customerId,
+		Currency:   "USD",
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "INSERT INTO carts (id, customer_id, currency, created_at) VALUES ($1, $2, $3, $4)",
+		cartSchema.Id, cartSchema.CustomerId, cartSchema.Currency, cartSchema.CreatedAt))
+
+	if guestCartId != nil {
+		mergeGuestCart(tx, r.productDAO, r.productPriceDAO, *guestCartId, cartSchema)
+	}
 
 	utils.ThrowOnError(tx.Commit(context.Background()))
 
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StartStockCountUsecaseInput struct {
+	WarehouseId uuid.UUID
+	ActorId     uuid.UUID
+}
+
+type StartStockCountUsecaseOutput struct {
+	StockCountId uuid.UUID
+}
+
+type StartStockCountUsecase struct {
+	pgxPool       *pgxpool.Pool
+	warehouseDAO  daos.WarehouseDAO
+	stockCountDAO daos.StockCountDAO
+}
+
+func NewStartStockCountUsecase(pgxPool *pgxpool.Pool, warehouseDAO daos.WarehouseDAO, stockCountDAO daos.StockCountDAO) StartStockCountUsecase {
+	return StartStockCountUsecase{pgxPool, warehouseDAO, stockCountDAO}
+}
+
+// Execute opens a count for every inventory of the warehouse, snapshotting the stock the system
+// expects so the variance can later be measured against what was physically counted.
+func (s *StartStockCountUsecase) Execute(input StartStockCountUsecaseInput) (StartStockCountUsecaseOutput, error) {
+	if s.warehouseDAO.FindOneById(input.WarehouseId) == nil {
+		return StartStockCountUsecaseOutput{}, errors.New("warehouse not found")
+	}
+
+	if s.stockCountDAO.ExistsInProgressByWarehouseId(input.WarehouseId) {
+		return StartStockCountUsecaseOutput{}, errors.New("a stock count is already in progress for this warehouse")
+	}
+
+	tx := utils.GetOrThrow(s.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	stockCountId := uuid.New()
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		"INSERT INTO stock_counts (id, warehouse_id, status, started_by, created_at) VALUES ($1, $2, $3, $4, $5)",
+		stockCountId, input.WarehouseId, "open", input.ActorId, time.Now().UTC()))
+
+	rows := utils.GetOrThrow(tx.Query(context.Background(),
+		"SELECT id, stock_quantity FROM inventories WHERE warehouse_id = $1 FOR SHARE", input.WarehouseId))
+
+	type schema struct {
+		InventoryId   uuid.UUID
+		StockQuantity int32
+	}
+
+	records := []schema{}
+	for rows.Next() {
+		var item schema
+
+		utils.ThrowOnError(rows.Scan(&item.InventoryId, &item.StockQuantity))
+		records = append(records, item)
+	}
+
+	for _, record := range records {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO stock_count_items (id, stock_count_id, inventory_id, expected_quantity) VALUES ($1, $2, $3, $4)",
+			uuid.New(), stockCountId, record.InventoryId, record.StockQuantity))
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return StartStockCountUsecaseOutput{
+		StockCountId: stockCountId,
+	}, nil
+}
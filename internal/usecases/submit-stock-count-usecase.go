@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubmitStockCountItem struct {
+	InventoryId     uuid.UUID
+	CountedQuantity int32
+}
+
+type SubmitStockCountUsecaseInput struct {
+	StockCountId uuid.UUID
+	Items        []SubmitStockCountItem
+}
+
+type SubmitStockCountUsecase struct {
+	pgxPool       *pgxpool.Pool
+	stockCountDAO daos.StockCountDAO
+}
+
+func NewSubmitStockCountUsecase(pgxPool *pgxpool.Pool, stockCountDAO daos.StockCountDAO) SubmitStockCountUsecase {
+	return SubmitStockCountUsecase{pgxPool, stockCountDAO}
+}
+
+// Execute records the counted quantities. Inventories left out of the submission are treated as not
+// counted and are left untouched when the count is applied.
+func (s *SubmitStockCountUsecase) Execute(input SubmitStockCountUsecaseInput) error {
+	stockCountSchema := s.stockCountDAO.FindOneById(input.StockCountId)
+
+	if stockCountSchema == nil {
+		return errors.New("stock count not found")
+	}
+
+	stockCountItems := map[uuid.UUID]bool{}
+	for _, item := range s.stockCountDAO.FindAllItemsByStockCountId(input.StockCountId) {
+		stockCountItems[item.InventoryId] = true
+	}
+
+	for _, item := range input.Items {
+		if item.CountedQuantity < 0 {
+			return errors.New("counted quantity cannot be negative")
+		}
+
+		if !stockCountItems[item.InventoryId] {
+			return errors.New("inventory is not part of the stock count")
+		}
+	}
+
+	tx := utils.GetOrThrow(s.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var status string
+	utils.ThrowOnError(tx.QueryRow(context.Background(), "SELECT status FROM stock_counts WHERE id = $1 FOR UPDATE", input.StockCountId).
+		Scan(&status))
+
+	if status != "open" {
+		return errors.New("stock count is not open")
+	}
+
+	for _, item := range input.Items {
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"UPDATE stock_count_items SET counted_quantity = $1 WHERE stock_count_id = $2 AND inventory_id = $3",
+			item.CountedQuantity, input.StockCountId, item.InventoryId))
+	}
+
+	_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE stock_counts SET status = $1, submitted_at = $2 WHERE id = $3",
+		"submitted", time.Now().UTC(), input.StockCountId))
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscribeToRestockUsecaseInput struct {
+	CustomerId uuid.UUID
+	ProductId  uuid.UUID
+}
+
+type SubscribeToRestockUsecase struct {
+	pgxPool      *pgxpool.Pool
+	productDAO   daos.ProductDAO
+	inventoryDAO daos.InventoryDAO
+}
+
+func NewSubscribeToRestockUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO, inventoryDAO daos.InventoryDAO) SubscribeToRestockUsecase {
+	return SubscribeToRestockUsecase{pgxPool, productDAO, inventoryDAO}
+}
+
+// Execute subscribes the customer to the next restock of an out of stock product. Subscribing again
+// while a subscription is still pending keeps the existing one.
+func (s *SubscribeToRestockUsecase) Execute(input SubscribeToRestockUsecaseInput) error {
+	if s.productDAO.FindOneById(input.ProductId) == nil {
+		return errors.New("product not found")
+	}
+
+	if s.inventoryDAO.SumStockQuantityByProductId(input.ProductId) > 0 {
+		return errors.New("product is in stock")
+	}
+
+	_ = utils.GetOrThrow(s.pgxPool.Exec(context.Background(),
+		`INSERT INTO restock_subscriptions (id, customer_id, product_id, unsubscribe_token, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (customer_id, product_id) WHERE notified_at IS NULL AND unsubscribed_at IS NULL DO NOTHING`,
+		uuid.New(), input.CustomerId, input.ProductId, newUnsubscribeToken(), time.Now().UTC()))
+
+	return nil
+}
+
+func newUnsubscribeToken() string {
+	token := make([]byte, 32)
+	_ = utils.GetOrThrow(rand.Read(token))
+
+	return hex.EncodeToString(token)
+}
@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+)
+
+// TaxCategoryGeneral is the tax category of products not given one, and the rate a state falls back to for
+// categories it has no rate for.
+const TaxCategoryGeneral = "general"
+
+// TaxDestination is where an order ships to, which decides the tax it pays.
+type TaxDestination struct {
+	State   string
+	ZipCode string
+}
+
+type TaxableLine struct {
+	ProductId   uuid.UUID
+	TaxCategory string
+	Amount      int64
+}
+
+type TaxLine struct {
+	ProductId       uuid.UUID
+	Jurisdiction    string
+	TaxCategory     string
+	RateBasisPoints int32
+	TaxableAmount   int64
+	Amount          int64
+}
+
+// TaxProvider calculates the tax on lines shipped to a destination. Lines not taxed there are left out of the
+// result. An external tax calculator can be plugged in by implementing it.
+type TaxProvider interface {
+	Calculate(destination TaxDestination, lines []TaxableLine) ([]TaxLine, error)
+}
+
+type RateTableTaxProvider struct {
+	taxRateDAO daos.TaxRateDAO
+}
+
+func NewRateTableTaxProvider(taxRateDAO daos.TaxRateDAO) RateTableTaxProvider {
+	return RateTableTaxProvider{taxRateDAO}
+}
+
+// Calculate taxes each line at the destination state's rate for its tax category, or the state's general rate
+// when the category has no rate of its own. Amounts are rounded half up to the minor unit.
+func (r RateTableTaxProvider) Calculate(destination TaxDestination, lines []TaxableLine) ([]TaxLine, error) {
+	taxLines := []TaxLine{}
+
+	for _, line := range lines {
+		taxRateSchema := r.taxRateDAO.FindOneByStateAndTaxCategory(strings.ToUpper(destination.State), line.TaxCategory)
+
+		if taxRateSchema == nil && line.TaxCategory != TaxCategoryGeneral {
+			taxRateSchema = r.taxRateDAO.FindOneByStateAndTaxCategory(strings.ToUpper(destination.State), TaxCategoryGeneral)
+		}
+
+		if taxRateSchema == nil {
+			continue
+		}
+
+		taxLines = append(taxLines, TaxLine{
+			ProductId:       line.ProductId,
+			Jurisdiction:    strings.ToUpper(destination.State),
+			TaxCategory:     line.TaxCategory,
+			RateBasisPoints: taxRateSchema.RateBasisPoints,
+			TaxableAmount:   line.Amount,
+			Amount:          (line.Amount*int64(taxRateSchema.RateBasisPoints) + 5000) / 10000,
+		})
+	}
+
+	return taxLines, nil
+}
+
+// parseTaxCategory normalizes a tax category name, so "Groceries" and "groceries" are the same category.
+func parseTaxCategory(taxCategory string) (string, error) {
+	taxCategory = strings.ToLower(strings.TrimSpace(taxCategory))
+
+	if !regexp.MustCompile(`^[a-z0-9_]{1,50}$`).MatchString(taxCategory) {
+		return "", errors.New("tax category must have up to 50 letters, digits or underscores")
+	}
+
+	return taxCategory, nil
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UnpinRelatedProductUsecaseInput struct {
+	ProductId        uuid.UUID
+	RelatedProductId uuid.UUID
+}
+
+type UnpinRelatedProductUsecase struct {
+	pgxPool *pgxpool.Pool
+}
+
+func NewUnpinRelatedProductUsecase(pgxPool *pgxpool.Pool) UnpinRelatedProductUsecase {
+	return UnpinRelatedProductUsecase{pgxPool}
+}
+
+func (u *UnpinRelatedProductUsecase) Execute(input UnpinRelatedProductUsecaseInput) error {
+	commandTag := utils.GetOrThrow(u.pgxPool.Exec(context.Background(),
+		"DELETE FROM product_recommendation_pins WHERE product_id = $1 AND related_product_id = $2",
+		input.ProductId, input.RelatedProductId))
+
+	if commandTag.RowsAffected() == 0 {
+		return errors.New("related product is not pinned")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UnsubscribeFromRestockUsecaseInput struct {
+	UnsubscribeToken string
+}
+
+type UnsubscribeFromRestockUsecase struct {
+	pgxPool                *pgxpool.Pool
+	restockSubscriptionDAO daos.RestockSubscriptionDAO
+}
+
+func NewUnsubscribeFromRestockUsecase(pgxPool *pgxpool.Pool, restockSubscriptionDAO daos.RestockSubscriptionDAO) UnsubscribeFromRestockUsecase {
+	return UnsubscribeFromRestockUsecase{pgxPool, restockSubscriptionDAO}
+}
+
+// Execute is idempotent, as unsubscribe links may be followed more than once.
+func (u *UnsubscribeFromRestockUsecase) Execute(input UnsubscribeFromRestockUsecaseInput) error {
+	restockSubscriptionSchema := u.restockSubscriptionDAO.FindOneByUnsubscribeToken(input.UnsubscribeToken)
+
+	if restockSubscriptionSchema == nil {
+		return errors.New("subscription not found")
+	}
+
+	_ = utils.GetOrThrow(u.pgxPool.Exec(context.Background(),
+		"UPDATE restock_subscriptions SET unsubscribed_at = $1 WHERE id = $2 AND unsubscribed_at IS NULL",
+		time.Now().UTC(), restockSubscriptionSchema.Id))
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/daos"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UpdateCartItem struct {
+	ProductId uuid.UUID
+	Quantity  int32
+}
+
+type UpdateCartUsecaseInput struct {
+	CustomerId uuid.UUID
+	Version    int32
+	// Replace makes the cart hold exactly the given items. Otherwise only the given items are changed, and a
+	// zero quantity removes the item.
+	Replace bool
+	Items   []UpdateCartItem
+}
+
+type UpdateCartUsecaseOutput struct {
+	Version int32
+}
+
+type UpdateCartUsecase struct {
+	pgxPool         *pgxpool.Pool
+	productDAO      daos.ProductDAO
+	inventoryDAO    daos.InventoryDAO
+	productPriceDAO daos.ProductPriceDAO
+	orderItemDAO    daos.OrderItemDAO
+}
+
+func NewUpdateCartUsecase(pgxPool *pgxpool.Pool, productDAO daos.ProductDAO, inventoryDAO daos.InventoryDAO,
+	productPriceDAO daos.ProductPriceDAO, orderItemDAO daos.OrderItemDAO) UpdateCartUsecase {
+	return UpdateCartUsecase{pgxPool, productDAO, inventoryDAO, productPriceDAO, orderItemDAO}
+}
+
+// Execute applies every item change in one transaction, or none of them. The cart row is locked and its version
+// compared with the one the client read, so a client working on a stale cart cannot overwrite newer changes.
+func (u *UpdateCartUsecase) Execute(input UpdateCartUsecaseInput) (UpdateCartUsecaseOutput, error) {
+	quantities := map[uuid.UUID]int32{}
+
+	for _, item := range input.Items {
+		if _, ok := quantities[item.ProductId]; ok {
+			return UpdateCartUsecaseOutput{}, errors.New("cart items must be distinct")
+		}
+
+		quantities[item.ProductId] = item.Quantity
+	}
+
+	tx := utils.GetOrThrow(u.pgxPool.Begin(context.Background()))
+
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var cartId uuid.UUID
+	var currency string
+	var version int32
+
+	err := tx.QueryRow(context.Background(), "SELECT id, currency, version FROM carts WHERE customer_id = $1 FOR UPDATE",
+		input.CustomerId).Scan(&cartId, &currency, &version)
+	if err == pgx.ErrNoRows {
+		return UpdateCartUsecaseOutput{}, errors.New("cart not found")
+	}
+	utils.ThrowOnError(err)
+
+	if version != input.Version {
+		return UpdateCartUsecaseOutput{}, errors.New("cart has been modified")
+	}
+
+	rows := utils.GetOrThrow(tx.Query(context.Background(), "SELECT product_id, quantity FROM cart_items WHERE cart_id = $1", cartId))
+
+	cartQuantities := map[uuid.UUID]int32{}
+	for rows.Next() {
+		var productId uuid.UUID
+		var quantity int32
+
+		utils.ThrowOnError(rows.Scan(&productId, &quantity))
+		cartQuantities[productId] = quantity
+	}
+
+	if input.Replace {
+		for productId := range cartQuantities {
+			if _, ok := quantities[productId]; !ok {
+				quantities[productId] = 0
+			}
+		}
+	}
+
+	prices := map[uuid.UUID]int64{}
+
+	for _, item := range input.Items {
+		if item.Quantity <= cartQuantities[item.ProductId] {
+			continue
+		}
+
+		productSchema := u.productDAO.FindOneById(item.ProductId)
+
+		if productSchema == nil {
+			return UpdateCartUsecaseOutput{}, errors.New("product not found")
+		}
+
+		stockQuantity := u.inventoryDAO.SumStockQuantityByProductId(item.ProductId)
+		backorderedQuantity := u.orderItemDAO.SumBackorderedQuantityByProductId(item.ProductId)
+
+		if item.Quantity > sellableQuantity(*productSchema, stockQuantity, backorderedQuantity) {
+			return UpdateCartUsecaseOutput{}, errors.New("product quantity exceeds the stock available")
+		}
+
+		if err := checkPurchaseLimits(tx, *productSchema, &input.CustomerId, item.Quantity); err != nil {
+			return UpdateCartUsecaseOutput{}, err
+		}
+
+		price, ok := findProductPrice(u.productPriceDAO, *productSchema, currency)
+
+		if !ok {
+			return UpdateCartUsecaseOutput{}, errors.New("product is not priced in the cart currency")
+		}
+
+		prices[item.ProductId] = price.Amount
+	}
+
+	for productId, quantity := range quantities {
+		cartQuantity, inCart := cartQuantities[productId]
+
+		if quantity == 0 && inCart {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(), "DELETE FROM cart_items WHERE cart_id = $1 AND product_id = $2", cartId, productId))
+			continue
+		}
+
+		if quantity == 0 || quantity == cartQuantity {
+			continue
+		}
+
+		if inCart {
+			_ = utils.GetOrThrow(tx.Exec(context.Background(), "UPDATE cart_items SET quantity = $1 WHERE cart_id = $2 AND product_id = $3",
+				quantity, cartId, productId))
+			continue
+		}
+
+		_ = utils.GetOrThrow(tx.Exec(context.Background(),
+			"INSERT INTO cart_items (id, cart_id, product_id, quantity, price_at_add, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
+			uuid.New(), cartId, productId, quantity, prices[productId], time.Now().UTC()))
+	}
+
+	output := UpdateCartUsecaseOutput{
+		Version: touchCart(tx, cartId),
+	}
+
+	utils.ThrowOnError(tx.Commit(context.Background()))
+
+	return output, nil
+}
@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+type WarehouseStock struct {
+	InventoryId   uuid.UUID
+	WarehouseId   uuid.UUID
+	ZipCode       *string
+	StockQuantity int32
+}
+
+type StockAllocation struct {
+	InventoryId uuid.UUID
+	WarehouseId uuid.UUID
+	Quantity    int32
+}
+
+// WarehouseAllocationStrategy decides which warehouses fulfil an order line by ranking the
+// warehouses holding the product from the most to the least preferred.
+type WarehouseAllocationStrategy interface {
+	Rank(warehouses []WarehouseStock, shippingZipCode string) []WarehouseStock
+}
+
+type NearestWarehouseAllocationStrategy struct{}
+
+// Rank prefers warehouses whose zip code is closest to the shipping zip code. US zip codes are
+// assigned geographically, so the distance between their three-digit prefixes is a good enough proxy.
+func (n NearestWarehouseAllocationStrategy) Rank(warehouses []WarehouseStock, shippingZipCode string) []WarehouseStock {
+	ranked := append([]WarehouseStock{}, warehouses...)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		iDistance := zipCodeDistance(ranked[i].ZipCode, shippingZipCode)
+		jDistance := zipCodeDistance(ranked[j].ZipCode, shippingZipCode)
+
+		if iDistance != jDistance {
+			return iDistance < jDistance
+		}
+
+		return ranked[i].StockQuantity > ranked[j].StockQuantity
+	})
+
+	return ranked
+}
+
+type MostStockWarehouseAllocationStrategy struct{}
+
+func (m MostStockWarehouseAllocationStrategy) Rank(warehouses []WarehouseStock, shippingZipCode string) []WarehouseStock {
+	ranked := append([]WarehouseStock{}, warehouses...)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].StockQuantity > ranked[j].StockQuantity
+	})
+
+	return ranked
+}
+
+// NewWarehouseAllocationStrategy returns the strategy with the given name, defaulting to the nearest warehouse.
+func NewWarehouseAllocationStrategy(name string) WarehouseAllocationStrategy {
+	if name == "most_stock" {
+		return MostStockWarehouseAllocationStrategy{}
+	}
+
+	return NearestWarehouseAllocationStrategy{}
+}
+
+func zipCodeDistance(warehouseZipCode *string, shippingZipCode string) int {
+	const unknownDistance = 1000
+
+	if warehouseZipCode == nil || len(*warehouseZipCode) < 3 || len(shippingZipCode) < 3 {
+		return unknownDistance
+	}
+
+	warehousePrefix, err := strconv.Atoi((*warehouseZipCode)[:3])
+	if err != nil {
+		return unknownDistance
+	}
+
+	shippingPrefix, err := strconv.Atoi(shippingZipCode[:3])
+	if err != nil {
+		return unknownDistance
+	}
+
+	distance := warehousePrefix - shippingPrefix
+	if distance < 0 {
+		return -distance
+	}
+
+	return distance
+}
+
+// allocateStock ships the whole quantity from the most preferred warehouse that can fill it, and only
+// splits the line across warehouses, in order of preference, when none of them can fill it alone.
+func allocateStock(strategy WarehouseAllocationStrategy, warehouses []WarehouseStock, shippingZipCode string,
+	quantity int32) ([]StockAllocation, error) {
+	ranked := strategy.Rank(warehouses, shippingZipCode)
+
+	for _, warehouse := range ranked {
+		if warehouse.StockQuantity >= quantity {
+			return []StockAllocation{{InventoryId: warehouse.InventoryId, WarehouseId: warehouse.WarehouseId, Quantity: quantity}}, nil
+		}
+	}
+
+	allocations := []StockAllocation{}
+	remaining := quantity
+
+	for _, warehouse := range ranked {
+		if remaining == 0 {
+			break
+		}
+
+		if warehouse.StockQuantity <= 0 {
+			continue
+		}
+
+		allocated := min(warehouse.StockQuantity, remaining)
+		allocations = append(allocations, StockAllocation{InventoryId: warehouse.InventoryId, WarehouseId: warehouse.WarehouseId, Quantity: allocated})
+		remaining -= allocated
+	}
+
+	if remaining > 0 {
+		return nil, errors.New("product quantity exceeds the stock available")
+	}
+
+	return allocations, nil
+}
@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	WishlistKindWishlist      = "wishlist"
+	WishlistKindSavedForLater = "saved_for_later"
+)
+
+const (
+	WishlistItemInStock     = "in_stock"
+	WishlistItemOutOfStock  = "out_of_stock"
+	WishlistItemUnavailable = "unavailable"
+)
+
+// WishlistItem is a product on a list, priced now in the currency it was added in. PriceDropped tells
+// whether it costs less than when it was added.
+type WishlistItem struct {
+	ProductId    uuid.UUID
+	Name         string
+	Quantity     int32
+	Currency     string
+	PriceAtAdd   *int64
+	Price        *int64
+	PriceDropped bool
+	StockStatus  string
+}
+
+type Wishlist struct {
+	Id    uuid.UUID
+	Kind  string
+	Name  string
+	Items []WishlistItem
+}
+
+// FindWishlists returns the customer's lists, the saved for later one included, oldest first.
+func FindWishlists(querier pgxQuerier, customerId uuid.UUID) []Wishlist {
+	rows := utils.GetOrThrow(querier.Query(context.Background(),
+		"SELECT id, kind, name FROM wishlists WHERE customer_id = $1 ORDER BY created_at, id", customerId))
+
+	wishlists := []Wishlist{}
+	indexes := map[uuid.UUID]int{}
+	for rows.Next() {
+		wishlist := Wishlist{Items: []WishlistItem{}}
+
+		utils.ThrowOnError(rows.Scan(&wishlist.Id, &wishlist.Kind, &wishlist.Name))
+		indexes[wishlist.Id] = len(wishlists)
+		wishlists = append(wishlists, wishlist)
+	}
+
+	rows = utils.GetOrThrow(querier.Query(context.Background(),
+		`
+			SELECT
+				wi.wishlist_id,
+				wi.product_id,
+				p.name,
+				wi.quantity,
+				wi.currency,
+				wi.price_at_add,
+				COALESCE(pp.price, CASE WHEN p.currency = wi.currency THEN p.price END),
+				p.status,
+				p.allows_backorder,
+				p.allows_preorder,
+				p.backorder_limit,
+				(SELECT COALESCE(SUM(s.stock_quantity), 0)::INT FROM product_available_stock s WHERE s.product_id = p.id),
+				(SELECT COALESCE(SUM(oi.backordered_quantity), 0)::INT FROM order_items oi WHERE oi.product_id = p.id)
+			FROM wishlists w
+			JOIN wishlist_items wi
+				ON wi.wishlist_id = w.id
+			JOIN products p
+				ON p.id = wi.product_id
+			LEFT JOIN product_prices pp
+				ON pp.product_id = p.id AND pp.currency = wi.currency
+			WHERE w.customer_id = $1
+			ORDER BY wi.created_at, wi.id
+		`, customerId))
+
+	for rows.Next() {
+		var wishlistId uuid.UUID
+		var item WishlistItem
+		var line CartLine
+
+		utils.ThrowOnError(rows.Scan(&wishlistId, &item.ProductId, &item.Name, &item.Quantity, &item.Currency, &item.PriceAtAdd,
+			&item.Price, &line.Product.Status, &line.Product.AllowsBackorder, &line.Product.AllowsPreorder,
+			&line.Product.BackorderLimit, &line.StockQuantity, &line.BackorderedQuantity))
+
+		line.Price = item.Price
+
+		switch {
+		case !line.available():
+			item.StockStatus = WishlistItemUnavailable
+		case sellableQuantity(line.Product, line.StockQuantity, line.BackorderedQuantity) <= 0:
+			item.StockStatus = WishlistItemOutOfStock
+		default:
+			item.StockStatus = WishlistItemInStock
+		}
+
+		item.PriceDropped = item.PriceAtAdd != nil && item.Price != nil && *item.Price < *item.PriceAtAdd
+
+		wishlist := &wishlists[indexes[wishlistId]]
+		wishlist.Items = append(wishlist.Items, item)
+	}
+
+	return wishlists
+}
+
+// savedForLaterWishlist returns the customer's saved for later list, creating it on first use.
+func savedForLaterWishlist(tx pgx.Tx, customerId uuid.UUID) uuid.UUID {
+	_ = utils.GetOrThrow(tx.Exec(context.Background(),
+		`INSERT INTO wishlists (id, customer_id, kind, name, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (customer_id) WHERE kind = 'saved_for_later' DO NOTHING`,
+		uuid.New(), customerId, WishlistKindSavedForLater, "Saved for later", time.Now().UTC()))
+
+	var wishlistId uuid.UUID
+
+	utils.ThrowOnError(tx.QueryRow(context.Background(),
+		"SELECT id FROM wishlists WHERE customer_id = $1 AND kind = $2", customerId, WishlistKindSavedForLater).Scan(&wishlistId))
+
+	return wishlistId
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const DefaultCurrency = "USD"
+
+// Number of minor units digits per ISO 4217 currency, e.g. 2 for USD (cents) and 0 for CLP.
+var currencyExponents = map[string]int{
+	"ARS": 2,
+	"BRL": 2,
+	"CLP": 0,
+	"COP": 2,
+	"EUR": 2,
+	"MXN": 2,
+	"PEN": 2,
+	"USD": 2,
+	"UYU": 2,
+}
+
+// Money is an amount in the minor units of its ISO 4217 currency, e.g. Money{2999, "USD"} is 29.99 dollars.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+func NewMoney(amount int64, currency string) (Money, error) {
+	if !IsSupportedCurrency(currency) {
+		return Money{}, errors.New("currency is not supported")
+	}
+
+	return Money{amount, currency}, nil
+}
+
+func IsSupportedCurrency(currency string) bool {
+	_, ok := currencyExponents[currency]
+	return ok
+}
+
+func (m Money) Multiply(quantity int64) Money {
+	return Money{m.Amount * quantity, m.Currency}
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, errors.New("cannot add amounts in different currencies")
+	}
+
+	return Money{m.Amount + other.Amount, m.Currency}, nil
+}
+
+// DecimalString formats the amount in major units with the currency's exact number of decimals, e.g. "29.99".
+func (m Money) DecimalString() string {
+	exponent := currencyExponents[m.Currency]
+	digits := strconv.FormatInt(m.Amount, 10)
+	sign := ""
+
+	if strings.HasPrefix(digits, "-") {
+		sign = "-"
+		digits = digits[1:]
+	}
+
+	if exponent == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+// Decimal converts the amount to the major-units decimal expected by payment gateways, e.g. 29.99.
+// Parsing the exact decimal string yields the closest float64, avoiding the drift of dividing floats.
+func (m Money) Decimal() float64 {
+	return GetOrThrow(strconv.ParseFloat(m.DecimalString(), 64))
+}
@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type MoneySuite struct {
+	suite.Suite
+}
+
+func (m *MoneySuite) Test1() {
+	m.Run("when converting an amount in cents to decimal, then returns it in major units", func() {
+		money := utils.GetOrThrow(utils.NewMoney(2999, "USD"))
+
+		m.Equal("29.99", money.DecimalString())
+		m.Equal(29.99, money.Decimal())
+	})
+}
+
+func (m *MoneySuite) Test2() {
+	m.Run("when converting amounts smaller than one major unit, then pads them with zeros", func() {
+		m.Equal("0.05", utils.GetOrThrow(utils.NewMoney(5, "BRL")).DecimalString())
+		m.Equal("0.00", utils.GetOrThrow(utils.NewMoney(0, "BRL")).DecimalString())
+		m.Equal("-0.50", utils.GetOrThrow(utils.NewMoney(-50, "BRL")).DecimalString())
+	})
+}
+
+func (m *MoneySuite) Test3() {
+	m.Run("when converting an amount of a currency without minor units, then returns it unchanged", func() {
+		money := utils.GetOrThrow(utils.NewMoney(15990, "CLP"))
+
+		m.Equal("15990", money.DecimalString())
+		m.Equal(float64(15990), money.Decimal())
+	})
+}
+
+func (m *MoneySuite) Test4() {
+	m.Run("when converting large amounts, then does not lose precision to float arithmetic", func() {
+		money := utils.GetOrThrow(utils.NewMoney(99286, "USD")).Multiply(3)
+
+		m.Equal("2978.58", money.DecimalString())
+		m.Equal(2978.58, money.Decimal())
+	})
+}
+
+func (m *MoneySuite) Test5() {
+	m.Run("when creating money with an unknown currency, then returns error", func() {
+		_, err := utils.NewMoney(100, "XYZ")
+
+		m.EqualError(err, "currency is not supported")
+	})
+}
+
+func (m *MoneySuite) Test6() {
+	m.Run("when adding amounts, then requires the same currency", func() {
+		total := utils.GetOrThrow(utils.GetOrThrow(utils.NewMoney(100, "USD")).Add(utils.Money{Amount: 250, Currency: "USD"}))
+		m.Equal(utils.Money{Amount: 350, Currency: "USD"}, total)
+
+		_, err := utils.GetOrThrow(utils.NewMoney(100, "USD")).Add(utils.Money{Amount: 250, Currency: "BRL"})
+		m.EqualError(err, "cannot add amounts in different currencies")
+	})
+}
+
+func TestMoney(t *testing.T) {
+	suite.Run(t, new(MoneySuite))
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func Slugify(input string) string {
+	var builder strings.Builder
+	pendingHyphen := false
+
+	for _, r := range norm.NFKD.String(strings.ToLower(input)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && builder.Len() > 0 {
+				builder.WriteRune('-')
+			}
+
+			builder.WriteRune(r)
+			pendingHyphen = false
+			continue
+		}
+
+		pendingHyphen = true
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,40 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SlugifySuite struct {
+	suite.Suite
+}
+
+func (s *SlugifySuite) Test1() {
+	s.Run("when slugifying a name, then returns it lowercased and hyphen separated", func() {
+		s.Equal("ergoclick-pro-wireless-mouse", utils.Slugify("ErgoClick Pro Wireless Mouse"))
+	})
+}
+
+func (s *SlugifySuite) Test2() {
+	s.Run("when slugifying a name with punctuation and repeated separators, then collapses them into a single hyphen", func() {
+		s.Equal("jbl-tune-520bt-wireless-headphones", utils.Slugify("  JBL Tune 520BT -- Wireless   Headphones!  "))
+	})
+}
+
+func (s *SlugifySuite) Test3() {
+	s.Run("when slugifying a name with accents, then removes the diacritics", func() {
+		s.Equal("cafe-creme-brulee", utils.Slugify("Café Crème Brûlée"))
+	})
+}
+
+func (s *SlugifySuite) Test4() {
+	s.Run("when slugifying a name without letters or digits, then returns empty", func() {
+		s.Equal("", utils.Slugify("!!! ---"))
+	})
+}
+
+func TestSlugify(t *testing.T) {
+	suite.Run(t, new(SlugifySuite))
+}
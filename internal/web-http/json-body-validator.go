@@ -19,6 +19,7 @@ func NewJSONBodyValidator() (JSONBodyValidator, error) {
 
 	utils.ThrowOnError(newValidator.RegisterValidation("string", isString))
 	utils.ThrowOnError(newValidator.RegisterValidation("integer", isInteger))
+	utils.ThrowOnError(newValidator.RegisterValidation("boolean", isBoolean))
 	utils.ThrowOnError(newValidator.RegisterValidation("notEmpty", isNotEmpty))
 	utils.ThrowOnError(newValidator.RegisterValidation("positive", isPositive))
 	utils.ThrowOnError(newValidator.RegisterValidation("timeRFC3339", isTimeRFC3339))
@@ -44,6 +45,10 @@ func isInteger(fieldLevel validator.FieldLevel) bool {
 	return value == float64(int(value))
 }
 
+func isBoolean(fieldLevel validator.FieldLevel) bool {
+	return fieldLevel.Field().Kind() == reflect.Bool
+}
+
 func isNotEmpty(fieldLevel validator.FieldLevel) bool {
 	field := fieldLevel.Field()
 
@@ -51,6 +56,10 @@ func isNotEmpty(fieldLevel validator.FieldLevel) bool {
 		return strings.TrimSpace(field.String()) != ""
 	}
 
+	if field.Kind() == reflect.Slice {
+		return field.Len() > 0
+	}
+
 	return false
 }
 
@@ -97,7 +106,7 @@ func (j *JSONBodyValidator) Validate(body any) []string {
 			field := strings.ToLower(validationError.Field()[:1]) + validationError.Field()[1:]
 
 			switch tag {
-			case "required":
+			case "required", "required_with", "required_without", "required_without_all":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s is required", field))
 			case "uuid4":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be uuidv4", field))
@@ -105,6 +114,8 @@ func (j *JSONBodyValidator) Validate(body any) []string {
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be string", field))
 			case "integer":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must be integer", field))
+			case "boolean":
+				errorMessages = append(errorMessages, fmt.Sprintf("%s must be boolean", field))
 			case "notEmpty":
 				errorMessages = append(errorMessages, fmt.Sprintf("%s must not be empty", field))
 			case "positive":
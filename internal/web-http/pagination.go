@@ -0,0 +1,39 @@
+package webhttp
+
+import "strconv"
+
+type Pagination struct {
+	Page     int
+	PageSize int
+}
+
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+func ParsePagination(page string, pageSize string) (Pagination, []string) {
+	pagination := Pagination{Page: 1, PageSize: 20}
+	errorMessages := []string{}
+
+	if page != "" {
+		value, err := strconv.Atoi(page)
+
+		if err != nil || value < 1 {
+			errorMessages = append(errorMessages, "page must be a positive integer")
+		} else {
+			pagination.Page = value
+		}
+	}
+
+	if pageSize != "" {
+		value, err := strconv.Atoi(pageSize)
+
+		if err != nil || value < 1 || value > 100 {
+			errorMessages = append(errorMessages, "pageSize must be between 1 and 100")
+		} else {
+			pagination.PageSize = value
+		}
+	}
+
+	return pagination, errorMessages
+}
@@ -0,0 +1,56 @@
+package workers
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+)
+
+type OutboxDispatcher interface {
+	Execute() (usecases.OutboxDispatchOutput, error)
+}
+
+type OutboxWorker struct {
+	logger     *slog.Logger
+	name       string
+	interval   time.Duration
+	dispatcher OutboxDispatcher
+}
+
+func NewOutboxWorker(logger *slog.Logger, name string, interval time.Duration, dispatcher OutboxDispatcher) OutboxWorker {
+	return OutboxWorker{logger, name, interval, dispatcher}
+}
+
+// Start keeps dispatching while batches come back full, then waits for the interval.
+func (o *OutboxWorker) Start() {
+	go func() {
+		for {
+			for o.run() {
+			}
+
+			time.Sleep(o.interval)
+		}
+	}()
+}
+
+func (o *OutboxWorker) run() (hasMore bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			o.logger.Error(fmt.Sprintf("%s worker failed: %v", o.name, r))
+			hasMore = false
+		}
+	}()
+
+	output, err := o.dispatcher.Execute()
+	if err != nil {
+		o.logger.Error(err.Error())
+	}
+
+	if output.NotifiedCount > 0 {
+		o.logger.Info(o.name+" notified", slog.Int64("notifiedCount", output.NotifiedCount))
+	}
+
+	return output.HasMore
+}
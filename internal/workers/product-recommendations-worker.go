@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gsaaraujo/ecommerce-api-scenario-1/internal/usecases"
+)
+
+type ProductRecommendationsWorker struct {
+	logger                               *slog.Logger
+	interval                             time.Duration
+	computeProductRecommendationsUsecase usecases.ComputeProductRecommendationsUsecase
+}
+
+func NewProductRecommendationsWorker(logger *slog.Logger, interval time.Duration,
+	computeProductRecommendationsUsecase usecases.ComputeProductRecommendationsUsecase) ProductRecommendationsWorker {
+	return ProductRecommendationsWorker{logger, interval, computeProductRecommendationsUsecase}
+}
+
+// Start recomputes the recommendations right away and then once per interval, in the background.
+func (p *ProductRecommendationsWorker) Start() {
+	go func() {
+		for {
+			p.run()
+			time.Sleep(p.interval)
+		}
+	}()
+}
+
+func (p *ProductRecommendationsWorker) run() {
+	defer func() {
+		if r := recover(); r != nil {
+			p.logger.Error(fmt.Sprintf("product recommendations worker failed: %v", r))
+		}
+	}()
+
+	output, err := p.computeProductRecommendationsUsecase.Execute()
+	if err != nil {
+		p.logger.Error(err.Error())
+		return
+	}
+
+	p.logger.Info("product recommendations computed", slog.Int64("recommendationsCount", output.RecommendationsCount))
+}
@@ -0,0 +1,21 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS sku VARCHAR(50) UNIQUE;
+
+CREATE TABLE IF NOT EXISTS product_import_jobs (
+  id UUID PRIMARY KEY,
+  status VARCHAR(20) NOT NULL,
+  format VARCHAR(10) NOT NULL,
+  total_rows INT NOT NULL,
+  processed_rows INT NOT NULL,
+  failed_rows INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS product_import_job_errors (
+  id UUID PRIMARY KEY,
+  product_import_job_id UUID NOT NULL,
+  row_number INT NOT NULL,
+  message VARCHAR(255) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (product_import_job_id) REFERENCES product_import_jobs(id)
+);
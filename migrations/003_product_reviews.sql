@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS product_reviews (
+  id UUID PRIMARY KEY,
+  product_id UUID NOT NULL,
+  customer_id UUID NOT NULL,
+  rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+  title VARCHAR(100) NOT NULL,
+  body TEXT NOT NULL,
+  status VARCHAR(20) NOT NULL,
+  helpful_count INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  moderated_at TIMESTAMPTZ,
+  UNIQUE (product_id, customer_id),
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (customer_id) REFERENCES customers(id)
+);
+
+CREATE INDEX IF NOT EXISTS product_reviews_product_id_status_idx ON product_reviews (product_id, status);
+
+CREATE TABLE IF NOT EXISTS product_review_votes (
+  product_review_id UUID NOT NULL,
+  customer_id UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (product_review_id, customer_id),
+  FOREIGN KEY (product_review_id) REFERENCES product_reviews(id),
+  FOREIGN KEY (customer_id) REFERENCES customers(id)
+);
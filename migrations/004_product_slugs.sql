@@ -0,0 +1,12 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS slug VARCHAR(120) UNIQUE;
+
+UPDATE products
+SET slug = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')) || '-' || LEFT(id::TEXT, 8)
+WHERE slug IS NULL;
+
+CREATE TABLE IF NOT EXISTS product_slug_redirects (
+  slug VARCHAR(120) PRIMARY KEY,
+  product_id UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS product_recommendations (
+  product_id UUID NOT NULL,
+  related_product_id UUID NOT NULL,
+  score INT NOT NULL,
+  computed_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (product_id, related_product_id),
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (related_product_id) REFERENCES products(id)
+);
+
+CREATE TABLE IF NOT EXISTS product_recommendation_pins (
+  product_id UUID NOT NULL,
+  related_product_id UUID NOT NULL,
+  position INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (product_id, related_product_id),
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (related_product_id) REFERENCES products(id)
+);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS inventory_movements (
+  id UUID PRIMARY KEY,
+  inventory_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  reason VARCHAR(20) NOT NULL CHECK (reason IN ('restock', 'sale', 'return', 'adjustment', 'damage')),
+  quantity INT NOT NULL,
+  balance INT NOT NULL,
+  actor_id UUID,
+  reference_id UUID,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (inventory_id) REFERENCES inventories(id),
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+CREATE INDEX IF NOT EXISTS inventory_movements_product_id_created_at_idx ON inventory_movements (product_id, created_at);
+
+-- The ledger is append-only: rows can be inserted but never changed or removed.
+CREATE OR REPLACE FUNCTION reject_inventory_movements_change() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'inventory_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS inventory_movements_append_only ON inventory_movements;
+
+CREATE TRIGGER inventory_movements_append_only
+BEFORE UPDATE OR DELETE ON inventory_movements
+FOR EACH ROW EXECUTE FUNCTION reject_inventory_movements_change();
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS warehouses (
+  id UUID PRIMARY KEY,
+  name VARCHAR(50) UNIQUE NOT NULL,
+  zip_code VARCHAR(20),
+  is_default BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS warehouses_single_default_idx ON warehouses (is_default) WHERE is_default;
+
+-- Existing stock lives in the warehouse we had before multi-location support.
+INSERT INTO warehouses (id, name, zip_code, is_default, created_at)
+VALUES ('7c1d1a8e-2f4b-4e6a-9d3c-5b8e0f2a4c6d', 'Main warehouse', NULL, TRUE, NOW())
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE inventories ADD COLUMN IF NOT EXISTS warehouse_id UUID NOT NULL DEFAULT '7c1d1a8e-2f4b-4e6a-9d3c-5b8e0f2a4c6d' REFERENCES warehouses(id);
+ALTER TABLE inventories ADD CONSTRAINT inventories_product_id_warehouse_id_key UNIQUE (product_id, warehouse_id);
+
+CREATE TABLE IF NOT EXISTS order_item_allocations (
+  id UUID PRIMARY KEY,
+  order_item_id UUID NOT NULL,
+  inventory_id UUID NOT NULL,
+  warehouse_id UUID NOT NULL,
+  quantity INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (order_item_id) REFERENCES order_items(id),
+  FOREIGN KEY (inventory_id) REFERENCES inventories(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
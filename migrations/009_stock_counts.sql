@@ -0,0 +1,40 @@
+ALTER TABLE inventory_movements ADD COLUMN IF NOT EXISTS note TEXT;
+ALTER TABLE inventory_movements DROP CONSTRAINT IF EXISTS inventory_movements_reason_check;
+ALTER TABLE inventory_movements ADD CONSTRAINT inventory_movements_reason_check
+  CHECK (reason IN ('restock', 'sale', 'return', 'adjustment', 'damage', 'count'));
+
+-- Checkouts used to take stock without a guard, so it can be below zero. It is brought back to zero through an
+-- adjustment in the ledger before stock is kept from going negative.
+INSERT INTO inventory_movements (id, inventory_id, product_id, reason, quantity, balance, note, created_at)
+SELECT gen_random_uuid(), id, product_id, 'adjustment', -stock_quantity, 0, 'Negative stock cleared', NOW()
+FROM inventories WHERE stock_quantity < 0;
+
+UPDATE inventories SET stock_quantity = 0 WHERE stock_quantity < 0;
+
+ALTER TABLE inventories ADD CONSTRAINT inventories_stock_quantity_check CHECK (stock_quantity >= 0);
+
+CREATE TABLE IF NOT EXISTS stock_counts (
+  id UUID PRIMARY KEY,
+  warehouse_id UUID NOT NULL,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('open', 'submitted', 'applied')),
+  started_by UUID NOT NULL,
+  applied_by UUID,
+  created_at TIMESTAMPTZ NOT NULL,
+  submitted_at TIMESTAMPTZ,
+  applied_at TIMESTAMPTZ,
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+-- Only one count can be in progress per warehouse at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS stock_counts_warehouse_id_in_progress_idx ON stock_counts (warehouse_id) WHERE status <> 'applied';
+
+CREATE TABLE IF NOT EXISTS stock_count_items (
+  id UUID PRIMARY KEY,
+  stock_count_id UUID NOT NULL,
+  inventory_id UUID NOT NULL,
+  expected_quantity INT NOT NULL,
+  counted_quantity INT CHECK (counted_quantity >= 0),
+  FOREIGN KEY (stock_count_id) REFERENCES stock_counts(id),
+  FOREIGN KEY (inventory_id) REFERENCES inventories(id),
+  UNIQUE (stock_count_id, inventory_id)
+);
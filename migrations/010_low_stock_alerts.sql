@@ -0,0 +1,19 @@
+ALTER TABLE inventories ADD COLUMN IF NOT EXISTS reorder_point INT NOT NULL DEFAULT 0 CHECK (reorder_point >= 0);
+
+-- Outbox of alerts raised when an inventory drops to or below its reorder point. Rows are written in the
+-- same transaction as the stock change and dispatched to the notifier in the background.
+CREATE TABLE IF NOT EXISTS low_stock_alerts (
+  id UUID PRIMARY KEY,
+  inventory_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  warehouse_id UUID NOT NULL,
+  stock_quantity INT NOT NULL,
+  reorder_point INT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  notified_at TIMESTAMPTZ,
+  FOREIGN KEY (inventory_id) REFERENCES inventories(id),
+  FOREIGN KEY (product_id) REFERENCES products(id),
+  FOREIGN KEY (warehouse_id) REFERENCES warehouses(id)
+);
+
+CREATE INDEX IF NOT EXISTS low_stock_alerts_pending_idx ON low_stock_alerts (created_at) WHERE notified_at IS NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS restock_subscriptions (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  unsubscribe_token VARCHAR(64) UNIQUE NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  notified_at TIMESTAMPTZ,
+  unsubscribed_at TIMESTAMPTZ,
+  FOREIGN KEY (customer_id) REFERENCES customers(id),
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+-- A customer has at most one pending subscription per product, so a restock notifies them only once.
+CREATE UNIQUE INDEX IF NOT EXISTS restock_subscriptions_pending_idx ON restock_subscriptions (customer_id, product_id)
+WHERE notified_at IS NULL AND unsubscribed_at IS NULL;
@@ -0,0 +1,15 @@
+-- Products sold beyond their stock. Backorders cover products that are temporarily out of stock and
+-- pre-orders cover products that were not released yet. Either way at most backorder_limit units can
+-- be waiting for stock at once.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS allows_backorder BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS allows_preorder BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS backorder_limit INT NOT NULL DEFAULT 0 CHECK (backorder_limit >= 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS expected_ship_date DATE;
+ALTER TABLE products ADD CONSTRAINT products_backorder_or_preorder_check CHECK (NOT (allows_backorder AND allows_preorder));
+
+-- Units of the order item still waiting for stock. They are allocated, oldest order first, as stock arrives.
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS backordered_quantity INT NOT NULL DEFAULT 0
+  CHECK (backordered_quantity >= 0 AND backordered_quantity <= quantity);
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS expected_ship_date DATE;
+
+CREATE INDEX IF NOT EXISTS order_items_backordered_idx ON order_items (product_id, created_at) WHERE backordered_quantity > 0;
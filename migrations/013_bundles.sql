@@ -0,0 +1,36 @@
+-- Bundles are sold as products but hold no stock of their own: they are made of component products,
+-- and checkout takes the stock out of the components.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS is_bundle BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS bundle_components (
+  id UUID PRIMARY KEY,
+  bundle_product_id UUID NOT NULL,
+  component_product_id UUID NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (bundle_product_id, component_product_id),
+  CHECK (bundle_product_id <> component_product_id),
+  FOREIGN KEY (bundle_product_id) REFERENCES products(id),
+  FOREIGN KEY (component_product_id) REFERENCES products(id)
+);
+
+-- Component lines of a bundle order item point to it. They carry no price, since the bundle line is the
+-- one charged, and stock is allocated to them instead of to the bundle line.
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS parent_order_item_id UUID REFERENCES order_items(id);
+
+-- Stock available for each product across all warehouses. A bundle has as many units available as its
+-- scarcest component can fill.
+CREATE OR REPLACE VIEW product_available_stock AS
+  SELECT product_id, SUM(stock_quantity)::INT AS stock_quantity
+  FROM inventories
+  GROUP BY product_id
+  UNION ALL
+  SELECT bc.bundle_product_id, MIN(COALESCE(cs.stock_quantity, 0) / bc.quantity)::INT
+  FROM bundle_components bc
+  LEFT JOIN (
+    SELECT product_id, SUM(stock_quantity) AS stock_quantity
+    FROM inventories
+    GROUP BY product_id
+  ) cs
+    ON cs.product_id = bc.component_product_id
+  GROUP BY bc.bundle_product_id;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS guest_carts (
+  id UUID PRIMARY KEY,
+  currency CHAR(3) NOT NULL DEFAULT 'USD',
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS guest_carts_expires_at_idx ON guest_carts (expires_at);
+
+CREATE TABLE IF NOT EXISTS guest_cart_items (
+  id UUID PRIMARY KEY,
+  guest_cart_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (guest_cart_id, product_id),
+  FOREIGN KEY (guest_cart_id) REFERENCES guest_carts(id) ON DELETE CASCADE,
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
@@ -0,0 +1,7 @@
+-- Guest customers check out with just an email and an address. They have no password until they convert
+-- to a full account, so they cannot log in.
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS is_guest BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD CONSTRAINT customers_guest_password_check CHECK (is_guest OR password <> '');
+
+-- When the signed order lookup link was sent to a guest customer.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS lookup_link_sent_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+-- Bumped on every change to the cart or its items. It is exposed as the cart ETag, so batch updates made
+-- from a stale copy of the cart are rejected instead of overwriting newer changes.
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 0;
@@ -0,0 +1,15 @@
+-- Unit price, in the cart currency, the customer last saw for the item. The cart warns when the current price
+-- differs from it, and checkout waits until the customer acknowledges the change. Lines added before prices
+-- were tracked start from the current price.
+ALTER TABLE cart_items ADD COLUMN IF NOT EXISTS price_at_add BIGINT;
+
+UPDATE cart_items ci SET price_at_add = (
+  SELECT COALESCE(pp.price, CASE WHEN p.currency = c.currency THEN p.price END)
+  FROM carts c
+  JOIN products p
+    ON p.id = ci.product_id
+  LEFT JOIN product_prices pp
+    ON pp.product_id = p.id AND pp.currency = c.currency
+  WHERE c.id = ci.cart_id
+)
+WHERE ci.price_at_add IS NULL;
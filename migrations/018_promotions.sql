@@ -0,0 +1,41 @@
+-- Coupons customers apply to their cart. Which fields are set depends on the type: percentage off the
+-- subtotal, a fixed amount off the subtotal, get_quantity free units of product_id for every buy_quantity
+-- bought, or free shipping. Amounts and the minimum subtotal are in currency, and such coupons only apply
+-- to carts in that currency.
+CREATE TABLE IF NOT EXISTS promotions (
+  id UUID PRIMARY KEY,
+  code VARCHAR(50) UNIQUE NOT NULL,
+  type VARCHAR(20) NOT NULL CHECK (type IN ('percentage', 'fixed_amount', 'buy_x_get_y', 'free_shipping')),
+  percentage INT CHECK (percentage BETWEEN 1 AND 100),
+  amount BIGINT CHECK (amount > 0),
+  currency CHAR(3),
+  product_id UUID REFERENCES products(id),
+  buy_quantity INT CHECK (buy_quantity > 0),
+  get_quantity INT CHECK (get_quantity > 0),
+  minimum_subtotal BIGINT CHECK (minimum_subtotal > 0),
+  usage_limit INT CHECK (usage_limit > 0),
+  per_customer_limit INT CHECK (per_customer_limit > 0),
+  starts_at TIMESTAMPTZ,
+  ends_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL,
+  CHECK (starts_at IS NULL OR ends_at IS NULL OR ends_at > starts_at)
+);
+
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS promotion_id UUID REFERENCES promotions(id);
+
+-- Discounts applied to an order, which also count towards the coupon usage limits. total_price on the order
+-- is net of them.
+CREATE TABLE IF NOT EXISTS order_discounts (
+  id UUID PRIMARY KEY,
+  order_id UUID NOT NULL,
+  promotion_id UUID NOT NULL,
+  code VARCHAR(50) NOT NULL,
+  type VARCHAR(20) NOT NULL,
+  amount BIGINT NOT NULL CHECK (amount >= 0),
+  currency CHAR(3) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (order_id) REFERENCES orders(id),
+  FOREIGN KEY (promotion_id) REFERENCES promotions(id)
+);
+
+CREATE INDEX IF NOT EXISTS order_discounts_promotion_id_idx ON order_discounts (promotion_id);
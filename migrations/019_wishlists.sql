@@ -0,0 +1,28 @@
+-- Lists where customers park products. A customer has any number of named wishlists and a single saved for
+-- later list, which holds the cart items they moved out of the cart.
+CREATE TABLE IF NOT EXISTS wishlists (
+  id UUID PRIMARY KEY,
+  customer_id UUID NOT NULL,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('wishlist', 'saved_for_later')),
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (customer_id) REFERENCES customers(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS wishlists_name_idx ON wishlists (customer_id, name) WHERE kind = 'wishlist';
+CREATE UNIQUE INDEX IF NOT EXISTS wishlists_saved_for_later_idx ON wishlists (customer_id) WHERE kind = 'saved_for_later';
+
+-- price_at_add is the price in currency when the item was added, so a price drop can be shown. It is null
+-- when the product was not priced in that currency.
+CREATE TABLE IF NOT EXISTS wishlist_items (
+  id UUID PRIMARY KEY,
+  wishlist_id UUID NOT NULL,
+  product_id UUID NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  price_at_add BIGINT,
+  currency CHAR(3) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (wishlist_id, product_id),
+  FOREIGN KEY (wishlist_id) REFERENCES wishlists(id),
+  FOREIGN KEY (product_id) REFERENCES products(id)
+);
@@ -0,0 +1,25 @@
+-- Set on every change to the cart or its items, along with the version. Carts left idle for long enough are
+-- sent reminders.
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS last_activity_at TIMESTAMPTZ;
+UPDATE carts SET last_activity_at = created_at WHERE last_activity_at IS NULL;
+ALTER TABLE carts ALTER COLUMN last_activity_at SET DEFAULT NOW();
+ALTER TABLE carts ALTER COLUMN last_activity_at SET NOT NULL;
+
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS cart_reminders_opted_out BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Reminders sent for idle carts. sequence counts the reminders sent since the last order from the cart, and
+-- order_id is the first order the customer placed after the reminder, which counts it as recovered.
+CREATE TABLE IF NOT EXISTS cart_reminders (
+  id UUID PRIMARY KEY,
+  cart_id UUID NOT NULL,
+  customer_id UUID NOT NULL,
+  sequence INT NOT NULL CHECK (sequence > 0),
+  sent_at TIMESTAMPTZ NOT NULL,
+  order_id UUID,
+  FOREIGN KEY (cart_id) REFERENCES carts(id),
+  FOREIGN KEY (customer_id) REFERENCES customers(id),
+  FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE INDEX IF NOT EXISTS cart_reminders_cart_id_idx ON cart_reminders (cart_id, sent_at);
+CREATE INDEX IF NOT EXISTS carts_last_activity_at_idx ON carts (last_activity_at);
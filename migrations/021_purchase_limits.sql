@@ -0,0 +1,9 @@
+-- Caps on how many units of a product can be bought. max_per_order applies to a single cart or order and
+-- max_per_customer to the units a customer ordered within the last max_per_customer_period_days days.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_order INT CHECK (max_per_order > 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_customer INT CHECK (max_per_customer > 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_customer_period_days INT CHECK (max_per_customer_period_days > 0);
+ALTER TABLE products ADD CONSTRAINT products_max_per_customer_period_check
+  CHECK ((max_per_customer IS NULL) = (max_per_customer_period_days IS NULL));
+
+CREATE INDEX IF NOT EXISTS orders_customer_id_created_at_idx ON orders (customer_id, created_at);
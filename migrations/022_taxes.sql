@@ -0,0 +1,31 @@
+-- Sales tax by shipping state. A product is taxed at the rate of its tax category in the destination state, or
+-- at the state's general rate when its category has no rate of its own. States without rates are not taxed.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS tax_category VARCHAR(50) NOT NULL DEFAULT 'general';
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS tax_exempt BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS tax_rates (
+  id UUID PRIMARY KEY,
+  state CHAR(2) NOT NULL,
+  tax_category VARCHAR(50) NOT NULL,
+  rate_basis_points INT NOT NULL CHECK (rate_basis_points >= 0 AND rate_basis_points <= 10000),
+  created_at TIMESTAMPTZ NOT NULL,
+  UNIQUE (state, tax_category)
+);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax_amount BIGINT NOT NULL DEFAULT 0;
+
+-- The tax charged on each order item, kept as calculated at checkout so later rate changes do not alter it.
+CREATE TABLE IF NOT EXISTS order_item_taxes (
+  id UUID PRIMARY KEY,
+  order_item_id UUID NOT NULL,
+  jurisdiction VARCHAR(50) NOT NULL,
+  tax_category VARCHAR(50) NOT NULL,
+  rate_basis_points INT NOT NULL,
+  taxable_amount BIGINT NOT NULL,
+  amount BIGINT NOT NULL,
+  currency CHAR(3) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (order_item_id) REFERENCES order_items(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS order_item_taxes_order_item_id_idx ON order_item_taxes (order_item_id);
@@ -0,0 +1,40 @@
+-- Product sizes, in grams and centimeters, used to quote weight-based shipping.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS weight_grams INT NOT NULL DEFAULT 0 CHECK (weight_grams >= 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS length_cm INT CHECK (length_cm > 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS width_cm INT CHECK (width_cm > 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS height_cm INT CHECK (height_cm > 0);
+
+-- Ways an order can ship. Which fields are set depends on the type: a flat rate, a base rate plus rate_per_kg
+-- for every started kilogram, a flat rate that is waived from free_threshold up, or a rate per zip prefix kept
+-- in shipping_zone_rates. Amounts are in currency, and methods only quote for carts in that currency.
+CREATE TABLE IF NOT EXISTS shipping_methods (
+  id UUID PRIMARY KEY,
+  name VARCHAR(50) UNIQUE NOT NULL,
+  type VARCHAR(20) NOT NULL CHECK (type IN ('flat', 'weight_based', 'free_over_threshold', 'zone')),
+  currency CHAR(3) NOT NULL,
+  rate BIGINT CHECK (rate >= 0),
+  rate_per_kg BIGINT CHECK (rate_per_kg >= 0),
+  free_threshold BIGINT CHECK (free_threshold > 0),
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+-- Zone rates match the longest zip prefix of the destination. A zone method does not ship to zip codes none of
+-- its prefixes match.
+CREATE TABLE IF NOT EXISTS shipping_zone_rates (
+  id UUID PRIMARY KEY,
+  shipping_method_id UUID NOT NULL,
+  zip_prefix VARCHAR(5) NOT NULL CHECK (zip_prefix ~ '^[0-9]{1,5}$'),
+  rate BIGINT NOT NULL CHECK (rate >= 0),
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (shipping_method_id) REFERENCES shipping_methods(id) ON DELETE CASCADE,
+  UNIQUE (shipping_method_id, zip_prefix)
+);
+
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS shipping_method_id UUID REFERENCES shipping_methods(id);
+
+-- The method is kept by name as well, so the order still shows how it shipped if the method is renamed.
+-- total_price on the order includes shipping_price.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_method_id UUID REFERENCES shipping_methods(id);
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_method_name VARCHAR(50);
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_price BIGINT NOT NULL DEFAULT 0;
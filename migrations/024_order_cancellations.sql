@@ -0,0 +1,30 @@
+-- Orders are placed at checkout and fulfilled once they ship. Customers can cancel until then, while admins can
+-- cancel at any stage. Cancelling puts the shipped stock back and refunds what is left of the payment.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'placed'
+  CHECK (status IN ('placed', 'fulfilled', 'cancelled'));
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS fulfilled_at TIMESTAMPTZ;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS cancelled_by UUID;
+
+-- Refunds issued through the payment gateway. refunded_amount on the payment is their total, so a payment is
+-- never refunded beyond what was charged.
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS refunded_amount BIGINT NOT NULL DEFAULT 0 CHECK (refunded_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS refunds (
+  id UUID PRIMARY KEY,
+  payment_id UUID NOT NULL,
+  amount BIGINT NOT NULL CHECK (amount > 0),
+  currency CHAR(3) NOT NULL,
+  reason VARCHAR(20) NOT NULL CHECK (reason IN ('cancellation')),
+  payment_gateway_refund_id VARCHAR(100) NOT NULL,
+  status VARCHAR(20) NOT NULL,
+  actor_id UUID,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (payment_id) REFERENCES payments(id)
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_id_idx ON refunds (payment_id);
+
+ALTER TABLE inventory_movements DROP CONSTRAINT IF EXISTS inventory_movements_reason_check;
+ALTER TABLE inventory_movements ADD CONSTRAINT inventory_movements_reason_check
+  CHECK (reason IN ('restock', 'sale', 'return', 'adjustment', 'damage', 'count', 'cancellation'));
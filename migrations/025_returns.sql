@@ -0,0 +1,31 @@
+-- How many days after an order is fulfilled its items can be returned. Zero makes the product non-returnable.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS return_window_days INT NOT NULL DEFAULT 30 CHECK (return_window_days >= 0);
+
+-- Customers request to return units of an order item. Admins approve or reject the request, and once the units
+-- are received back the returned lines are refunded and, if they can be sold again, restocked into the warehouses
+-- they shipped from. refunded_amount is what was refunded for the request when it was received.
+CREATE TABLE IF NOT EXISTS return_requests (
+  id UUID PRIMARY KEY,
+  order_item_id UUID NOT NULL,
+  customer_id UUID NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  reason_code VARCHAR(20) NOT NULL
+    CHECK (reason_code IN ('damaged', 'defective', 'wrong_item', 'not_as_described', 'no_longer_needed', 'other')),
+  comment TEXT,
+  status VARCHAR(20) NOT NULL DEFAULT 'requested' CHECK (status IN ('requested', 'approved', 'rejected', 'received')),
+  decision_note TEXT,
+  decided_by UUID,
+  decided_at TIMESTAMPTZ,
+  restocked BOOLEAN NOT NULL DEFAULT FALSE,
+  refunded_amount BIGINT NOT NULL DEFAULT 0,
+  received_by UUID,
+  received_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (order_item_id) REFERENCES order_items(id),
+  FOREIGN KEY (customer_id) REFERENCES customers(id)
+);
+
+CREATE INDEX IF NOT EXISTS return_requests_order_item_id_idx ON return_requests (order_item_id);
+
+ALTER TABLE refunds DROP CONSTRAINT IF EXISTS refunds_reason_check;
+ALTER TABLE refunds ADD CONSTRAINT refunds_reason_check CHECK (reason IN ('cancellation', 'return'));
@@ -0,0 +1,5 @@
+-- Restocking a product that was out of stock queues its pending subscriptions to be notified.
+ALTER TABLE restock_subscriptions ADD COLUMN IF NOT EXISTS queued_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS restock_subscriptions_queued_idx ON restock_subscriptions (queued_at, id)
+WHERE queued_at IS NOT NULL AND notified_at IS NULL AND unsubscribed_at IS NULL;
@@ -0,0 +1,4 @@
+-- The guest customer and shipping address a guest cart was checked out with. The cart is kept until the order is
+-- placed, so its token still identifies the guest through payment.
+ALTER TABLE guest_carts ADD COLUMN IF NOT EXISTS customer_id UUID REFERENCES customers(id);
+ALTER TABLE guest_carts ADD COLUMN IF NOT EXISTS address_id UUID REFERENCES addresses(id);
@@ -0,0 +1,18 @@
+-- What a cart was priced at when its payment preference was created, which is what the customer pays. A cart
+-- keeps the quote of its latest preference.
+CREATE TABLE IF NOT EXISTS checkout_quotes (
+  cart_id UUID PRIMARY KEY,
+  preference_id VARCHAR(100) NOT NULL,
+  promotion_id UUID,
+  coupon_code VARCHAR(50),
+  discount_type VARCHAR(20),
+  discount_amount BIGINT NOT NULL DEFAULT 0,
+  total_price BIGINT NOT NULL,
+  currency CHAR(3) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  FOREIGN KEY (cart_id) REFERENCES carts(id) ON DELETE CASCADE,
+  FOREIGN KEY (promotion_id) REFERENCES promotions(id)
+);
+
+-- What the cart priced at when the payment came in, set only when it differs from the quoted total charged.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS repriced_total BIGINT;
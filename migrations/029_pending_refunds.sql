@@ -0,0 +1,7 @@
+-- Refunds are recorded as pending along with the cancellation or return, and sent to the payment gateway after
+-- the transaction commits, so every refund the gateway makes has a row. sent_at is set, along with the gateway
+-- refund id and status, once the gateway answers.
+ALTER TABLE refunds ALTER COLUMN payment_gateway_refund_id DROP NOT NULL;
+ALTER TABLE refunds ADD COLUMN IF NOT EXISTS sent_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS refunds_pending_idx ON refunds (created_at) WHERE sent_at IS NULL;
@@ -0,0 +1,5 @@
+-- Import jobs are queued with their file and processed by a worker, which clears the content once the job is
+-- completed or failed.
+ALTER TABLE product_import_jobs ADD COLUMN IF NOT EXISTS content BYTEA;
+
+CREATE INDEX IF NOT EXISTS product_import_jobs_queued_idx ON product_import_jobs (created_at) WHERE status = 'queued';
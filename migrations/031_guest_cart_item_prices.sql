@@ -0,0 +1,15 @@
+-- Unit price, in the guest cart currency, the guest last saw for the item. It is carried over to the customer
+-- cart at checkout or sign in, so price changes since then are still warned about. Existing items start from the
+-- current price.
+ALTER TABLE guest_cart_items ADD COLUMN IF NOT EXISTS price_at_add BIGINT;
+
+UPDATE guest_cart_items gci SET price_at_add = (
+  SELECT COALESCE(pp.price, CASE WHEN p.currency = gc.currency THEN p.price END)
+  FROM guest_carts gc
+  JOIN products p
+    ON p.id = gci.product_id
+  LEFT JOIN product_prices pp
+    ON pp.product_id = p.id AND pp.currency = gc.currency
+  WHERE gc.id = gci.guest_cart_id
+)
+WHERE gci.price_at_add IS NULL;
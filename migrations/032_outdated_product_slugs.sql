@@ -0,0 +1,10 @@
+-- The slugs backfilled by 004 were made in SQL, which keeps accented letters as separators instead of stripping
+-- their accents like the application does. They are flagged here and regenerated from the product names by the
+-- product slugs worker, which keeps each old slug as a redirect.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS slug_outdated BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE products
+SET slug_outdated = TRUE
+WHERE slug = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')) || '-' || LEFT(id::TEXT, 8);
+
+CREATE INDEX IF NOT EXISTS products_slug_outdated_idx ON products (created_at) WHERE slug_outdated;